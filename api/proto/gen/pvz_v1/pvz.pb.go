@@ -29,6 +29,8 @@ type ReceptionStatus int32
 const (
 	ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS ReceptionStatus = 0
 	ReceptionStatus_RECEPTION_STATUS_CLOSED      ReceptionStatus = 1
+	ReceptionStatus_RECEPTION_STATUS_PAUSED      ReceptionStatus = 2
+	ReceptionStatus_RECEPTION_STATUS_CANCELLED   ReceptionStatus = 3
 )
 
 // Enum value maps for ReceptionStatus.
@@ -36,10 +38,14 @@ var (
 	ReceptionStatus_name = map[int32]string{
 		0: "RECEPTION_STATUS_IN_PROGRESS",
 		1: "RECEPTION_STATUS_CLOSED",
+		2: "RECEPTION_STATUS_PAUSED",
+		3: "RECEPTION_STATUS_CANCELLED",
 	}
 	ReceptionStatus_value = map[string]int32{
 		"RECEPTION_STATUS_IN_PROGRESS": 0,
 		"RECEPTION_STATUS_CLOSED":      1,
+		"RECEPTION_STATUS_PAUSED":      2,
+		"RECEPTION_STATUS_CANCELLED":   3,
 	}
 )
 
@@ -70,6 +76,76 @@ func (ReceptionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_api_proto_pvz_proto_rawDescGZIP(), []int{0}
 }
 
+type PVZEventType int32
+
+const (
+	PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED         PVZEventType = 0
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_STARTED   PVZEventType = 1
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED    PVZEventType = 2
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED       PVZEventType = 3
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED     PVZEventType = 4
+	PVZEventType_PVZ_EVENT_TYPE_PRODUCT_RESTORED    PVZEventType = 5
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_PAUSED    PVZEventType = 6
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_RESUMED   PVZEventType = 7
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CANCELLED PVZEventType = 8
+	PVZEventType_PVZ_EVENT_TYPE_RECEPTION_REOPENED  PVZEventType = 9
+)
+
+// Enum value maps for PVZEventType.
+var (
+	PVZEventType_name = map[int32]string{
+		0: "PVZ_EVENT_TYPE_UNSPECIFIED",
+		1: "PVZ_EVENT_TYPE_RECEPTION_STARTED",
+		2: "PVZ_EVENT_TYPE_RECEPTION_CLOSED",
+		3: "PVZ_EVENT_TYPE_PRODUCT_ADDED",
+		4: "PVZ_EVENT_TYPE_PRODUCT_DELETED",
+		5: "PVZ_EVENT_TYPE_PRODUCT_RESTORED",
+		6: "PVZ_EVENT_TYPE_RECEPTION_PAUSED",
+		7: "PVZ_EVENT_TYPE_RECEPTION_RESUMED",
+		8: "PVZ_EVENT_TYPE_RECEPTION_CANCELLED",
+		9: "PVZ_EVENT_TYPE_RECEPTION_REOPENED",
+	}
+	PVZEventType_value = map[string]int32{
+		"PVZ_EVENT_TYPE_UNSPECIFIED":         0,
+		"PVZ_EVENT_TYPE_RECEPTION_STARTED":   1,
+		"PVZ_EVENT_TYPE_RECEPTION_CLOSED":    2,
+		"PVZ_EVENT_TYPE_PRODUCT_ADDED":       3,
+		"PVZ_EVENT_TYPE_PRODUCT_DELETED":     4,
+		"PVZ_EVENT_TYPE_PRODUCT_RESTORED":    5,
+		"PVZ_EVENT_TYPE_RECEPTION_PAUSED":    6,
+		"PVZ_EVENT_TYPE_RECEPTION_RESUMED":   7,
+		"PVZ_EVENT_TYPE_RECEPTION_CANCELLED": 8,
+		"PVZ_EVENT_TYPE_RECEPTION_REOPENED":  9,
+	}
+)
+
+func (x PVZEventType) Enum() *PVZEventType {
+	p := new(PVZEventType)
+	*p = x
+	return p
+}
+
+func (x PVZEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PVZEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_pvz_proto_enumTypes[1].Descriptor()
+}
+
+func (PVZEventType) Type() protoreflect.EnumType {
+	return &file_api_proto_pvz_proto_enumTypes[1]
+}
+
+func (x PVZEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PVZEventType.Descriptor instead.
+func (PVZEventType) EnumDescriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{1}
+}
+
 type PVZ struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -132,6 +208,8 @@ func (x *PVZ) GetCity() string {
 
 type GetPVZListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -166,9 +244,24 @@ func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_pvz_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetPVZListRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZListRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type GetPVZListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -210,32 +303,5126 @@ func (x *GetPVZListResponse) GetPvzs() []*PVZ {
 	return nil
 }
 
-var File_api_proto_pvz_proto protoreflect.FileDescriptor
+func (x *GetPVZListResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
 
-const file_api_proto_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\x13api/proto/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf0\x02\n" +
-	"\x03PVZ\x12s\n" +
-	"\x02id\x18\x01 \x01(\tBc\x92A`26Уникальный идентификатор ПВЗJ&\"123e4567-e89b-12d3-a456-426614174000\"R\x02id\x12\x9e\x01\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBU\x92AR28Дата регистрации ПВЗ в системеJ\x16\"2023-01-15T12:00:00Z\"R\x10registrationDate\x12S\n" +
-	"\x04city\x18\x03 \x01(\tB?\x92A<2*Город расположения ПВЗJ\x0e\"Москва\"R\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"O\n" +
-	"\x12GetPVZListResponse\x129\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZB\x18\x92A\x152\x13Массив ПВЗR\x04pvzs*P\n" +
-	"\x0fReceptionStatus\x12 \n" +
-	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012\xba\x03\n" +
-	"\n" +
-	"PVZService\x12\xab\x03\n" +
-	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\"\xe5\x02\x92A\xce\x02\n" +
-	"\x03PVZ\x12-Получить список всех ПВЗ\x1a\x7fВозвращает список всех пунктов выдачи заказов с их основными даннымиJD\n" +
-	"\x03200\x12=\n" +
-	"\x1bУспешный ответ\x12\x1e\n" +
-	"\x1c\x1a\x1a.pvz.v1.GetPVZListResponseJQ\n" +
+type Reception struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reception) Reset() {
+	*x = Reception{}
+	mi := &file_api_proto_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reception) ProtoMessage() {}
+
+func (x *Reception) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS
+}
+
+type Product struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Barcode       string                 `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	OrderId       string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_api_proto_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReceptionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	Products      []*Product             `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionInfo) Reset() {
+	*x = ReceptionInfo{}
+	mi := &file_api_proto_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionInfo) ProtoMessage() {}
+
+func (x *ReceptionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionInfo.ProtoReflect.Descriptor instead.
+func (*ReceptionInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReceptionInfo) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionInfo) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type PVZInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	Receptions    []*ReceptionInfo       `protobuf:"bytes,2,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZInfo) Reset() {
+	*x = PVZInfo{}
+	mi := &file_api_proto_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZInfo) ProtoMessage() {}
+
+func (x *PVZInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZInfo.ProtoReflect.Descriptor instead.
+func (*PVZInfo) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PVZInfo) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *PVZInfo) GetReceptions() []*ReceptionInfo {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+type CreatePVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	City          string                 `protobuf:"bytes,1,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZRequest) Reset() {
+	*x = CreatePVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZRequest) ProtoMessage() {}
+
+func (x *CreatePVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZRequest.ProtoReflect.Descriptor instead.
+func (*CreatePVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePVZRequest) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+type CreatePVZResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pvz           *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePVZResponse) Reset() {
+	*x = CreatePVZResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePVZResponse) ProtoMessage() {}
+
+func (x *CreatePVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePVZResponse.ProtoReflect.Descriptor instead.
+func (*CreatePVZResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreatePVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+type GetPVZSInfoRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	StartDate         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Page              int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit             int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor            string                 `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Cities            []string               `protobuf:"bytes,6,rep,name=cities,proto3" json:"cities,omitempty"`
+	PvzIds            []string               `protobuf:"bytes,7,rep,name=pvz_ids,json=pvzIds,proto3" json:"pvz_ids,omitempty"`
+	ReceptionStatuses []ReceptionStatus      `protobuf:"varint,8,rep,packed,name=reception_statuses,json=receptionStatuses,proto3,enum=pvz.v1.ReceptionStatus" json:"reception_statuses,omitempty"`
+	ProductTypes      []string               `protobuf:"bytes,9,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetPVZSInfoRequest) Reset() {
+	*x = GetPVZSInfoRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZSInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZSInfoRequest) ProtoMessage() {}
+
+func (x *GetPVZSInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZSInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZSInfoRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetPVZSInfoRequest) GetStartDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetPVZSInfoRequest) GetEndDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetPVZSInfoRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZSInfoRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZSInfoRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetPVZSInfoRequest) GetCities() []string {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *GetPVZSInfoRequest) GetPvzIds() []string {
+	if x != nil {
+		return x.PvzIds
+	}
+	return nil
+}
+
+func (x *GetPVZSInfoRequest) GetReceptionStatuses() []ReceptionStatus {
+	if x != nil {
+		return x.ReceptionStatuses
+	}
+	return nil
+}
+
+func (x *GetPVZSInfoRequest) GetProductTypes() []string {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+type GetPVZSInfoResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*PVZInfo             `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	Limit         int32                  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	NextPage      int32                  `protobuf:"varint,5,opt,name=next_page,json=nextPage,proto3" json:"next_page,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,6,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZSInfoResponse) Reset() {
+	*x = GetPVZSInfoResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZSInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZSInfoResponse) ProtoMessage() {}
+
+func (x *GetPVZSInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZSInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZSInfoResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPVZSInfoResponse) GetItems() []*PVZInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetPVZSInfoResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetPVZSInfoResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetPVZSInfoResponse) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPVZSInfoResponse) GetNextPage() int32 {
+	if x != nil {
+		return x.NextPage
+	}
+	return 0
+}
+
+func (x *GetPVZSInfoResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type StartReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReceptionRequest) Reset() {
+	*x = StartReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReceptionRequest) ProtoMessage() {}
+
+func (x *StartReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReceptionRequest.ProtoReflect.Descriptor instead.
+func (*StartReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StartReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type StartReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartReceptionResponse) Reset() {
+	*x = StartReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartReceptionResponse) ProtoMessage() {}
+
+func (x *StartReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartReceptionResponse.ProtoReflect.Descriptor instead.
+func (*StartReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StartReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CloseReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseReceptionRequest) Reset() {
+	*x = CloseReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReceptionRequest) ProtoMessage() {}
+
+func (x *CloseReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CloseReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CloseReceptionRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type CloseReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseReceptionResponse) Reset() {
+	*x = CloseReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseReceptionResponse) ProtoMessage() {}
+
+func (x *CloseReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CloseReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CloseReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type PauseReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseReceptionRequest) Reset() {
+	*x = PauseReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseReceptionRequest) ProtoMessage() {}
+
+func (x *PauseReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseReceptionRequest.ProtoReflect.Descriptor instead.
+func (*PauseReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PauseReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type PauseReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseReceptionResponse) Reset() {
+	*x = PauseReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseReceptionResponse) ProtoMessage() {}
+
+func (x *PauseReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseReceptionResponse.ProtoReflect.Descriptor instead.
+func (*PauseReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PauseReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type ResumeReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeReceptionRequest) Reset() {
+	*x = ResumeReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeReceptionRequest) ProtoMessage() {}
+
+func (x *ResumeReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeReceptionRequest.ProtoReflect.Descriptor instead.
+func (*ResumeReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ResumeReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type ResumeReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeReceptionResponse) Reset() {
+	*x = ResumeReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeReceptionResponse) ProtoMessage() {}
+
+func (x *ResumeReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeReceptionResponse.ProtoReflect.Descriptor instead.
+func (*ResumeReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ResumeReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type CancelReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReceptionRequest) Reset() {
+	*x = CancelReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReceptionRequest) ProtoMessage() {}
+
+func (x *CancelReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReceptionRequest.ProtoReflect.Descriptor instead.
+func (*CancelReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CancelReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *CancelReceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelReceptionResponse) Reset() {
+	*x = CancelReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelReceptionResponse) ProtoMessage() {}
+
+func (x *CancelReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelReceptionResponse.ProtoReflect.Descriptor instead.
+func (*CancelReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CancelReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type ReopenReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionRequest) Reset() {
+	*x = ReopenReceptionRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionRequest) ProtoMessage() {}
+
+func (x *ReopenReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionRequest.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ReopenReceptionRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReopenReceptionRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReopenReceptionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reception     *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReopenReceptionResponse) Reset() {
+	*x = ReopenReceptionResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReopenReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReopenReceptionResponse) ProtoMessage() {}
+
+func (x *ReopenReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReopenReceptionResponse.ProtoReflect.Descriptor instead.
+func (*ReopenReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ReopenReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+type GetReceptionHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReceptionId   string                 `protobuf:"bytes,1,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionHistoryRequest) Reset() {
+	*x = GetReceptionHistoryRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionHistoryRequest) ProtoMessage() {}
+
+func (x *GetReceptionHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReceptionHistoryRequest) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+type ReceptionStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,2,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	FromStatus    string                 `protobuf:"bytes,3,opt,name=from_status,json=fromStatus,proto3" json:"from_status,omitempty"`
+	ToStatus      string                 `protobuf:"bytes,4,opt,name=to_status,json=toStatus,proto3" json:"to_status,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	UserId        string                 `protobuf:"bytes,7,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,8,opt,name=role,proto3" json:"role,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionStatusChange) Reset() {
+	*x = ReceptionStatusChange{}
+	mi := &file_api_proto_pvz_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionStatusChange) ProtoMessage() {}
+
+func (x *ReceptionStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionStatusChange.ProtoReflect.Descriptor instead.
+func (*ReceptionStatusChange) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ReceptionStatusChange) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetFromStatus() string {
+	if x != nil {
+		return x.FromStatus
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetToStatus() string {
+	if x != nil {
+		return x.ToStatus
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *ReceptionStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type GetReceptionHistoryResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Items         []*ReceptionStatusChange `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionHistoryResponse) Reset() {
+	*x = GetReceptionHistoryResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionHistoryResponse) ProtoMessage() {}
+
+func (x *GetReceptionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetReceptionHistoryResponse) GetItems() []*ReceptionStatusChange {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type AddProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Type          string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Barcode       string                 `protobuf:"bytes,3,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	OrderId       string                 `protobuf:"bytes,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductRequest) Reset() {
+	*x = AddProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductRequest) ProtoMessage() {}
+
+func (x *AddProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductRequest.ProtoReflect.Descriptor instead.
+func (*AddProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AddProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *AddProductRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type AddProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductResponse) Reset() {
+	*x = AddProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductResponse) ProtoMessage() {}
+
+func (x *AddProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductResponse.ProtoReflect.Descriptor instead.
+func (*AddProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AddProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ProductItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Barcode       string                 `protobuf:"bytes,2,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	OrderId       string                 `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductItem) Reset() {
+	*x = ProductItem{}
+	mi := &file_api_proto_pvz_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductItem) ProtoMessage() {}
+
+func (x *ProductItem) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductItem.ProtoReflect.Descriptor instead.
+func (*ProductItem) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ProductItem) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductItem) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *ProductItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type AddProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Products      []*ProductItem         `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsRequest) Reset() {
+	*x = AddProductsRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsRequest) ProtoMessage() {}
+
+func (x *AddProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsRequest.ProtoReflect.Descriptor instead.
+func (*AddProductsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AddProductsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductsRequest) GetProducts() []*ProductItem {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type AddProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsResponse) Reset() {
+	*x = AddProductsResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsResponse) ProtoMessage() {}
+
+func (x *AddProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsResponse.ProtoReflect.Descriptor instead.
+func (*AddProductsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AddProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type DeleteLastProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductRequest) Reset() {
+	*x = DeleteLastProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductRequest) ProtoMessage() {}
+
+func (x *DeleteLastProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteLastProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type DeleteLastProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteLastProductResponse) Reset() {
+	*x = DeleteLastProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteLastProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteLastProductResponse) ProtoMessage() {}
+
+func (x *DeleteLastProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteLastProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteLastProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{32}
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *DeleteProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{34}
+}
+
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreProductRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *RestoreProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type RestoreProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductResponse) Reset() {
+	*x = RestoreProductResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductResponse) ProtoMessage() {}
+
+func (x *RestoreProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductResponse.ProtoReflect.Descriptor instead.
+func (*RestoreProductResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RestoreProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type FindProductsByBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Barcode       string                 `protobuf:"bytes,1,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProductsByBarcodeRequest) Reset() {
+	*x = FindProductsByBarcodeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProductsByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProductsByBarcodeRequest) ProtoMessage() {}
+
+func (x *FindProductsByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProductsByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*FindProductsByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *FindProductsByBarcodeRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+type FindProductsByBarcodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FindProductsByBarcodeResponse) Reset() {
+	*x = FindProductsByBarcodeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindProductsByBarcodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindProductsByBarcodeResponse) ProtoMessage() {}
+
+func (x *FindProductsByBarcodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindProductsByBarcodeResponse.ProtoReflect.Descriptor instead.
+func (*FindProductsByBarcodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *FindProductsByBarcodeResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type AssignEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEmployeeRequest) Reset() {
+	*x = AssignEmployeeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEmployeeRequest) ProtoMessage() {}
+
+func (x *AssignEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*AssignEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AssignEmployeeRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AssignEmployeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type AssignEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignEmployeeResponse) Reset() {
+	*x = AssignEmployeeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignEmployeeResponse) ProtoMessage() {}
+
+func (x *AssignEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*AssignEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{40}
+}
+
+type UnassignEmployeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignEmployeeRequest) Reset() {
+	*x = UnassignEmployeeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignEmployeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignEmployeeRequest) ProtoMessage() {}
+
+func (x *UnassignEmployeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignEmployeeRequest.ProtoReflect.Descriptor instead.
+func (*UnassignEmployeeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UnassignEmployeeRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UnassignEmployeeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type UnassignEmployeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnassignEmployeeResponse) Reset() {
+	*x = UnassignEmployeeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnassignEmployeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnassignEmployeeResponse) ProtoMessage() {}
+
+func (x *UnassignEmployeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnassignEmployeeResponse.ProtoReflect.Descriptor instead.
+func (*UnassignEmployeeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{42}
+}
+
+type GetAuditLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PvzIds        []string               `protobuf:"bytes,2,rep,name=pvz_ids,json=pvzIds,proto3" json:"pvz_ids,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogRequest) Reset() {
+	*x = GetAuditLogRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogRequest) ProtoMessage() {}
+
+func (x *GetAuditLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogRequest.ProtoReflect.Descriptor instead.
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetAuditLogRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetAuditLogRequest) GetPvzIds() []string {
+	if x != nil {
+		return x.PvzIds
+	}
+	return nil
+}
+
+func (x *GetAuditLogRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *GetAuditLogRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *GetAuditLogRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAuditLogRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type AuditEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Dummy         bool                   `protobuf:"varint,4,opt,name=dummy,proto3" json:"dummy,omitempty"`
+	Action        string                 `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	PvzId         string                 `protobuf:"bytes,6,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,7,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,8,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	TargetUserId  string                 `protobuf:"bytes,9,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEntry) Reset() {
+	*x = AuditEntry{}
+	mi := &file_api_proto_pvz_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEntry) ProtoMessage() {}
+
+func (x *AuditEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEntry.ProtoReflect.Descriptor instead.
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AuditEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetDummy() bool {
+	if x != nil {
+		return x.Dummy
+	}
+	return false
+}
+
+func (x *AuditEntry) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetTargetUserId() string {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return ""
+}
+
+func (x *AuditEntry) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetAuditLogResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*AuditEntry          `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAuditLogResponse) Reset() {
+	*x = GetAuditLogResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAuditLogResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAuditLogResponse) ProtoMessage() {}
+
+func (x *GetAuditLogResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAuditLogResponse.ProtoReflect.Descriptor instead.
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetAuditLogResponse) GetItems() []*AuditEntry {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetAuditLogResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type DummyLoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Role          string                 `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DummyLoginRequest) Reset() {
+	*x = DummyLoginRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DummyLoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DummyLoginRequest) ProtoMessage() {}
+
+func (x *DummyLoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DummyLoginRequest.ProtoReflect.Descriptor instead.
+func (*DummyLoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DummyLoginRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type DummyLoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DummyLoginResponse) Reset() {
+	*x = DummyLoginResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DummyLoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DummyLoginResponse) ProtoMessage() {}
+
+func (x *DummyLoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DummyLoginResponse.ProtoReflect.Descriptor instead.
+func (*DummyLoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DummyLoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type RegisterRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterRequest) Reset() {
+	*x = RegisterRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterRequest) ProtoMessage() {}
+
+func (x *RegisterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterRequest.ProtoReflect.Descriptor instead.
+func (*RegisterRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *RegisterRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *RegisterRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type RegisterResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterResponse) Reset() {
+	*x = RegisterResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterResponse) ProtoMessage() {}
+
+func (x *RegisterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterResponse.ProtoReflect.Descriptor instead.
+func (*RegisterResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RegisterResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *RegisterResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *LoginResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshRequest) Reset() {
+	*x = RefreshRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshRequest) ProtoMessage() {}
+
+func (x *RefreshRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshRequest.ProtoReflect.Descriptor instead.
+func (*RefreshRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RefreshRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,2,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshResponse) Reset() {
+	*x = RefreshResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshResponse) ProtoMessage() {}
+
+func (x *RefreshResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshResponse.ProtoReflect.Descriptor instead.
+func (*RefreshResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RefreshResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *RefreshResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutRequest) Reset() {
+	*x = LogoutRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutRequest) ProtoMessage() {}
+
+func (x *LogoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutRequest.ProtoReflect.Descriptor instead.
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LogoutRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type LogoutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogoutResponse) Reset() {
+	*x = LogoutResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogoutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogoutResponse) ProtoMessage() {}
+
+func (x *LogoutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogoutResponse.ProtoReflect.Descriptor instead.
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{55}
+}
+
+type ChangePasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OldPassword   string                 `protobuf:"bytes,1,opt,name=old_password,json=oldPassword,proto3" json:"old_password,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ChangePasswordRequest) GetOldPassword() string {
+	if x != nil {
+		return x.OldPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{57}
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RequestPasswordResetRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{59}
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{61}
+}
+
+type WatchPVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzIds        []string               `protobuf:"bytes,1,rep,name=pvz_ids,json=pvzIds,proto3" json:"pvz_ids,omitempty"`
+	FromSequence  uint64                 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPVZRequest) Reset() {
+	*x = WatchPVZRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPVZRequest) ProtoMessage() {}
+
+func (x *WatchPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPVZRequest.ProtoReflect.Descriptor instead.
+func (*WatchPVZRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WatchPVZRequest) GetPvzIds() []string {
+	if x != nil {
+		return x.PvzIds
+	}
+	return nil
+}
+
+func (x *WatchPVZRequest) GetFromSequence() uint64 {
+	if x != nil {
+		return x.FromSequence
+	}
+	return 0
+}
+
+type PVZEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sequence      uint64                 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Type          PVZEventType           `protobuf:"varint,2,opt,name=type,proto3,enum=pvz.v1.PVZEventType" json:"type,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	ReceptionId   string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,5,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVZEvent) Reset() {
+	*x = PVZEvent{}
+	mi := &file_api_proto_pvz_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZEvent) ProtoMessage() {}
+
+func (x *PVZEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZEvent.ProtoReflect.Descriptor instead.
+func (*PVZEvent) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PVZEvent) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *PVZEvent) GetType() PVZEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PVZEventType_PVZ_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PVZEvent) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PVZEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Disabled      bool                   `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_api_proto_pvz_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *User) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *User) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *User) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor        string                 `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersRequest) Reset() {
+	*x = ListUsersRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersRequest) ProtoMessage() {}
+
+func (x *ListUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersRequest.ProtoReflect.Descriptor instead.
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListUsersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUsersResponse) Reset() {
+	*x = ListUsersResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUsersResponse) ProtoMessage() {}
+
+func (x *ListUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUsersResponse.ProtoReflect.Descriptor instead.
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *ListUsersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type UpdateUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleRequest) Reset() {
+	*x = UpdateUserRoleRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleRequest) ProtoMessage() {}
+
+func (x *UpdateUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *UpdateUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type UpdateUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRoleResponse) Reset() {
+	*x = UpdateUserRoleResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRoleResponse) ProtoMessage() {}
+
+func (x *UpdateUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UpdateUserRoleResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserRequest) Reset() {
+	*x = DeactivateUserRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserRequest) ProtoMessage() {}
+
+func (x *DeactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DeactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateUserResponse) Reset() {
+	*x = DeactivateUserResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateUserResponse) ProtoMessage() {}
+
+func (x *DeactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeactivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ActivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateUserRequest) Reset() {
+	*x = ActivateUserRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateUserRequest) ProtoMessage() {}
+
+func (x *ActivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ActivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ActivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ActivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivateUserResponse) Reset() {
+	*x = ActivateUserResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivateUserResponse) ProtoMessage() {}
+
+func (x *ActivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ActivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ActivateUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{76}
+}
+
+type ProductType struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fragile        bool                   `protobuf:"varint,2,opt,name=fragile,proto3" json:"fragile,omitempty"`
+	MaxWeightGrams int32                  `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3" json:"max_weight_grams,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProductType) Reset() {
+	*x = ProductType{}
+	mi := &file_api_proto_pvz_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductType) ProtoMessage() {}
+
+func (x *ProductType) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductType.ProtoReflect.Descriptor instead.
+func (*ProductType) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ProductType) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProductType) GetFragile() bool {
+	if x != nil {
+		return x.Fragile
+	}
+	return false
+}
+
+func (x *ProductType) GetMaxWeightGrams() int32 {
+	if x != nil {
+		return x.MaxWeightGrams
+	}
+	return 0
+}
+
+func (x *ProductType) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProductType) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type ListProductTypesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductTypesRequest) Reset() {
+	*x = ListProductTypesRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductTypesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductTypesRequest) ProtoMessage() {}
+
+func (x *ListProductTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductTypesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{78}
+}
+
+type ListProductTypesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductTypes  []*ProductType         `protobuf:"bytes,1,rep,name=product_types,json=productTypes,proto3" json:"product_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductTypesResponse) Reset() {
+	*x = ListProductTypesResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductTypesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductTypesResponse) ProtoMessage() {}
+
+func (x *ListProductTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductTypesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ListProductTypesResponse) GetProductTypes() []*ProductType {
+	if x != nil {
+		return x.ProductTypes
+	}
+	return nil
+}
+
+type CreateProductTypeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fragile        bool                   `protobuf:"varint,2,opt,name=fragile,proto3" json:"fragile,omitempty"`
+	MaxWeightGrams int32                  `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3" json:"max_weight_grams,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateProductTypeRequest) Reset() {
+	*x = CreateProductTypeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductTypeRequest) ProtoMessage() {}
+
+func (x *CreateProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateProductTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductTypeRequest) GetFragile() bool {
+	if x != nil {
+		return x.Fragile
+	}
+	return false
+}
+
+func (x *CreateProductTypeRequest) GetMaxWeightGrams() int32 {
+	if x != nil {
+		return x.MaxWeightGrams
+	}
+	return 0
+}
+
+type CreateProductTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductType   *ProductType           `protobuf:"bytes,1,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductTypeResponse) Reset() {
+	*x = CreateProductTypeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductTypeResponse) ProtoMessage() {}
+
+func (x *CreateProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CreateProductTypeResponse) GetProductType() *ProductType {
+	if x != nil {
+		return x.ProductType
+	}
+	return nil
+}
+
+type UpdateProductTypeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Fragile        bool                   `protobuf:"varint,2,opt,name=fragile,proto3" json:"fragile,omitempty"`
+	MaxWeightGrams int32                  `protobuf:"varint,3,opt,name=max_weight_grams,json=maxWeightGrams,proto3" json:"max_weight_grams,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateProductTypeRequest) Reset() {
+	*x = UpdateProductTypeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductTypeRequest) ProtoMessage() {}
+
+func (x *UpdateProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *UpdateProductTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductTypeRequest) GetFragile() bool {
+	if x != nil {
+		return x.Fragile
+	}
+	return false
+}
+
+func (x *UpdateProductTypeRequest) GetMaxWeightGrams() int32 {
+	if x != nil {
+		return x.MaxWeightGrams
+	}
+	return 0
+}
+
+type UpdateProductTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductType   *ProductType           `protobuf:"bytes,1,opt,name=product_type,json=productType,proto3" json:"product_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductTypeResponse) Reset() {
+	*x = UpdateProductTypeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductTypeResponse) ProtoMessage() {}
+
+func (x *UpdateProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateProductTypeResponse) GetProductType() *ProductType {
+	if x != nil {
+		return x.ProductType
+	}
+	return nil
+}
+
+type DeleteProductTypeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductTypeRequest) Reset() {
+	*x = DeleteProductTypeRequest{}
+	mi := &file_api_proto_pvz_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductTypeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductTypeRequest) ProtoMessage() {}
+
+func (x *DeleteProductTypeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductTypeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductTypeRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteProductTypeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteProductTypeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductTypeResponse) Reset() {
+	*x = DeleteProductTypeResponse{}
+	mi := &file_api_proto_pvz_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductTypeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductTypeResponse) ProtoMessage() {}
+
+func (x *DeleteProductTypeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_pvz_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductTypeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductTypeResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_pvz_proto_rawDescGZIP(), []int{85}
+}
+
+var File_api_proto_pvz_proto protoreflect.FileDescriptor
+
+const file_api_proto_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\x13api/proto/pvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\xf0\x02\n" +
+	"\x03PVZ\x12s\n" +
+	"\x02id\x18\x01 \x01(\tBc\x92A`26Уникальный идентификатор ПВЗJ&\"123e4567-e89b-12d3-a456-426614174000\"R\x02id\x12\x9e\x01\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBU\x92AR28Дата регистрации ПВЗ в системеJ\x16\"2023-01-15T12:00:00Z\"R\x10registrationDate\x12S\n" +
+	"\x04city\x18\x03 \x01(\tB?\x92A<2*Город расположения ПВЗJ\x0e\"Москва\"R\x04city\"\xb2\x02\n" +
+	"\x11GetPVZListRequest\x12\x88\x01\n" +
+	"\x05limit\x18\x01 \x01(\x05Br\x92Ao2fКоличество ПВЗ на странице, по умолчанию 100, не больше 1000J\x05\"100\"R\x05limit\x12\x91\x01\n" +
+	"\x06cursor\x18\x02 \x01(\tBy\x92Av2tКурсор из next_cursor предыдущего ответа, пустой для первой страницыR\x06cursor\"\xea\x01\n" +
+	"\x12GetPVZListResponse\x129\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZB\x18\x92A\x152\x13Массив ПВЗR\x04pvzs\x12\x98\x01\n" +
+	"\vnext_cursor\x18\x02 \x01(\tBw\x92At2rКурсор следующей страницы, пустой если это последняя страницаR\n" +
+	"nextCursor\"\x8f\x03\n" +
+	"\tReception\x12X\n" +
+	"\x02id\x18\x01 \x01(\tBH\x92AE2>Уникальный идентификатор приемкиJ\x03\"1\"R\x02id\x12\x90\x01\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBW\x92AT2:Дата и время проведения приемкиJ\x16\"2023-01-15T12:00:00Z\"R\bdateTime\x12B\n" +
+	"\x06pvz_id\x18\x03 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12Q\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusB \x92A\x1d2\x1bСтатус приемкиR\x06status\"\xbf\x05\n" +
+	"\aProduct\x12V\n" +
+	"\x02id\x18\x01 \x01(\tBF\x92AC2<Уникальный идентификатор товараJ\x03\"1\"R\x02id\x12\x86\x01\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBM\x92AJ20Дата и время приема товараJ\x16\"2023-01-15T12:00:00Z\"R\bdateTime\x12F\n" +
+	"\x04type\x18\x03 \x01(\tB2\x92A/2\x13Тип товараJ\x18\"электроника\"R\x04type\x12V\n" +
+	"\freception_id\x18\x04 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\x12\x97\x01\n" +
+	"\abarcode\x18\x05 \x01(\tB}\x92Az2gШтрихкод или артикул товара, если был указан при приемкеJ\x0f\"4601234567890\"R\abarcode\x12\x98\x01\n" +
+	"\border_id\x18\x06 \x01(\tB}\x92Az2lИдентификатор внешнего заказа, если был указан при приемкеJ\n" +
+	"\"ORD-1001\"R\aorderId\"\xc6\x01\n" +
+	"\rReceptionInfo\x12D\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB\x13\x92A\x102\x0eПриемкаR\treception\x12o\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductBB\x92A?2=Товары, принятые в рамках приемкиR\bproducts\"\xad\x01\n" +
+	"\aPVZInfo\x12*\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZB\v\x92A\b2\x06ПВЗR\x03pvz\x12v\n" +
+	"\n" +
+	"receptions\x18\x02 \x03(\v2\x15.pvz.v1.ReceptionInfoB?\x92A<2:Приемки ПВЗ за указанный периодR\n" +
+	"receptions\"a\n" +
+	"\x10CreatePVZRequest\x12M\n" +
+	"\x04city\x18\x01 \x01(\tB9\x92A62*Город расположения ПВЗJ\b\"Moscow\"R\x04city\"R\n" +
+	"\x11CreatePVZResponse\x12=\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZB\x1e\x92A\x1b2\x19Созданный ПВЗR\x03pvz\"\xdc\t\n" +
+	"\x12GetPVZSInfoRequest\x12\xa4\x01\n" +
+	"\n" +
+	"start_date\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampBi\x92Af2LНачальная дата диапазона, необязательнаяJ\x16\"2023-01-01T00:00:00Z\"R\tstartDate\x12\x9e\x01\n" +
+	"\bend_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampBg\x92Ad2JКонечная дата диапазона, необязательнаяJ\x16\"2023-01-31T00:00:00Z\"R\aendDate\x12T\n" +
+	"\x04page\x18\x03 \x01(\x05B@\x92A=26Номер страницы, по умолчанию 1J\x03\"1\"R\x04page\x12z\n" +
+	"\x05limit\x18\x04 \x01(\x05Bd\x92Aa2YКоличество элементов на странице, по умолчанию 10J\x04\"10\"R\x05limit\x12\x95\x01\n" +
+	"\x06cursor\x18\x05 \x01(\tB}\x92Az2xКурсор из next_cursor предыдущего ответа. Если задан, page не учитываетсяR\x06cursor\x12^\n" +
+	"\x06cities\x18\x06 \x03(\tBF\x92AC2AОставить только ПВЗ из этих городовR\x06cities\x12q\n" +
+	"\apvz_ids\x18\a \x03(\tBX\x92AU2SОставить только ПВЗ с этими идентификаторамиR\x06pvzIds\x12\x9a\x01\n" +
+	"\x12reception_statuses\x18\b \x03(\x0e2\x17.pvz.v1.ReceptionStatusBR\x92AO2MОставить только приемки с этими статусамиR\x11receptionStatuses\x12\xa3\x01\n" +
+	"\rproduct_types\x18\t \x03(\tB~\x92A{2yОставить только приемки, в которых есть товар одного из этих типовR\fproductTypes\"\xcf\x05\n" +
+	"\x13GetPVZSInfoResponse\x12i\n" +
+	"\x05items\x18\x01 \x03(\v2\x0f.pvz.v1.PVZInfoBB\x92A?2=Массив ПВЗ с приемками и товарамиR\x05items\x12G\n" +
+	"\x05total\x18\x02 \x01(\x05B1\x92A.2&Общее количество ПВЗJ\x04\"42\"R\x05total\x12H\n" +
+	"\x04page\x18\x03 \x01(\x05B4\x92A12*Номер текущей страницыJ\x03\"1\"R\x04page\x12^\n" +
+	"\x05limit\x18\x04 \x01(\x05BH\x92AE2=Количество элементов на страницеJ\x04\"10\"R\x05limit\x12\xbe\x01\n" +
+	"\tnext_page\x18\x05 \x01(\x05B\xa0\x01\x92A\x9c\x012\x94\x01Номер следующей страницы, 0 если это последняя страница или запрос был по курсоруJ\x03\"2\"R\bnextPage\x12\x98\x01\n" +
+	"\vnext_cursor\x18\x06 \x01(\tBw\x92At2rКурсор следующей страницы, пустой если это последняя страницаR\n" +
+	"nextCursor\"[\n" +
+	"\x15StartReceptionRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\"q\n" +
+	"\x16StartReceptionResponse\x12W\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB&\x92A#2!Созданная приемкаR\treception\"[\n" +
+	"\x15CloseReceptionRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\"o\n" +
+	"\x16CloseReceptionResponse\x12U\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB$\x92A!2\x1fЗакрытая приемкаR\treception\"o\n" +
+	"\x15PauseReceptionRequest\x12V\n" +
+	"\freception_id\x18\x01 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\"\x7f\n" +
+	"\x16PauseReceptionResponse\x12e\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB4\x92A12/Приостановленная приемкаR\treception\"p\n" +
+	"\x16ResumeReceptionRequest\x12V\n" +
+	"\freception_id\x18\x01 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\"|\n" +
+	"\x17ResumeReceptionResponse\x12a\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB0\x92A-2+Возобновленная приемкаR\treception\"\x8c\x02\n" +
+	"\x16CancelReceptionRequest\x12V\n" +
+	"\freception_id\x18\x01 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\x12\x99\x01\n" +
+	"\x06reason\x18\x02 \x01(\tB\x80\x01\x92A}2UНеобязательная причина, не длиннее 255 символовJ$\"Поставка не пришла\"R\x06reason\"t\n" +
+	"\x17CancelReceptionResponse\x12Y\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB(\x92A%2#Отмененная приемкаR\treception\"\x84\x02\n" +
+	"\x16ReopenReceptionRequest\x12V\n" +
+	"\freception_id\x18\x01 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\x12\x91\x01\n" +
+	"\x06reason\x18\x02 \x01(\tBy\x92Av2UНеобязательная причина, не длиннее 255 символовJ\x1d\"Пропущен товар\"R\x06reason\"\x89\x01\n" +
+	"\x17ReopenReceptionResponse\x12n\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionB=\x92A:28Приемка, возвращенная в работуR\treception\"t\n" +
+	"\x1aGetReceptionHistoryRequest\x12V\n" +
+	"\freception_id\x18\x01 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\"\xe3\a\n" +
+	"\x15ReceptionStatusChange\x12A\n" +
+	"\x02id\x18\x01 \x01(\tB1\x92A.2'Идентификатор записиJ\x03\"1\"R\x02id\x12V\n" +
+	"\freception_id\x18\x02 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\x12\x8c\x01\n" +
+	"\vfrom_status\x18\x03 \x01(\tBk\x92Ah2WСтатус до перехода, пустой при открытии приемкиJ\r\"in_progress\"R\n" +
+	"fromStatus\x12T\n" +
+	"\tto_status\x18\x04 \x01(\tB7\x92A42(Статус после переходаJ\b\"paused\"R\btoStatus\x12i\n" +
+	"\x06action\x18\x05 \x01(\tBQ\x92AN2CДействие: start, pause, resume, close, cancel или reopenJ\a\"pause\"R\x06action\x12`\n" +
+	"\x06reason\x18\x06 \x01(\tBH\x92AE2CПричина отмены или возврата в работуR\x06reason\x12\x9c\x01\n" +
+	"\auser_id\x18\a \x01(\tB\x82\x01\x92A\x7f2xПользователь, выполнивший переход. Пустой для внутренних вызововJ\x03\"7\"R\x06userId\x12i\n" +
+	"\x04role\x18\b \x01(\tBU\x92AR2DРоль пользователя на момент переходаJ\n" +
+	"\"employee\"R\x04role\x12s\n" +
+	"\n" +
+	"changed_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampB8\x92A52\x1bВремя переходаJ\x16\"2023-01-15T12:00:00Z\"R\tchangedAt\"\xa7\x01\n" +
+	"\x1bGetReceptionHistoryResponse\x12\x87\x01\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.pvz.v1.ReceptionStatusChangeBR\x92AO2MПереходы приемки от открытия к последнемуR\x05items\"\xa5\x04\n" +
+	"\x11AddProductRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12F\n" +
+	"\x04type\x18\x02 \x01(\tB2\x92A/2\x13Тип товараJ\x18\"электроника\"R\x04type\x12\xd4\x01\n" +
+	"\abarcode\x18\x03 \x01(\tB\xb9\x01\x92A\xb5\x012\xa1\x01Необязательный штрихкод или артикул, не длиннее 64 символов. Уникален в пределах приемкиJ\x0f\"4601234567890\"R\abarcode\x12\xac\x01\n" +
+	"\border_id\x18\x04 \x01(\tB\x90\x01\x92A\x8c\x012~Необязательный идентификатор внешнего заказа, не длиннее 64 символовJ\n" +
+	"\"ORD-1001\"R\aorderId\"g\n" +
+	"\x12AddProductResponse\x12Q\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductB&\x92A#2!Добавленный товарR\aproduct\"\xdb\x03\n" +
+	"\vProductItem\x12F\n" +
+	"\x04type\x18\x01 \x01(\tB2\x92A/2\x13Тип товараJ\x18\"электроника\"R\x04type\x12\xd4\x01\n" +
+	"\abarcode\x18\x02 \x01(\tB\xb9\x01\x92A\xb5\x012\xa1\x01Необязательный штрихкод или артикул, не длиннее 64 символов. Уникален в пределах приемкиJ\x0f\"4601234567890\"R\abarcode\x12\xac\x01\n" +
+	"\border_id\x18\x03 \x01(\tB\x90\x01\x92A\x8c\x012~Необязательный идентификатор внешнего заказа, не длиннее 64 символовJ\n" +
+	"\"ORD-1001\"R\aorderId\"\xbb\x01\n" +
+	"\x12AddProductsRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12a\n" +
+	"\bproducts\x18\x02 \x03(\v2\x13.pvz.v1.ProductItemB0\x92A-2+Отсканированные товарыR\bproducts\"\x8d\x01\n" +
+	"\x13AddProductsResponse\x12v\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductBI\x92AF2DДобавленные товары в порядке запросаR\bproducts\"^\n" +
+	"\x18DeleteLastProductRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\"\x1b\n" +
+	"\x19DeleteLastProductResponse\"\xac\x01\n" +
+	"\x14DeleteProductRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12P\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB1\x92A.2'Идентификатор товараJ\x03\"5\"R\tproductId\"\x17\n" +
+	"\x15DeleteProductResponse\"\xad\x01\n" +
+	"\x15RestoreProductRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12P\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tB1\x92A.2'Идентификатор товараJ\x03\"5\"R\tproductId\"C\n" +
+	"\x16RestoreProductResponse\x12)\n" +
+	"\aproduct\x18\x01 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\"\x83\x01\n" +
+	"\x1cFindProductsByBarcodeRequest\x12c\n" +
+	"\abarcode\x18\x01 \x01(\tBI\x92AF23Штрихкод или артикул товараJ\x0f\"4601234567890\"R\abarcode\"L\n" +
+	"\x1dFindProductsByBarcodeResponse\x12+\n" +
+	"\bproducts\x18\x01 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"\xaf\x01\n" +
+	"\x15AssignEmployeeRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12R\n" +
+	"\auser_id\x18\x02 \x01(\tB9\x92A62/Идентификатор сотрудникаJ\x03\"7\"R\x06userId\"\x18\n" +
+	"\x16AssignEmployeeResponse\"\xb1\x01\n" +
+	"\x17UnassignEmployeeRequest\x12B\n" +
+	"\x06pvz_id\x18\x01 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12R\n" +
+	"\auser_id\x18\x02 \x01(\tB9\x92A62/Идентификатор сотрудникаJ\x03\"7\"R\x06userId\"\x1a\n" +
+	"\x18UnassignEmployeeResponse\"\x8f\x06\n" +
+	"\x12GetAuditLogRequest\x12\x81\x01\n" +
+	"\auser_id\x18\x01 \x01(\tBh\x92Ae2^Идентификатор пользователя, выполнившего действиеJ\x03\"7\"R\x06userId\x12|\n" +
+	"\apvz_ids\x18\x02 \x03(\tBc\x92A`2^Идентификаторы ПВЗ, пустой список - без ограниченияR\x06pvzIds\x12l\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampB<\x92A92\x1fНачало интервалаJ\x16\"2025-01-01T00:00:00Z\"R\x04from\x12f\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB:\x92A72\x1dКонец интервалаJ\x16\"2025-01-31T23:59:59Z\"R\x02to\x12\x8c\x01\n" +
+	"\x05limit\x18\x05 \x01(\x05Bv\x92As2kКоличество записей на странице, по умолчанию 10, не больше 30J\x04\"10\"R\x05limit\x12\x91\x01\n" +
+	"\x06cursor\x18\x06 \x01(\tBy\x92Av2tКурсор из next_cursor предыдущего ответа, пустой для первой страницыR\x06cursor\"\xd0\b\n" +
+	"\n" +
+	"AuditEntry\x12A\n" +
+	"\x02id\x18\x01 \x01(\tB1\x92A.2'Идентификатор записиJ\x03\"1\"R\x02id\x12\x9f\x01\n" +
+	"\auser_id\x18\x02 \x01(\tB\x85\x01\x92A\x81\x012zПользователь, выполнивший действие. Пустой для внутренних вызововJ\x03\"7\"R\x06userId\x12i\n" +
+	"\x04role\x18\x03 \x01(\tBU\x92AR2DРоль пользователя на момент действияJ\n" +
+	"\"employee\"R\x04role\x12[\n" +
+	"\x05dummy\x18\x04 \x01(\bBE\x92AB2@Действие выполнено по токену DummyLoginR\x05dummy\x12\xbb\x01\n" +
+	"\x06action\x18\x05 \x01(\tB\xa2\x01\x92A\x9e\x012\x8a\x01Действие: pvz_created, reception_started, reception_closed, product_added, product_deleted, employee_assigned, employee_unassignedJ\x0f\"product_added\"R\x06action\x12B\n" +
+	"\x06pvz_id\x18\x06 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12Q\n" +
+	"\freception_id\x18\a \x01(\tB.\x92A+2)Идентификатор приемкиR\vreceptionId\x12K\n" +
+	"\n" +
+	"product_id\x18\b \x01(\tB,\x92A)2'Идентификатор товараR\tproductId\x12~\n" +
+	"\x0etarget_user_id\x18\t \x01(\tBX\x92AU2SСотрудник, которого назначили или сняли с ПВЗR\ftargetUserId\x12s\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampB8\x92A52\x1bВремя действияJ\x16\"2025-01-15T12:00:00Z\"R\tcreatedAt\"\x9c\x02\n" +
+	"\x13GetAuditLogResponse\x12j\n" +
+	"\x05items\x18\x01 \x03(\v2\x12.pvz.v1.AuditEntryB@\x92A=2;Записи журнала от новых к старымR\x05items\x12\x98\x01\n" +
+	"\vnext_cursor\x18\x02 \x01(\tBw\x92At2rКурсор следующей страницы, пустой если это последняя страницаR\n" +
+	"nextCursor\"[\n" +
+	"\x11DummyLoginRequest\x12F\n" +
+	"\x04role\x18\x01 \x01(\tB2\x92A/2!Роль пользователяJ\n" +
+	"\"employee\"R\x04role\"?\n" +
+	"\x12DummyLoginResponse\x12)\n" +
+	"\x05token\x18\x01 \x01(\tB\x13\x92A\x102\x0eJWT токенR\x05token\"\xf0\x01\n" +
+	"\x0fRegisterRequest\x12M\n" +
+	"\x05email\x18\x01 \x01(\tB7\x92A42\x1eEmail пользователяJ\x12\"user@example.com\"R\x05email\x12F\n" +
+	"\bpassword\x18\x02 \x01(\tB*\x92A'2%Пароль пользователяR\bpassword\x12F\n" +
+	"\x04role\x18\x03 \x01(\tB2\x92A/2!Роль пользователяJ\n" +
+	"\"employee\"R\x04role\"\xf8\x01\n" +
+	"\x10RegisterResponse\x12M\n" +
+	"\x02id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"1\"R\x02id\x12M\n" +
+	"\x05email\x18\x02 \x01(\tB7\x92A42\x1eEmail пользователяJ\x12\"user@example.com\"R\x05email\x12F\n" +
+	"\x04role\x18\x03 \x01(\tB2\x92A/2!Роль пользователяJ\n" +
+	"\"employee\"R\x04role\"\xa5\x01\n" +
+	"\fLoginRequest\x12M\n" +
+	"\x05email\x18\x01 \x01(\tB7\x92A42\x1eEmail пользователяJ\x12\"user@example.com\"R\x05email\x12F\n" +
+	"\bpassword\x18\x02 \x01(\tB*\x92A'2%Пароль пользователяR\bpassword\"\xb0\x01\n" +
+	"\rLoginResponse\x12)\n" +
+	"\x05token\x18\x01 \x01(\tB\x13\x92A\x102\x0eJWT токенR\x05token\x12t\n" +
+	"\rrefresh_token\x18\x02 \x01(\tBO\x92AL2JRefresh токен для получения нового JWT токенаR\frefreshToken\"\xb4\x01\n" +
+	"\x0eRefreshRequest\x12\xa1\x01\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB|\x92Ay2wRefresh токен, полученный при авторизации или предыдущем обновленииR\frefreshToken\"\x85\x01\n" +
+	"\x0fRefreshResponse\x12)\n" +
+	"\x05token\x18\x01 \x01(\tB\x13\x92A\x102\x0eJWT токенR\x05token\x12G\n" +
+	"\rrefresh_token\x18\x02 \x01(\tB\"\x92A\x1f2\x1dНовый refresh токенR\frefreshToken\"\xcd\x01\n" +
+	"\rLogoutRequest\x12\xbb\x01\n" +
+	"\rrefresh_token\x18\x01 \x01(\tB\x95\x01\x92A\x91\x012\x8e\x01Refresh токен, который нужно отозвать вместе с текущим JWT токеном, необязательныйR\frefreshToken\"\x10\n" +
+	"\x0eLogoutResponse\"\xeb\x01\n" +
+	"\x15ChangePasswordRequest\x12C\n" +
+	"\fold_password\x18\x01 \x01(\tB \x92A\x1d2\x1bТекущий парольR\voldPassword\x12\x8c\x01\n" +
+	"\fnew_password\x18\x02 \x01(\tBi\x92Af2dНовый пароль, должен соответствовать политике паролейR\vnewPassword\"\x18\n" +
+	"\x16ChangePasswordResponse\"l\n" +
+	"\x1bRequestPasswordResetRequest\x12M\n" +
+	"\x05email\x18\x01 \x01(\tB7\x92A42\x1eEmail пользователяJ\x12\"user@example.com\"R\x05email\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"\xf5\x01\n" +
+	"\x14ResetPasswordRequest\x12N\n" +
+	"\x05token\x18\x01 \x01(\tB8\x92A523Токен сброса из уведомленияR\x05token\x12\x8c\x01\n" +
+	"\fnew_password\x18\x02 \x01(\tBi\x92Af2dНовый пароль, должен соответствовать политике паролейR\vnewPassword\"\x17\n" +
+	"\x15ResetPasswordResponse\"\xba\x03\n" +
+	"\x0fWatchPVZRequest\x12\xaa\x01\n" +
+	"\apvz_ids\x18\x01 \x03(\tB\x90\x01\x92A\x8c\x012\x89\x01Идентификаторы ПВЗ, события которых нужно получать. Пустой список - все ПВЗR\x06pvzIds\x12\xf9\x01\n" +
+	"\rfrom_sequence\x18\x02 \x01(\x04B\xd3\x01\x92A\xcf\x012\xc6\x01Номер последнего полученного события. Будут отправлены события с большими номерами, 0 - только новые событияJ\x04\"42\"R\ffromSequence\"\xb2\x04\n" +
+	"\bPVZEvent\x12@\n" +
+	"\bsequence\x18\x01 \x01(\x04B$\x92A!2\x19Номер событияJ\x04\"42\"R\bsequence\x12D\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x14.pvz.v1.PVZEventTypeB\x1a\x92A\x172\x15Тип событияR\x04type\x12B\n" +
+	"\x06pvz_id\x18\x03 \x01(\tB+\x92A(2!Идентификатор ПВЗJ\x03\"1\"R\x05pvzId\x12V\n" +
+	"\freception_id\x18\x04 \x01(\tB3\x92A02)Идентификатор приемкиJ\x03\"1\"R\vreceptionId\x12\x8c\x01\n" +
+	"\n" +
+	"product_id\x18\x05 \x01(\tBm\x92Aj2cИдентификатор товара, если событие относится к товаруJ\x03\"1\"R\tproductId\x12s\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB6\x92A32\x19Время событияJ\x16\"2023-01-15T12:00:00Z\"R\n" +
+	"occurredAt\"\x94\x04\n" +
+	"\x04User\x12M\n" +
+	"\x02id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"7\"R\x02id\x12M\n" +
+	"\x05email\x18\x02 \x01(\tB7\x92A42\x1eEmail пользователяJ\x12\"user@example.com\"R\x05email\x12F\n" +
+	"\x04role\x18\x03 \x01(\tB2\x92A/2!Роль пользователяJ\n" +
+	"\"employee\"R\x04role\x12O\n" +
+	"\bdisabled\x18\x04 \x01(\bB3\x92A02.Учетная запись отключенаR\bdisabled\x12a\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB&\x92A#2!Время регистрацииR\tcreatedAt\x12r\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampB7\x92A422Время последнего измененияR\tupdatedAt\"\xc2\x02\n" +
+	"\x10ListUsersRequest\x12\x99\x01\n" +
+	"\x05limit\x18\x01 \x01(\x05B\x82\x01\x92A\x7f2wКоличество пользователей на странице, по умолчанию 10, не больше 30J\x04\"10\"R\x05limit\x12\x91\x01\n" +
+	"\x06cursor\x18\x02 \x01(\tBy\x92Av2tКурсор из next_cursor предыдущего ответа, пустой для первой страницыR\x06cursor\"\xc7\x01\n" +
+	"\x11ListUsersResponse\x12\"\n" +
+	"\x05users\x18\x01 \x03(\v2\f.pvz.v1.UserR\x05users\x12\x8d\x01\n" +
+	"\vnext_cursor\x18\x02 \x01(\tBl\x92Ai2gКурсор следующей страницы, пустой на последней страницеR\n" +
+	"nextCursor\"h\n" +
+	"\x0eGetUserRequest\x12V\n" +
+	"\auser_id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"7\"R\x06userId\"3\n" +
+	"\x0fGetUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.pvz.v1.UserR\x04user\"\xb4\x01\n" +
+	"\x15UpdateUserRoleRequest\x12V\n" +
+	"\auser_id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"7\"R\x06userId\x12C\n" +
+	"\x04role\x18\x02 \x01(\tB/\x92A,2\x1dНовая роль из RBACJ\v\"moderator\"R\x04role\":\n" +
+	"\x16UpdateUserRoleResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.pvz.v1.UserR\x04user\"o\n" +
+	"\x15DeactivateUserRequest\x12V\n" +
+	"\auser_id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"7\"R\x06userId\":\n" +
+	"\x16DeactivateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.pvz.v1.UserR\x04user\"m\n" +
+	"\x13ActivateUserRequest\x12V\n" +
+	"\auser_id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"7\"R\x06userId\"8\n" +
+	"\x14ActivateUserResponse\x12 \n" +
+	"\x04user\x18\x01 \x01(\v2\f.pvz.v1.UserR\x04user\"k\n" +
+	"\x11DeleteUserRequest\x12V\n" +
+	"\auser_id\x18\x01 \x01(\tB=\x92A:23Идентификатор пользователяJ\x03\"7\"R\x06userId\"\x14\n" +
+	"\x12DeleteUserResponse\"\xaa\x04\n" +
+	"\vProductType\x12Y\n" +
+	"\x04name\x18\x01 \x01(\tBE\x92AB2&Название типа товараJ\x18\"электроника\"R\x04name\x128\n" +
+	"\afragile\x18\x02 \x01(\bB\x1e\x92A\x1b2\x19Хрупкий товарR\afragile\x12\x98\x01\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x05Bn\x92Ak2aМаксимальный вес товара в граммах, 0 - без ограниченияJ\x06\"5000\"R\x0emaxWeightGrams\x12w\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampB<\x92A927Время добавления в справочникR\tcreatedAt\x12r\n" +
+	"\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampB7\x92A422Время последнего измененияR\tupdatedAt\"\x19\n" +
+	"\x17ListProductTypesRequest\"T\n" +
+	"\x18ListProductTypesResponse\x128\n" +
+	"\rproduct_types\x18\x01 \x03(\v2\x13.pvz.v1.ProductTypeR\fproductTypes\"\xe9\x02\n" +
+	"\x18CreateProductTypeRequest\x12x\n" +
+	"\x04name\x18\x01 \x01(\tBd\x92Aa2OНазвание типа товара, не длиннее 50 символовJ\x0e\"посуда\"R\x04name\x128\n" +
+	"\afragile\x18\x02 \x01(\bB\x1e\x92A\x1b2\x19Хрупкий товарR\afragile\x12\x98\x01\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x05Bn\x92Ak2aМаксимальный вес товара в граммах, 0 - без ограниченияJ\x06\"5000\"R\x0emaxWeightGrams\"S\n" +
+	"\x19CreateProductTypeResponse\x126\n" +
+	"\fproduct_type\x18\x01 \x01(\v2\x13.pvz.v1.ProductTypeR\vproductType\"\xc0\x02\n" +
+	"\x18UpdateProductTypeRequest\x12O\n" +
+	"\x04name\x18\x01 \x01(\tB;\x92A82&Название типа товараJ\x0e\"посуда\"R\x04name\x128\n" +
+	"\afragile\x18\x02 \x01(\bB\x1e\x92A\x1b2\x19Хрупкий товарR\afragile\x12\x98\x01\n" +
+	"\x10max_weight_grams\x18\x03 \x01(\x05Bn\x92Ak2aМаксимальный вес товара в граммах, 0 - без ограниченияJ\x06\"5000\"R\x0emaxWeightGrams\"S\n" +
+	"\x19UpdateProductTypeResponse\x126\n" +
+	"\fproduct_type\x18\x01 \x01(\v2\x13.pvz.v1.ProductTypeR\vproductType\"k\n" +
+	"\x18DeleteProductTypeRequest\x12O\n" +
+	"\x04name\x18\x01 \x01(\tB;\x92A82&Название типа товараJ\x0e\"посуда\"R\x04name\"\x1b\n" +
+	"\x19DeleteProductTypeResponse*\x8d\x01\n" +
+	"\x0fReceptionStatus\x12 \n" +
+	"\x1cRECEPTION_STATUS_IN_PROGRESS\x10\x00\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01\x12\x1b\n" +
+	"\x17RECEPTION_STATUS_PAUSED\x10\x02\x12\x1e\n" +
+	"\x1aRECEPTION_STATUS_CANCELLED\x10\x03*\xfe\x02\n" +
+	"\fPVZEventType\x12\x1e\n" +
+	"\x1aPVZ_EVENT_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" PVZ_EVENT_TYPE_RECEPTION_STARTED\x10\x01\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_CLOSED\x10\x02\x12 \n" +
+	"\x1cPVZ_EVENT_TYPE_PRODUCT_ADDED\x10\x03\x12\"\n" +
+	"\x1ePVZ_EVENT_TYPE_PRODUCT_DELETED\x10\x04\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_PRODUCT_RESTORED\x10\x05\x12#\n" +
+	"\x1fPVZ_EVENT_TYPE_RECEPTION_PAUSED\x10\x06\x12$\n" +
+	" PVZ_EVENT_TYPE_RECEPTION_RESUMED\x10\a\x12&\n" +
+	"\"PVZ_EVENT_TYPE_RECEPTION_CANCELLED\x10\b\x12%\n" +
+	"!PVZ_EVENT_TYPE_RECEPTION_REOPENED\x10\t2\xe3\x83\x01\n" +
+	"\n" +
+	"PVZService\x12\xb7\x03\n" +
+	"\n" +
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\"\xf1\x02\x92A\xda\x02\n" +
+	"\x03PVZ\x12$Получить список ПВЗ\x1a\x93\x01Возвращает пункты выдачи заказов с их основными данными постранично, по курсоруJD\n" +
+	"\x03200\x12=\n" +
+	"\x1bУспешный ответ\x12\x1e\n" +
+	"\x1c\x1a\x1a.pvz.v1.GetPVZListResponseJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\r\x12\v/api/v1/pvz\x12\x88\x04\n" +
+	"\tCreatePVZ\x12\x18.pvz.v1.CreatePVZRequest\x1a\x19.pvz.v1.CreatePVZResponse\"\xc5\x03\x92A\xab\x03\n" +
+	"\x03PVZ\x12\x17Создание ПВЗ\x1anСоздает пункт выдачи заказов в одном из разрешенных городовJC\n" +
+	"\x03200\x12<\n" +
+	"\x1bУспешный ответ\x12\x1d\n" +
+	"\x1b\x1a\x19.pvz.v1.CreatePVZResponseJ>\n" +
+	"\x03400\x127\n" +
+	"\x1dНеверный запрос\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJC\n" +
+	"\x03409\x12<\n" +
+	"\"ПВЗ уже существует\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/api/v1/pvz\x12\x9e\x04\n" +
+	"\vGetPVZSInfo\x12\x1a.pvz.v1.GetPVZSInfoRequest\x1a\x1b.pvz.v1.GetPVZSInfoResponse\"\xd5\x03\x92A\xb9\x03\n" +
+	"\x03PVZ\x12PПолучение списка ПВЗ с приемками и товарами\x1a\x85\x01Возвращает страницу ПВЗ с приемками за указанный период и товарами в нихJE\n" +
+	"\x03200\x12>\n" +
+	"\x1bУспешный ответ\x12\x1f\n" +
+	"\x1d\x1a\x1b.pvz.v1.GetPVZSInfoResponseJ>\n" +
+	"\x03400\x127\n" +
+	"\x1dНеверный запрос\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/pvz/info\x12\xa8\x04\n" +
+	"\x0eStartReception\x12\x1d.pvz.v1.StartReceptionRequest\x1a\x1e.pvz.v1.StartReceptionResponse\"\xd6\x03\x92A\xb5\x03\n" +
+	"\n" +
+	"Receptions\x129Создание новой приемки товаров\x1asОткрывает приемку в ПВЗ, если в нем нет другой открытой приемкиJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.StartReceptionResponseJZ\n" +
+	"\x03400\x12S\n" +
+	"9В ПВЗ уже есть открытая приемка\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/receptions\x12\xf6\x04\n" +
+	"\x0eCloseReception\x12\x1d.pvz.v1.CloseReceptionRequest\x1a\x1e.pvz.v1.CloseReceptionResponse\"\xa4\x04\x92A\xec\x03\n" +
+	"\n" +
+	"Receptions\x12iЗакрытие последней открытой приемки товаров в рамках ПВЗ\x1a\x82\x01Закрывает текущую открытую приемку в ПВЗ, в том числе приостановленнуюJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.CloseReceptionResponseJQ\n" +
+	"\x03400\x12J\n" +
+	"0В ПВЗ нет открытой приемки\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02.:\x01*\")/api/v1/pvz/{pvz_id}/close_last_reception\x12\xf8\x05\n" +
+	"\x0ePauseReception\x12\x1d.pvz.v1.PauseReceptionRequest\x1a\x1e.pvz.v1.PauseReceptionResponse\"\xa6\x05\x92A\xf0\x04\n" +
+	"\n" +
+	"Receptions\x12'Приостановка приемки\x1a\xd0\x01Переводит приемку из статуса in_progress в paused. Пока приемка приостановлена, товары в нее не добавляются и не удаляютсяJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.PauseReceptionResponseJD\n" +
+	"\x03400\x12=\n" +
+	"#Приемка не в работе\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJC\n" +
+	"\x03404\x12<\n" +
+	"\"Приемка не найдена\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02,:\x01*\"'/api/v1/receptions/{reception_id}/pause\x12\x9b\x05\n" +
+	"\x0fResumeReception\x12\x1e.pvz.v1.ResumeReceptionRequest\x1a\x1f.pvz.v1.ResumeReceptionResponse\"\xc6\x04\x92A\x8f\x04\n" +
+	"\n" +
+	"Receptions\x12)Возобновление приемки\x1a`Переводит приостановленную приемку обратно в in_progressJI\n" +
+	"\x03200\x12B\n" +
+	"\x1bУспешный ответ\x12#\n" +
+	"!\x1a\x1f.pvz.v1.ResumeReceptionResponseJQ\n" +
+	"\x03400\x12J\n" +
+	"0Приемка не приостановлена\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJC\n" +
+	"\x03404\x12<\n" +
+	"\"Приемка не найдена\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/receptions/{reception_id}/resume\x12\x89\x06\n" +
+	"\x0fCancelReception\x12\x1e.pvz.v1.CancelReceptionRequest\x1a\x1f.pvz.v1.CancelReceptionResponse\"\xb4\x05\x92A\xfd\x04\n" +
+	"\n" +
+	"Receptions\x12\x1bОтмена приемки\x1a\xa8\x01Отменяет приемку в работе или приостановленную. Отмененную приемку нельзя вернуть в работуJI\n" +
+	"\x03200\x12B\n" +
+	"\x1bУспешный ответ\x12#\n" +
+	"!\x1a\x1f.pvz.v1.CancelReceptionResponseJ\x83\x01\n" +
+	"\x03400\x12|\n" +
+	"bПриемка уже завершена или причина длиннее 255 символов\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJC\n" +
+	"\x03404\x12<\n" +
+	"\"Приемка не найдена\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/receptions/{reception_id}/cancel\x12\xfd\x06\n" +
+	"\x0fReopenReception\x12\x1e.pvz.v1.ReopenReceptionRequest\x1a\x1f.pvz.v1.ReopenReceptionResponse\"\xa8\x06\x92A\xf1\x05\n" +
+	"\n" +
+	"Receptions\x12>Возврат закрытой приемки в работу\x1a\xc9\x01Модератор (право reception:reopen) возвращает закрытую приемку в статус in_progress, если в ПВЗ нет другой открытой приемкиJI\n" +
+	"\x03200\x12B\n" +
+	"\x1bУспешный ответ\x12#\n" +
+	"!\x1a\x1f.pvz.v1.ReopenReceptionResponseJ\xb3\x01\n" +
+	"\x03400\x12\xab\x01\n" +
+	"\x90\x01Приемка не закрыта, в ПВЗ есть открытая приемка или причина длиннее 255 символов\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJC\n" +
+	"\x03404\x12<\n" +
+	"\"Приемка не найдена\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/receptions/{reception_id}/reopen\x12\xfc\x04\n" +
+	"\x13GetReceptionHistory\x12\".pvz.v1.GetReceptionHistoryRequest\x1a#.pvz.v1.GetReceptionHistoryResponse\"\x9b\x04\x92A\xe6\x03\n" +
+	"\n" +
+	"Receptions\x12.История статусов приемки\x1a\x80\x01Возвращает переходы приемки между статусами от открытия к последнемуJM\n" +
+	"\x03200\x12F\n" +
+	"\x1bУспешный ответ\x12'\n" +
+	"%\x1a#.pvz.v1.GetReceptionHistoryResponseJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJC\n" +
+	"\x03404\x12<\n" +
+	"\"Приемка не найдена\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02+\x12)/api/v1/receptions/{reception_id}/history\x12\xd5\a\n" +
+	"\n" +
+	"AddProduct\x12\x19.pvz.v1.AddProductRequest\x1a\x1a.pvz.v1.AddProductResponse\"\x8f\a\x92A\xf0\x06\n" +
+	"\bProducts\x12BДобавление товара в текущую приемку\x1a\xa5\x02Добавляет товар в открытую приемку ПВЗ. Тип товара должен быть в справочнике типов. Штрихкод и номер заказа необязательны, штрихкод уникален в пределах приемкиJD\n" +
+	"\x03200\x12=\n" +
+	"\x1bУспешный ответ\x12\x1e\n" +
+	"\x1c\x1a\x1a.pvz.v1.AddProductResponseJ\xe1\x01\n" +
+	"\x03400\x12\xd9\x01\n" +
+	"\xbe\x01Тип товара не из справочника, слишком длинный штрихкод или номер заказа, либо в ПВЗ нет открытой приемки\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ{\n" +
+	"\x03409\x12t\n" +
+	"ZТовар с таким штрихкодом уже принят в эту приемку\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/products\x12\xb1\b\n" +
+	"\vAddProducts\x12\x1a.pvz.v1.AddProductsRequest\x1a\x1b.pvz.v1.AddProductsResponse\"\xe8\a\x92A\xc3\a\n" +
+	"\bProducts\x12UПакетное добавление товаров в текущую приемку\x1a\xd1\x01Добавляет несколько товаров в открытую приемку ПВЗ одной транзакцией. Либо добавляются все товары, либо ни одногоJt\n" +
+	"\x03200\x12m\n" +
+	"JУспешный ответ, товары в порядке запроса\x12\x1f\n" +
+	"\x1d\x1a\x1b.pvz.v1.AddProductsResponseJ\xb0\x02\n" +
+	"\x03400\x12\xa8\x02\n" +
+	"\x8d\x02Пустой или слишком большой список товаров, тип товара не из справочника, слишком длинный штрихкод или номер заказа, либо в ПВЗ нет открытой приемки\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ\x8f\x01\n" +
+	"\x03409\x12\x87\x01\n" +
+	"mШтрихкод повторяется в запросе или уже принят в эту приемку\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/products/batch\x12\x88\x06\n" +
+	"\x11DeleteLastProduct\x12 .pvz.v1.DeleteLastProductRequest\x1a!.pvz.v1.DeleteLastProductResponse\"\xad\x05\x92A\xf6\x04\n" +
+	"\bProducts\x12nУдаление последнего добавленного товара из текущей приемки\x1a\xef\x01Удаляет последний добавленный товар открытой приемки ПВЗ (LIFO по id товара). Удаление мягкое, товар можно восстановить через RestoreProductJK\n" +
+	"\x03200\x12D\n" +
+	"\x1bУспешный ответ\x12%\n" +
+	"#\x1a!.pvz.v1.DeleteLastProductResponseJh\n" +
+	"\x03400\x12a\n" +
+	"GНет открытой приемки или приемка пуста\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02-:\x01*\"(/api/v1/pvz/{pvz_id}/delete_last_product\x12\xf7\x05\n" +
+	"\rDeleteProduct\x12\x1c.pvz.v1.DeleteProductRequest\x1a\x1d.pvz.v1.DeleteProductResponse\"\xa8\x05\x92A\xf2\x04\n" +
+	"\bProducts\x12@Удаление товара из текущей приемки\x1a\xc5\x01Мягко удаляет товар открытой приемки ПВЗ по id. Товар можно восстановить через RestoreProduct, пока приемка открытаJG\n" +
+	"\x03200\x12@\n" +
+	"\x1bУспешный ответ\x12!\n" +
+	"\x1f\x1a\x1d.pvz.v1.DeleteProductResponseJG\n" +
+	"\x03400\x12@\n" +
+	"&Нет открытой приемки\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJw\n" +
+	"\x03404\x12p\n" +
+	"VТовара нет в открытой приемке или он уже удален\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02,**/api/v1/pvz/{pvz_id}/products/{product_id}\x12\xa2\x06\n" +
+	"\x0eRestoreProduct\x12\x1d.pvz.v1.RestoreProductRequest\x1a\x1e.pvz.v1.RestoreProductResponse\"\xd0\x05\x92A\x8f\x05\n" +
+	"\bProducts\x12>Восстановление удаленного товара\x1aUОтменяет удаление товара открытой приемки ПВЗJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.RestoreProductResponseJk\n" +
+	"\x03400\x12d\n" +
+	"JНет открытой приемки или товар не удален\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJW\n" +
+	"\x03404\x12P\n" +
+	"6Товара нет в открытой приемке\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ\x88\x01\n" +
+	"\x03409\x12\x80\x01\n" +
+	"fШтрихкод товара уже принят в эту приемку другим товаром\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x027:\x01*\"2/api/v1/pvz/{pvz_id}/products/{product_id}/restore\x12\xfe\x04\n" +
+	"\x15FindProductsByBarcode\x12$.pvz.v1.FindProductsByBarcodeRequest\x1a%.pvz.v1.FindProductsByBarcodeResponse\"\x97\x04\x92A\xfb\x03\n" +
+	"\bProducts\x121Поиск товаров по штрихкоду\x1a\xad\x01Ищет товары с указанным штрихкодом во всех приемках доступных пользователю ПВЗ, новые первымиJO\n" +
+	"\x03200\x12H\n" +
+	"\x1bУспешный ответ\x12)\n" +
+	"'\x1a%.pvz.v1.FindProductsByBarcodeResponseJh\n" +
+	"\x03400\x12a\n" +
+	"GШтрихкод не указан или слишком длинный\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x12\x12\x10/api/v1/products\x12\xbf\x06\n" +
+	"\bWatchPVZ\x12\x17.pvz.v1.WatchPVZRequest\x1a\x10.pvz.v1.PVZEvent\"\x85\x06\x92A\xe8\x05\n" +
+	"\x03PVZ\x12EПодписка на события приемок и товаров\x1a\x94\x03Отправляет событие каждый раз, когда в выбранных ПВЗ открывается или закрывается приемка, добавляется или удаляется товар. Для продолжения после переподключения передайте номер последнего полученного события в from_sequenceJ8\n" +
+	"\x03200\x121\n" +
+	"\x19Поток событий\x12\x14\n" +
+	"\x12\x1a\x10.pvz.v1.PVZEventJv\n" +
+	"\x03400\x12o\n" +
+	"UСобытия с указанным номером больше недоступны\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x13\x12\x11/api/v1/pvz/watch0\x01\x12\xba\x05\n" +
+	"\x0eAssignEmployee\x12\x1d.pvz.v1.AssignEmployeeRequest\x1a\x1e.pvz.v1.AssignEmployeeResponse\"\xe8\x04\x92A\xbb\x04\n" +
+	"\x03PVZ\x125Назначение сотрудника на ПВЗ\x1a\xc4\x01Сотрудник без права pvz:any работает только с назначенными ему ПВЗ. Повторное назначение не считается ошибкойJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.AssignEmployeeResponseJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJY\n" +
+	"\x03404\x12R\n" +
+	"8ПВЗ или пользователь не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/pvz/{pvz_id}/employees\x12\xba\x04\n" +
+	"\x10UnassignEmployee\x12\x1f.pvz.v1.UnassignEmployeeRequest\x1a .pvz.v1.UnassignEmployeeResponse\"\xe2\x03\x92A\xae\x03\n" +
+	"\x03PVZ\x12+Снятие сотрудника с ПВЗ\x1aDСнимает назначение сотрудника на ПВЗJJ\n" +
+	"\x03200\x12C\n" +
+	"\x1bУспешный ответ\x12$\n" +
+	"\"\x1a .pvz.v1.UnassignEmployeeResponseJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJU\n" +
+	"\x03404\x12N\n" +
+	"4Сотрудник не назначен на ПВЗ\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02**(/api/v1/pvz/{pvz_id}/employees/{user_id}\x12\xa4\x05\n" +
+	"\vGetAuditLog\x12\x1a.pvz.v1.GetAuditLogRequest\x1a\x1b.pvz.v1.GetAuditLogResponse\"\xdb\x04\x92A\xc2\x04\n" +
+	"\x05Audit\x128Журнал действий пользователей\x1a\xcf\x01Отдает записи журнала от новых к старым с фильтрами по пользователю, ПВЗ и интервалу времени. Требует право audit:readJE\n" +
+	"\x03200\x12>\n" +
+	"\x1bУспешный ответ\x12\x1f\n" +
+	"\x1d\x1a\x1b.pvz.v1.GetAuditLogResponseJS\n" +
+	"\x03400\x12L\n" +
+	"2Неверные параметры запроса\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/audit\x12\xcb\x03\n" +
+	"\x10ListProductTypes\x12\x1f.pvz.v1.ListProductTypesRequest\x1a .pvz.v1.ListProductTypesResponse\"\xf3\x02\x92A\xd2\x02\n" +
+	"\fProductTypes\x12.Справочник типов товаров\x1asОтдает все типы товаров, которые можно принять, с их атрибутамиJJ\n" +
+	"\x03200\x12C\n" +
+	"\x1bУспешный ответ\x12$\n" +
+	"\"\x1a .pvz.v1.ListProductTypesResponseJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x17\x12\x15/api/v1/product_types\x12\xe3\x05\n" +
+	"\x11CreateProductType\x12 .pvz.v1.CreateProductTypeRequest\x1a!.pvz.v1.CreateProductTypeResponse\"\x88\x05\x92A\xe4\x04\n" +
+	"\fProductTypes\x12*Добавление типа товара\x1a\x9f\x01Название приводится к нижнему регистру без пробелов по краям. Требует право product_type:manageJK\n" +
+	"\x03200\x12D\n" +
+	"\x1bУспешный ответ\x12%\n" +
+	"#\x1a!.pvz.v1.CreateProductTypeResponseJS\n" +
+	"\x03400\x12L\n" +
+	"2Некорректное описание типа\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03409\x12J\n" +
+	"0Тип уже есть в справочнике\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/product_types\x12\xc0\x05\n" +
+	"\x11UpdateProductType\x12 .pvz.v1.UpdateProductTypeRequest\x1a!.pvz.v1.UpdateProductTypeResponse\"\xe5\x04\x92A\xba\x04\n" +
+	"\fProductTypes\x12;Изменение атрибутов типа товара\x1a}Меняет атрибуты типа, название не меняется. Требует право product_type:manageJK\n" +
+	"\x03200\x12D\n" +
+	"\x1bУспешный ответ\x12%\n" +
+	"#\x1a!.pvz.v1.UpdateProductTypeResponseJS\n" +
+	"\x03400\x12L\n" +
+	"2Некорректное описание типа\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ9\n" +
+	"\x03404\x122\n" +
+	"\x18Тип не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/product_types/{name}\x12\x95\x05\n" +
+	"\x11DeleteProductType\x12 .pvz.v1.DeleteProductTypeRequest\x1a!.pvz.v1.DeleteProductTypeResponse\"\xba\x04\x92A\x92\x04\n" +
+	"\fProductTypes\x12&Удаление типа товара\x1a\xbe\x01Уже принятые товары этого типа не меняются, новые с этим типом добавить нельзя. Требует право product_type:manageJK\n" +
+	"\x03200\x12D\n" +
+	"\x1bУспешный ответ\x12%\n" +
+	"#\x1a!.pvz.v1.DeleteProductTypeResponseJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ9\n" +
+	"\x03404\x122\n" +
+	"\x18Тип не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x1e*\x1c/api/v1/product_types/{name}2\x8bA\n" +
+	"\vAuthService\x12\xd4\x03\n" +
+	"\n" +
+	"DummyLogin\x12\x19.pvz.v1.DummyLoginRequest\x1a\x1a.pvz.v1.DummyLoginResponse\"\x8e\x03\x92A\xed\x02\n" +
+	"\x04Auth\x122Получение тестового токена\x1aXВыдает токен для указанной роли без регистрацииJD\n" +
+	"\x03200\x12=\n" +
+	"\x1bУспешный ответ\x12\x1e\n" +
+	"\x1c\x1a\x1a.pvz.v1.DummyLoginResponseJ>\n" +
+	"\x03400\x127\n" +
+	"\x1dНеверный запрос\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/dummyLogin\x12\x98\x04\n" +
+	"\bRegister\x12\x17.pvz.v1.RegisterRequest\x1a\x18.pvz.v1.RegisterResponse\"\xd8\x03\x92A\xb9\x03\n" +
+	"\x04Auth\x12/Регистрация пользователя\x1aHСоздает пользователя с указанной рольюJL\n" +
+	"\x03201\x12E\n" +
+	"%Пользователь создан\x12\x1c\n" +
+	"\x1a\x1a\x18.pvz.v1.RegisterResponseJ>\n" +
+	"\x03400\x127\n" +
+	"\x1dНеверный запрос\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJU\n" +
+	"\x03409\x12N\n" +
+	"4Пользователь уже существует\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/api/v1/register\x12\xa9\x03\n" +
+	"\x05Login\x12\x14.pvz.v1.LoginRequest\x1a\x15.pvz.v1.LoginResponse\"\xf2\x02\x92A\xd6\x02\n" +
+	"\x04Auth\x12/Авторизация пользователя\x1a:Возвращает токен по email и паролюJ?\n" +
+	"\x03200\x128\n" +
+	"\x1bУспешный ответ\x12\x19\n" +
+	"\x17\x1a\x15.pvz.v1.LoginResponseJM\n" +
+	"\x03401\x12F\n" +
+	",Неверные учетные данные\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/api/v1/login\x12\x8d\x04\n" +
+	"\aRefresh\x12\x16.pvz.v1.RefreshRequest\x1a\x17.pvz.v1.RefreshResponse\"\xd0\x03\x92A\xb2\x03\n" +
+	"\x04Auth\x12#Обновление токенов\x1a\x85\x01Обменивает refresh токен на новую пару токенов, старый refresh токен отзываетсяJA\n" +
+	"\x03200\x12:\n" +
+	"\x1bУспешный ответ\x12\x1b\n" +
+	"\x19\x1a\x17.pvz.v1.RefreshResponseJg\n" +
+	"\x03401\x12`\n" +
+	"FRefresh токен не найден, истек или отозван\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/api/v1/refresh\x12\x9c\x03\n" +
+	"\x06Logout\x12\x15.pvz.v1.LogoutRequest\x1a\x16.pvz.v1.LogoutResponse\"\xe2\x02\x92A\xc5\x02\n" +
+	"\x04Auth\x12\n" +
+	"Выход\x1a\\Отзывает текущий access токен и переданный refresh токенJB\n" +
+	"\x03200\x12;\n" +
+	"\x1dТокены отозваны\x12\x1a\n" +
+	"\x18\x1a\x16.pvz.v1.LogoutResponseJ<\n" +
+	"\x03401\x125\n" +
+	"\x1bНеверный токен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/logout\x12\xea\x04\n" +
+	"\x0eChangePassword\x12\x1d.pvz.v1.ChangePasswordRequest\x1a\x1e.pvz.v1.ChangePasswordResponse\"\x98\x04\x92A\xf2\x03\n" +
+	"\x04Auth\x12\x17Смена пароля\x1azМеняет пароль текущего пользователя и отзывает все его refresh токеныJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bПароль изменен\x12\"\n" +
+	" \x1a\x1e.pvz.v1.ChangePasswordResponseJi\n" +
+	"\x03400\x12b\n" +
+	"HНовый пароль не соответствует политике\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJM\n" +
+	"\x03401\x12F\n" +
+	",Неверный текущий пароль\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/v1/password/change\x12\xc3\x04\n" +
+	"\x14RequestPasswordReset\x12#.pvz.v1.RequestPasswordResetRequest\x1a$.pvz.v1.RequestPasswordResetResponse\"\xdf\x03\x92A\xb2\x03\n" +
+	"\x04Auth\x12&Запрос сброса пароля\x1a\xa7\x01Отправляет одноразовый токен сброса на email. Ответ не зависит от того, зарегистрирован ли emailJL\n" +
+	"\x03200\x12E\n" +
+	"\x19Запрос принят\x12(\n" +
+	"&\x1a$.pvz.v1.RequestPasswordResetResponseJ7\n" +
+	"\x03400\x120\n" +
+	"\x16Неверный email\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/v1/password/reset/request\x12\xf0\x04\n" +
+	"\rResetPassword\x12\x1c.pvz.v1.ResetPasswordRequest\x1a\x1d.pvz.v1.ResetPasswordResponse\"\xa1\x04\x92A\xfc\x03\n" +
+	"\x04Auth\x12\x17Сброс пароля\x1a\x9e\x01Устанавливает новый пароль по токену сброса. Токен одноразовый и ограничен по времениJG\n" +
+	"\x03200\x12@\n" +
+	"\x1bПароль изменен\x12!\n" +
+	"\x1f\x1a\x1d.pvz.v1.ResetPasswordResponseJ\x9d\x01\n" +
+	"\x03400\x12\x95\x01\n" +
+	"{Неверный или истекший токен, либо пароль не соответствует политике\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/v1/password/reset\x12\xd2\x04\n" +
+	"\tListUsers\x12\x18.pvz.v1.ListUsersRequest\x1a\x19.pvz.v1.ListUsersResponse\"\x8f\x04\x92A\xf6\x03\n" +
+	"\x05Users\x12'Список пользователей\x1a\x96\x01Отдает пользователей по возрастанию id страницами по курсору. Требует право user:manageJC\n" +
+	"\x03200\x12<\n" +
+	"\x1bУспешный ответ\x12\x1d\n" +
+	"\x1b\x1a\x19.pvz.v1.ListUsersResponseJS\n" +
+	"\x03400\x12L\n" +
+	"2Неверные параметры запроса\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x0f\x12\r/api/v1/users\x12\xd3\x03\n" +
+	"\aGetUser\x12\x16.pvz.v1.GetUserRequest\x1a\x17.pvz.v1.GetUserResponse\"\x96\x03\x92A\xf3\x02\n" +
+	"\x05Users\x12 Пользователь по id\x1a%Требует право user:manageJA\n" +
+	"\x03200\x12:\n" +
+	"\x1bУспешный ответ\x12\x1b\n" +
+	"\x19\x1a\x17.pvz.v1.GetUserResponseJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJK\n" +
+	"\x03404\x12D\n" +
+	"*Пользователь не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/users/{user_id}\x12\xab\x06\n" +
+	"\x0eUpdateUserRole\x12\x1d.pvz.v1.UpdateUserRoleRequest\x1a\x1e.pvz.v1.UpdateUserRoleResponse\"\xd9\x05\x92A\xae\x05\n" +
+	"\x05Users\x12,Смена роли пользователя\x1a\xc4\x01Роль должна быть описана в RBAC. Уже выданные access токены несут прежнюю роль до истечения. Требует право user:manageJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.UpdateUserRoleResponseJ\x85\x01\n" +
+	"\x03400\x12~\n" +
+	"dНеизвестная роль или попытка сменить собственную роль\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJK\n" +
+	"\x03404\x12D\n" +
+	"*Пользователь не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/users/{user_id}/role\x12\x95\x06\n" +
+	"\x0eDeactivateUser\x12\x1d.pvz.v1.DeactivateUserRequest\x1a\x1e.pvz.v1.DeactivateUserResponse\"\xc3\x05\x92A\x92\x05\n" +
+	"\x05Users\x12-Отключение пользователя\x1a\xc0\x01Запрещает вход, отзывает refresh токены, уже выданные access токены перестают приниматься. Требует право user:manageJH\n" +
+	"\x03200\x12A\n" +
+	"\x1bУспешный ответ\x12\"\n" +
+	" \x1a\x1e.pvz.v1.DeactivateUserResponseJm\n" +
+	"\x03400\x12f\n" +
+	"LНельзя менять собственную учетную запись\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJK\n" +
+	"\x03404\x12D\n" +
+	"*Пользователь не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02':\x01*\"\"/api/v1/users/{user_id}/deactivate\x12\xc7\x05\n" +
+	"\fActivateUser\x12\x1b.pvz.v1.ActivateUserRequest\x1a\x1c.pvz.v1.ActivateUserResponse\"\xfb\x04\x92A\xcc\x04\n" +
+	"\x05Users\x12+Включение пользователя\x1a\x7fСнова разрешает вход отключенному пользователю. Требует право user:manageJF\n" +
+	"\x03200\x12?\n" +
+	"\x1bУспешный ответ\x12 \n" +
+	"\x1e\x1a\x1c.pvz.v1.ActivateUserResponseJm\n" +
+	"\x03400\x12f\n" +
+	"LНельзя менять собственную учетную запись\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJK\n" +
+	"\x03404\x12D\n" +
+	"*Пользователь не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
+	"\x03500\x12J\n" +
+	"0Внутренняя ошибка сервера\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/users/{user_id}/activate\x12\xcd\x05\n" +
+	"\n" +
+	"DeleteUser\x12\x19.pvz.v1.DeleteUserRequest\x1a\x1a.pvz.v1.DeleteUserResponse\"\x87\x05\x92A\xe4\x04\n" +
+	"\x05Users\x12)Удаление пользователя\x1a\x9a\x01Удаляет учетную запись вместе с токенами и назначениями на ПВЗ. Требует право user:manageJD\n" +
+	"\x03200\x12=\n" +
+	"\x1bУспешный ответ\x12\x1e\n" +
+	"\x1c\x1a\x1a.pvz.v1.DeleteUserResponseJm\n" +
+	"\x03400\x12f\n" +
+	"LНельзя менять собственную учетную запись\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJ>\n" +
+	"\x03403\x127\n" +
+	"\x1dДоступ запрещен\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJK\n" +
+	"\x03404\x12D\n" +
+	"*Пользователь не найден\x12\x16\n" +
+	"\x14\x1a\x12.google.rpc.StatusJQ\n" +
 	"\x03500\x12J\n" +
 	"0Внутренняя ошибка сервера\x12\x16\n" +
-	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\r\x12\v/api/v1/pvzBX\x92A8\x1a\x0elocalhost:6060*\x02\x01\x022\x10application/json:\x10application/jsonZ\x1bapi/proto/gen/pvz_v1;pvz_v1b\x06proto3"
+	"\x14\x1a\x12.google.rpc.Status\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/users/{user_id}BX\x92A8\x1a\x0elocalhost:6060*\x02\x01\x022\x10application/json:\x10application/jsonZ\x1bapi/proto/gen/pvz_v1;pvz_v1b\x06proto3"
 
 var (
 	file_api_proto_pvz_proto_rawDescOnce sync.Once
@@ -249,25 +5436,226 @@ func file_api_proto_pvz_proto_rawDescGZIP() []byte {
 	return file_api_proto_pvz_proto_rawDescData
 }
 
-var file_api_proto_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_proto_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_api_proto_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
 var file_api_proto_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(ReceptionStatus)(0),                  // 0: pvz.v1.ReceptionStatus
+	(PVZEventType)(0),                     // 1: pvz.v1.PVZEventType
+	(*PVZ)(nil),                           // 2: pvz.v1.PVZ
+	(*GetPVZListRequest)(nil),             // 3: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),            // 4: pvz.v1.GetPVZListResponse
+	(*Reception)(nil),                     // 5: pvz.v1.Reception
+	(*Product)(nil),                       // 6: pvz.v1.Product
+	(*ReceptionInfo)(nil),                 // 7: pvz.v1.ReceptionInfo
+	(*PVZInfo)(nil),                       // 8: pvz.v1.PVZInfo
+	(*CreatePVZRequest)(nil),              // 9: pvz.v1.CreatePVZRequest
+	(*CreatePVZResponse)(nil),             // 10: pvz.v1.CreatePVZResponse
+	(*GetPVZSInfoRequest)(nil),            // 11: pvz.v1.GetPVZSInfoRequest
+	(*GetPVZSInfoResponse)(nil),           // 12: pvz.v1.GetPVZSInfoResponse
+	(*StartReceptionRequest)(nil),         // 13: pvz.v1.StartReceptionRequest
+	(*StartReceptionResponse)(nil),        // 14: pvz.v1.StartReceptionResponse
+	(*CloseReceptionRequest)(nil),         // 15: pvz.v1.CloseReceptionRequest
+	(*CloseReceptionResponse)(nil),        // 16: pvz.v1.CloseReceptionResponse
+	(*PauseReceptionRequest)(nil),         // 17: pvz.v1.PauseReceptionRequest
+	(*PauseReceptionResponse)(nil),        // 18: pvz.v1.PauseReceptionResponse
+	(*ResumeReceptionRequest)(nil),        // 19: pvz.v1.ResumeReceptionRequest
+	(*ResumeReceptionResponse)(nil),       // 20: pvz.v1.ResumeReceptionResponse
+	(*CancelReceptionRequest)(nil),        // 21: pvz.v1.CancelReceptionRequest
+	(*CancelReceptionResponse)(nil),       // 22: pvz.v1.CancelReceptionResponse
+	(*ReopenReceptionRequest)(nil),        // 23: pvz.v1.ReopenReceptionRequest
+	(*ReopenReceptionResponse)(nil),       // 24: pvz.v1.ReopenReceptionResponse
+	(*GetReceptionHistoryRequest)(nil),    // 25: pvz.v1.GetReceptionHistoryRequest
+	(*ReceptionStatusChange)(nil),         // 26: pvz.v1.ReceptionStatusChange
+	(*GetReceptionHistoryResponse)(nil),   // 27: pvz.v1.GetReceptionHistoryResponse
+	(*AddProductRequest)(nil),             // 28: pvz.v1.AddProductRequest
+	(*AddProductResponse)(nil),            // 29: pvz.v1.AddProductResponse
+	(*ProductItem)(nil),                   // 30: pvz.v1.ProductItem
+	(*AddProductsRequest)(nil),            // 31: pvz.v1.AddProductsRequest
+	(*AddProductsResponse)(nil),           // 32: pvz.v1.AddProductsResponse
+	(*DeleteLastProductRequest)(nil),      // 33: pvz.v1.DeleteLastProductRequest
+	(*DeleteLastProductResponse)(nil),     // 34: pvz.v1.DeleteLastProductResponse
+	(*DeleteProductRequest)(nil),          // 35: pvz.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),         // 36: pvz.v1.DeleteProductResponse
+	(*RestoreProductRequest)(nil),         // 37: pvz.v1.RestoreProductRequest
+	(*RestoreProductResponse)(nil),        // 38: pvz.v1.RestoreProductResponse
+	(*FindProductsByBarcodeRequest)(nil),  // 39: pvz.v1.FindProductsByBarcodeRequest
+	(*FindProductsByBarcodeResponse)(nil), // 40: pvz.v1.FindProductsByBarcodeResponse
+	(*AssignEmployeeRequest)(nil),         // 41: pvz.v1.AssignEmployeeRequest
+	(*AssignEmployeeResponse)(nil),        // 42: pvz.v1.AssignEmployeeResponse
+	(*UnassignEmployeeRequest)(nil),       // 43: pvz.v1.UnassignEmployeeRequest
+	(*UnassignEmployeeResponse)(nil),      // 44: pvz.v1.UnassignEmployeeResponse
+	(*GetAuditLogRequest)(nil),            // 45: pvz.v1.GetAuditLogRequest
+	(*AuditEntry)(nil),                    // 46: pvz.v1.AuditEntry
+	(*GetAuditLogResponse)(nil),           // 47: pvz.v1.GetAuditLogResponse
+	(*DummyLoginRequest)(nil),             // 48: pvz.v1.DummyLoginRequest
+	(*DummyLoginResponse)(nil),            // 49: pvz.v1.DummyLoginResponse
+	(*RegisterRequest)(nil),               // 50: pvz.v1.RegisterRequest
+	(*RegisterResponse)(nil),              // 51: pvz.v1.RegisterResponse
+	(*LoginRequest)(nil),                  // 52: pvz.v1.LoginRequest
+	(*LoginResponse)(nil),                 // 53: pvz.v1.LoginResponse
+	(*RefreshRequest)(nil),                // 54: pvz.v1.RefreshRequest
+	(*RefreshResponse)(nil),               // 55: pvz.v1.RefreshResponse
+	(*LogoutRequest)(nil),                 // 56: pvz.v1.LogoutRequest
+	(*LogoutResponse)(nil),                // 57: pvz.v1.LogoutResponse
+	(*ChangePasswordRequest)(nil),         // 58: pvz.v1.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),        // 59: pvz.v1.ChangePasswordResponse
+	(*RequestPasswordResetRequest)(nil),   // 60: pvz.v1.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),  // 61: pvz.v1.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),          // 62: pvz.v1.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),         // 63: pvz.v1.ResetPasswordResponse
+	(*WatchPVZRequest)(nil),               // 64: pvz.v1.WatchPVZRequest
+	(*PVZEvent)(nil),                      // 65: pvz.v1.PVZEvent
+	(*User)(nil),                          // 66: pvz.v1.User
+	(*ListUsersRequest)(nil),              // 67: pvz.v1.ListUsersRequest
+	(*ListUsersResponse)(nil),             // 68: pvz.v1.ListUsersResponse
+	(*GetUserRequest)(nil),                // 69: pvz.v1.GetUserRequest
+	(*GetUserResponse)(nil),               // 70: pvz.v1.GetUserResponse
+	(*UpdateUserRoleRequest)(nil),         // 71: pvz.v1.UpdateUserRoleRequest
+	(*UpdateUserRoleResponse)(nil),        // 72: pvz.v1.UpdateUserRoleResponse
+	(*DeactivateUserRequest)(nil),         // 73: pvz.v1.DeactivateUserRequest
+	(*DeactivateUserResponse)(nil),        // 74: pvz.v1.DeactivateUserResponse
+	(*ActivateUserRequest)(nil),           // 75: pvz.v1.ActivateUserRequest
+	(*ActivateUserResponse)(nil),          // 76: pvz.v1.ActivateUserResponse
+	(*DeleteUserRequest)(nil),             // 77: pvz.v1.DeleteUserRequest
+	(*DeleteUserResponse)(nil),            // 78: pvz.v1.DeleteUserResponse
+	(*ProductType)(nil),                   // 79: pvz.v1.ProductType
+	(*ListProductTypesRequest)(nil),       // 80: pvz.v1.ListProductTypesRequest
+	(*ListProductTypesResponse)(nil),      // 81: pvz.v1.ListProductTypesResponse
+	(*CreateProductTypeRequest)(nil),      // 82: pvz.v1.CreateProductTypeRequest
+	(*CreateProductTypeResponse)(nil),     // 83: pvz.v1.CreateProductTypeResponse
+	(*UpdateProductTypeRequest)(nil),      // 84: pvz.v1.UpdateProductTypeRequest
+	(*UpdateProductTypeResponse)(nil),     // 85: pvz.v1.UpdateProductTypeResponse
+	(*DeleteProductTypeRequest)(nil),      // 86: pvz.v1.DeleteProductTypeRequest
+	(*DeleteProductTypeResponse)(nil),     // 87: pvz.v1.DeleteProductTypeResponse
+	(*timestamppb.Timestamp)(nil),         // 88: google.protobuf.Timestamp
 }
 var file_api_proto_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	88, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	2,  // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	88, // 2: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	88, // 4: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	5,  // 5: pvz.v1.ReceptionInfo.reception:type_name -> pvz.v1.Reception
+	6,  // 6: pvz.v1.ReceptionInfo.products:type_name -> pvz.v1.Product
+	2,  // 7: pvz.v1.PVZInfo.pvz:type_name -> pvz.v1.PVZ
+	7,  // 8: pvz.v1.PVZInfo.receptions:type_name -> pvz.v1.ReceptionInfo
+	2,  // 9: pvz.v1.CreatePVZResponse.pvz:type_name -> pvz.v1.PVZ
+	88, // 10: pvz.v1.GetPVZSInfoRequest.start_date:type_name -> google.protobuf.Timestamp
+	88, // 11: pvz.v1.GetPVZSInfoRequest.end_date:type_name -> google.protobuf.Timestamp
+	0,  // 12: pvz.v1.GetPVZSInfoRequest.reception_statuses:type_name -> pvz.v1.ReceptionStatus
+	8,  // 13: pvz.v1.GetPVZSInfoResponse.items:type_name -> pvz.v1.PVZInfo
+	5,  // 14: pvz.v1.StartReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 15: pvz.v1.CloseReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 16: pvz.v1.PauseReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 17: pvz.v1.ResumeReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 18: pvz.v1.CancelReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 19: pvz.v1.ReopenReceptionResponse.reception:type_name -> pvz.v1.Reception
+	88, // 20: pvz.v1.ReceptionStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	26, // 21: pvz.v1.GetReceptionHistoryResponse.items:type_name -> pvz.v1.ReceptionStatusChange
+	6,  // 22: pvz.v1.AddProductResponse.product:type_name -> pvz.v1.Product
+	30, // 23: pvz.v1.AddProductsRequest.products:type_name -> pvz.v1.ProductItem
+	6,  // 24: pvz.v1.AddProductsResponse.products:type_name -> pvz.v1.Product
+	6,  // 25: pvz.v1.RestoreProductResponse.product:type_name -> pvz.v1.Product
+	6,  // 26: pvz.v1.FindProductsByBarcodeResponse.products:type_name -> pvz.v1.Product
+	88, // 27: pvz.v1.GetAuditLogRequest.from:type_name -> google.protobuf.Timestamp
+	88, // 28: pvz.v1.GetAuditLogRequest.to:type_name -> google.protobuf.Timestamp
+	88, // 29: pvz.v1.AuditEntry.created_at:type_name -> google.protobuf.Timestamp
+	46, // 30: pvz.v1.GetAuditLogResponse.items:type_name -> pvz.v1.AuditEntry
+	1,  // 31: pvz.v1.PVZEvent.type:type_name -> pvz.v1.PVZEventType
+	88, // 32: pvz.v1.PVZEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	88, // 33: pvz.v1.User.created_at:type_name -> google.protobuf.Timestamp
+	88, // 34: pvz.v1.User.updated_at:type_name -> google.protobuf.Timestamp
+	66, // 35: pvz.v1.ListUsersResponse.users:type_name -> pvz.v1.User
+	66, // 36: pvz.v1.GetUserResponse.user:type_name -> pvz.v1.User
+	66, // 37: pvz.v1.UpdateUserRoleResponse.user:type_name -> pvz.v1.User
+	66, // 38: pvz.v1.DeactivateUserResponse.user:type_name -> pvz.v1.User
+	66, // 39: pvz.v1.ActivateUserResponse.user:type_name -> pvz.v1.User
+	88, // 40: pvz.v1.ProductType.created_at:type_name -> google.protobuf.Timestamp
+	88, // 41: pvz.v1.ProductType.updated_at:type_name -> google.protobuf.Timestamp
+	79, // 42: pvz.v1.ListProductTypesResponse.product_types:type_name -> pvz.v1.ProductType
+	79, // 43: pvz.v1.CreateProductTypeResponse.product_type:type_name -> pvz.v1.ProductType
+	79, // 44: pvz.v1.UpdateProductTypeResponse.product_type:type_name -> pvz.v1.ProductType
+	3,  // 45: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	9,  // 46: pvz.v1.PVZService.CreatePVZ:input_type -> pvz.v1.CreatePVZRequest
+	11, // 47: pvz.v1.PVZService.GetPVZSInfo:input_type -> pvz.v1.GetPVZSInfoRequest
+	13, // 48: pvz.v1.PVZService.StartReception:input_type -> pvz.v1.StartReceptionRequest
+	15, // 49: pvz.v1.PVZService.CloseReception:input_type -> pvz.v1.CloseReceptionRequest
+	17, // 50: pvz.v1.PVZService.PauseReception:input_type -> pvz.v1.PauseReceptionRequest
+	19, // 51: pvz.v1.PVZService.ResumeReception:input_type -> pvz.v1.ResumeReceptionRequest
+	21, // 52: pvz.v1.PVZService.CancelReception:input_type -> pvz.v1.CancelReceptionRequest
+	23, // 53: pvz.v1.PVZService.ReopenReception:input_type -> pvz.v1.ReopenReceptionRequest
+	25, // 54: pvz.v1.PVZService.GetReceptionHistory:input_type -> pvz.v1.GetReceptionHistoryRequest
+	28, // 55: pvz.v1.PVZService.AddProduct:input_type -> pvz.v1.AddProductRequest
+	31, // 56: pvz.v1.PVZService.AddProducts:input_type -> pvz.v1.AddProductsRequest
+	33, // 57: pvz.v1.PVZService.DeleteLastProduct:input_type -> pvz.v1.DeleteLastProductRequest
+	35, // 58: pvz.v1.PVZService.DeleteProduct:input_type -> pvz.v1.DeleteProductRequest
+	37, // 59: pvz.v1.PVZService.RestoreProduct:input_type -> pvz.v1.RestoreProductRequest
+	39, // 60: pvz.v1.PVZService.FindProductsByBarcode:input_type -> pvz.v1.FindProductsByBarcodeRequest
+	64, // 61: pvz.v1.PVZService.WatchPVZ:input_type -> pvz.v1.WatchPVZRequest
+	41, // 62: pvz.v1.PVZService.AssignEmployee:input_type -> pvz.v1.AssignEmployeeRequest
+	43, // 63: pvz.v1.PVZService.UnassignEmployee:input_type -> pvz.v1.UnassignEmployeeRequest
+	45, // 64: pvz.v1.PVZService.GetAuditLog:input_type -> pvz.v1.GetAuditLogRequest
+	80, // 65: pvz.v1.PVZService.ListProductTypes:input_type -> pvz.v1.ListProductTypesRequest
+	82, // 66: pvz.v1.PVZService.CreateProductType:input_type -> pvz.v1.CreateProductTypeRequest
+	84, // 67: pvz.v1.PVZService.UpdateProductType:input_type -> pvz.v1.UpdateProductTypeRequest
+	86, // 68: pvz.v1.PVZService.DeleteProductType:input_type -> pvz.v1.DeleteProductTypeRequest
+	48, // 69: pvz.v1.AuthService.DummyLogin:input_type -> pvz.v1.DummyLoginRequest
+	50, // 70: pvz.v1.AuthService.Register:input_type -> pvz.v1.RegisterRequest
+	52, // 71: pvz.v1.AuthService.Login:input_type -> pvz.v1.LoginRequest
+	54, // 72: pvz.v1.AuthService.Refresh:input_type -> pvz.v1.RefreshRequest
+	56, // 73: pvz.v1.AuthService.Logout:input_type -> pvz.v1.LogoutRequest
+	58, // 74: pvz.v1.AuthService.ChangePassword:input_type -> pvz.v1.ChangePasswordRequest
+	60, // 75: pvz.v1.AuthService.RequestPasswordReset:input_type -> pvz.v1.RequestPasswordResetRequest
+	62, // 76: pvz.v1.AuthService.ResetPassword:input_type -> pvz.v1.ResetPasswordRequest
+	67, // 77: pvz.v1.AuthService.ListUsers:input_type -> pvz.v1.ListUsersRequest
+	69, // 78: pvz.v1.AuthService.GetUser:input_type -> pvz.v1.GetUserRequest
+	71, // 79: pvz.v1.AuthService.UpdateUserRole:input_type -> pvz.v1.UpdateUserRoleRequest
+	73, // 80: pvz.v1.AuthService.DeactivateUser:input_type -> pvz.v1.DeactivateUserRequest
+	75, // 81: pvz.v1.AuthService.ActivateUser:input_type -> pvz.v1.ActivateUserRequest
+	77, // 82: pvz.v1.AuthService.DeleteUser:input_type -> pvz.v1.DeleteUserRequest
+	4,  // 83: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	10, // 84: pvz.v1.PVZService.CreatePVZ:output_type -> pvz.v1.CreatePVZResponse
+	12, // 85: pvz.v1.PVZService.GetPVZSInfo:output_type -> pvz.v1.GetPVZSInfoResponse
+	14, // 86: pvz.v1.PVZService.StartReception:output_type -> pvz.v1.StartReceptionResponse
+	16, // 87: pvz.v1.PVZService.CloseReception:output_type -> pvz.v1.CloseReceptionResponse
+	18, // 88: pvz.v1.PVZService.PauseReception:output_type -> pvz.v1.PauseReceptionResponse
+	20, // 89: pvz.v1.PVZService.ResumeReception:output_type -> pvz.v1.ResumeReceptionResponse
+	22, // 90: pvz.v1.PVZService.CancelReception:output_type -> pvz.v1.CancelReceptionResponse
+	24, // 91: pvz.v1.PVZService.ReopenReception:output_type -> pvz.v1.ReopenReceptionResponse
+	27, // 92: pvz.v1.PVZService.GetReceptionHistory:output_type -> pvz.v1.GetReceptionHistoryResponse
+	29, // 93: pvz.v1.PVZService.AddProduct:output_type -> pvz.v1.AddProductResponse
+	32, // 94: pvz.v1.PVZService.AddProducts:output_type -> pvz.v1.AddProductsResponse
+	34, // 95: pvz.v1.PVZService.DeleteLastProduct:output_type -> pvz.v1.DeleteLastProductResponse
+	36, // 96: pvz.v1.PVZService.DeleteProduct:output_type -> pvz.v1.DeleteProductResponse
+	38, // 97: pvz.v1.PVZService.RestoreProduct:output_type -> pvz.v1.RestoreProductResponse
+	40, // 98: pvz.v1.PVZService.FindProductsByBarcode:output_type -> pvz.v1.FindProductsByBarcodeResponse
+	65, // 99: pvz.v1.PVZService.WatchPVZ:output_type -> pvz.v1.PVZEvent
+	42, // 100: pvz.v1.PVZService.AssignEmployee:output_type -> pvz.v1.AssignEmployeeResponse
+	44, // 101: pvz.v1.PVZService.UnassignEmployee:output_type -> pvz.v1.UnassignEmployeeResponse
+	47, // 102: pvz.v1.PVZService.GetAuditLog:output_type -> pvz.v1.GetAuditLogResponse
+	81, // 103: pvz.v1.PVZService.ListProductTypes:output_type -> pvz.v1.ListProductTypesResponse
+	83, // 104: pvz.v1.PVZService.CreateProductType:output_type -> pvz.v1.CreateProductTypeResponse
+	85, // 105: pvz.v1.PVZService.UpdateProductType:output_type -> pvz.v1.UpdateProductTypeResponse
+	87, // 106: pvz.v1.PVZService.DeleteProductType:output_type -> pvz.v1.DeleteProductTypeResponse
+	49, // 107: pvz.v1.AuthService.DummyLogin:output_type -> pvz.v1.DummyLoginResponse
+	51, // 108: pvz.v1.AuthService.Register:output_type -> pvz.v1.RegisterResponse
+	53, // 109: pvz.v1.AuthService.Login:output_type -> pvz.v1.LoginResponse
+	55, // 110: pvz.v1.AuthService.Refresh:output_type -> pvz.v1.RefreshResponse
+	57, // 111: pvz.v1.AuthService.Logout:output_type -> pvz.v1.LogoutResponse
+	59, // 112: pvz.v1.AuthService.ChangePassword:output_type -> pvz.v1.ChangePasswordResponse
+	61, // 113: pvz.v1.AuthService.RequestPasswordReset:output_type -> pvz.v1.RequestPasswordResetResponse
+	63, // 114: pvz.v1.AuthService.ResetPassword:output_type -> pvz.v1.ResetPasswordResponse
+	68, // 115: pvz.v1.AuthService.ListUsers:output_type -> pvz.v1.ListUsersResponse
+	70, // 116: pvz.v1.AuthService.GetUser:output_type -> pvz.v1.GetUserResponse
+	72, // 117: pvz.v1.AuthService.UpdateUserRole:output_type -> pvz.v1.UpdateUserRoleResponse
+	74, // 118: pvz.v1.AuthService.DeactivateUser:output_type -> pvz.v1.DeactivateUserResponse
+	76, // 119: pvz.v1.AuthService.ActivateUser:output_type -> pvz.v1.ActivateUserResponse
+	78, // 120: pvz.v1.AuthService.DeleteUser:output_type -> pvz.v1.DeleteUserResponse
+	83, // [83:121] is the sub-list for method output_type
+	45, // [45:83] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_pvz_proto_init() }
@@ -280,10 +5668,10 @@ func file_api_proto_pvz_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_pvz_proto_rawDesc), len(file_api_proto_pvz_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   3,
+			NumEnums:      2,
+			NumMessages:   86,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_api_proto_pvz_proto_goTypes,
 		DependencyIndexes: file_api_proto_pvz_proto_depIdxs,
@@ -8,6 +8,8 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	gen "github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
 	"github.com/Ranik23/avito-tech-spring/internal/config"
@@ -15,7 +17,13 @@ import (
 	"github.com/Ranik23/avito-tech-spring/internal/controllers/grpc/interceptors"
 	httpcontrollers "github.com/Ranik23/avito-tech-spring/internal/controllers/http"
 	"github.com/Ranik23/avito-tech-spring/internal/controllers/http/middleware"
+	"github.com/Ranik23/avito-tech-spring/internal/events"
 	"github.com/Ranik23/avito-tech-spring/internal/hasher"
+	"github.com/Ranik23/avito-tech-spring/internal/limiter"
+	"github.com/Ranik23/avito-tech-spring/internal/notifier"
+	"github.com/Ranik23/avito-tech-spring/internal/outbox"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
 	"github.com/Ranik23/avito-tech-spring/internal/repository/postgresql"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"github.com/Ranik23/avito-tech-spring/internal/token"
@@ -84,20 +92,113 @@ func NewApp() (*App, error) {
 	logger.Info("Initializing repositories...")
 	userRepo := postgresql.NewPostgresUserRepository(ctxManager, logger)
 	productRepo := postgresql.NewPostgresProductRepository(ctxManager, logger)
+	productTypeRepo := postgresql.NewPostgresProductTypeRepository(ctxManager, logger)
 	pvzRepo := postgresql.NewPostgresPvzRepository(ctxManager, logger)
 	receptionRepo := postgresql.NewPostgresReceptionRepository(ctxManager, logger)
+	outboxRepo := postgresql.NewPostgresOutboxRepository(ctxManager, logger)
+	tokenRepo := postgresql.NewPostgresTokenRepository(ctxManager, logger)
+	assignmentRepo := postgresql.NewPostgresAssignmentRepository(ctxManager, logger)
+	auditRepo := postgresql.NewPostgresAuditRepository(ctxManager, logger)
+	passwordResetRepo := postgresql.NewPostgresPasswordResetRepository(ctxManager, logger)
+
+	logger.Info("Loading signing keys...")
+	signingKeys, err := cfg.Token.LoadKeys()
+	if err != nil {
+		logger.Error("Failed to load signing keys", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	accessPolicy, err := cfg.RBAC.Policy()
+	if err != nil {
+		logger.Error("Failed to build RBAC policy", slog.String("error", err.Error()))
+		return nil, err
+	}
 
 	logger.Info("Initializing services...")
-	tokenService := token.NewToken(cfg.SecretKey, logger)
-	passwordhasher := hasher.NewHasher()
+	tokenService := token.NewToken(cfg.SecretKey, token.Config{
+		Issuer:    cfg.Token.Issuer,
+		Audience:  cfg.Token.Audience,
+		AccessTTL: time.Duration(cfg.Token.AccessTTL) * time.Second,
+		Keys:      signingKeys,
+	}, logger)
+
+	if signingKeys != nil && cfg.Token.KeyReloadInterval > 0 {
+		logger.Info("Starting signing key reload...", slog.String("active_kid", signingKeys.ActiveID()))
+		stop := startKeyReload(cfg.Token, tokenService, logger)
+		closer.Add(stop)
+	}
+	passwordhasher, err := hasher.NewHasher(hasher.Config{
+		Algorithm:  cfg.Hasher.Algorithm,
+		BcryptCost: cfg.Hasher.BcryptCost,
+		Argon2: hasher.Argon2Params{
+			Time:       cfg.Hasher.Argon2Time,
+			Memory:     cfg.Hasher.Argon2Memory,
+			Threads:    cfg.Hasher.Argon2Threads,
+			KeyLength:  cfg.Hasher.Argon2KeyLength,
+			SaltLength: cfg.Hasher.Argon2SaltLength,
+		},
+	})
+	if err != nil {
+		logger.Error("Failed to create password hasher", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	loginAttempts, err := createLoginAttemptStore(cfg, ctxManager, logger)
+	if err != nil {
+		logger.Error("Failed to create login attempt store", slog.String("error", err.Error()))
+		return nil, err
+	}
+	loginLimiter := limiter.NewLoginLimiter(loginAttempts, limiter.Config{
+		MaxEmailAttempts: cfg.LoginLimiter.MaxEmailAttempts,
+		MaxIPAttempts:    cfg.LoginLimiter.MaxIPAttempts,
+		Window:           time.Duration(cfg.LoginLimiter.Window) * time.Second,
+		BaseLockout:      time.Duration(cfg.LoginLimiter.BaseLockout) * time.Second,
+		MaxLockout:       time.Duration(cfg.LoginLimiter.MaxLockout) * time.Second,
+	}, logger)
+
+	if cfg.LoginLimiter.CleanupInterval > 0 {
+		stop := startLoginAttemptCleanup(cfg.LoginLimiter, loginAttempts, logger)
+		closer.Add(stop)
+	}
+
+	passwordNotifier, err := createNotifier(cfg, closer, logger)
+	if err != nil {
+		logger.Error("Failed to create notifier", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	authService := service.NewAuthService(userRepo, tokenRepo, passwordResetRepo, txManager, tokenService, passwordhasher, loginLimiter,
+		passwordNotifier, accessPolicy, cfg.Password.Policy(), time.Duration(cfg.Token.RefreshTTL)*time.Second,
+		time.Duration(cfg.Password.ResetTokenTTL)*time.Second, cfg.IsDevelopment(), logger)
+	broker := events.NewBroker(cfg.Events.HistorySize, cfg.Events.BufferSize, logger)
 
-	authService := service.NewAuthService(userRepo, txManager, tokenService, passwordhasher, logger)
-	pvzService := service.NewPVZService(pvzRepo, receptionRepo, cfg.Cities, productRepo, txManager, logger)
+	pvzService := service.NewPVZService(pvzRepo, receptionRepo, cfg.Cities, productRepo, productTypeRepo, outboxRepo, auditRepo, assignmentRepo, userRepo,
+		txManager, broker, accessPolicy, logger)
 	service := service.NewService(authService, pvzService)
 
+	if cfg.Outbox.Enabled {
+		logger.Info("Starting outbox relay...")
+		sink, err := createOutboxSink(cfg, closer)
+		if err != nil {
+			logger.Error("Failed to create outbox sink", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		relay := outbox.NewRelay(outboxRepo, txManager, sink, outbox.Config{
+			PollInterval: time.Duration(cfg.Outbox.PollInterval) * time.Millisecond,
+			BatchSize:    cfg.Outbox.BatchSize,
+			MaxAttempts:  cfg.Outbox.MaxAttempts,
+			RetryBackoff: time.Duration(cfg.Outbox.RetryBackoff) * time.Second,
+			SendTimeout:  time.Duration(cfg.Outbox.SendTimeout) * time.Second,
+		}, logger)
+		relay.Start(context.Background())
+		closer.Add(relay.Stop)
+	}
+
 	logger.Info("Initializing controllers...")
 	authController := httpcontrollers.NewAuthController(service, logger)
 	pvzController := httpcontrollers.NewPVZController(service, logger)
+	jwksController := httpcontrollers.NewJWKSController(tokenService, logger)
 
 
 	gatewayServer, err := createGateWayServer(logger, cfg)
@@ -106,8 +207,12 @@ func NewApp() (*App, error) {
 		return nil, err
 	}
 
-	httpServer := createHTTPServer(logger, cfg, authController, pvzController, tokenService)
-	grpcServer := createGRPCServer(logger, service, cfg)
+	httpServer, err := createHTTPServer(logger, cfg, authController, pvzController, jwksController, tokenService, tokenRepo, userRepo, accessPolicy)
+	if err != nil {
+		logger.Error("Failed to create HTTP Server", slog.String("error", err.Error()))
+		return nil, err
+	}
+	grpcServer := createGRPCServer(logger, service, cfg, tokenService, tokenRepo, userRepo, accessPolicy)
 	metricServer := createMetricsServer(logger, cfg)
 
 	logger.Info("App initialization complete")
@@ -180,7 +285,127 @@ func (a *App) Start(ctx context.Context) error {
 }
 
 
-func createGRPCServer(logger *slog.Logger, service service.Service, cfg *config.Config) *grpcserver.Server {
+// startKeyReload периодически перечитывает ключи подписи, так ротация не требует перезапуска.
+// При ошибке остается прежний набор ключей.
+func startKeyReload(cfg config.TokenConfig, tokenService token.Token, logger *slog.Logger) func(ctx context.Context) error {
+	ticker := time.NewTicker(time.Duration(cfg.KeyReloadInterval) * time.Second)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				keys, err := cfg.LoadKeys()
+				if err == nil && keys != nil {
+					err = tokenService.SetKeys(keys)
+				}
+				if err != nil {
+					logger.Error("Failed to reload signing keys", slog.String("error", err.Error()))
+				}
+			}
+		}
+	}()
+
+	return func(ctx context.Context) error {
+		ticker.Stop()
+		close(done)
+		return nil
+	}
+}
+
+
+// startLoginAttemptCleanup периодически удаляет счетчики попыток входа, которые уже ни на что не влияют.
+func startLoginAttemptCleanup(cfg config.LoginLimiterConfig, store repository.LoginAttemptRepository, logger *slog.Logger) func(ctx context.Context) error {
+	window := time.Duration(cfg.Window) * time.Second
+	if window <= 0 {
+		window = limiter.DefaultWindow
+	}
+
+	ticker := time.NewTicker(time.Duration(cfg.CleanupInterval) * time.Second)
+	done := make(chan struct{})
+
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				deleted, err := store.DeleteStale(context.Background(), time.Now().Add(-window))
+				if err != nil {
+					logger.Error("Failed to clean up login attempts", slog.String("error", err.Error()))
+					continue
+				}
+				if deleted > 0 {
+					logger.Info("Cleaned up login attempts", slog.Int64("count", deleted))
+				}
+			}
+		}
+	}()
+
+	return func(ctx context.Context) error {
+		ticker.Stop()
+		close(done)
+		return nil
+	}
+}
+
+
+func createLoginAttemptStore(cfg *config.Config, ctxManager repository.CtxManager, logger *slog.Logger) (repository.LoginAttemptRepository, error) {
+	switch cfg.LoginLimiter.Store {
+	case "memory":
+		return limiter.NewMemoryStore(), nil
+	case "postgres", "":
+		return postgresql.NewPostgresLoginAttemptRepository(ctxManager, logger), nil
+	default:
+		return nil, fmt.Errorf("unknown login limiter store %q", cfg.LoginLimiter.Store)
+	}
+}
+
+
+func createNotifier(cfg *config.Config, closer *closure.Closer, logger *slog.Logger) (notifier.Notifier, error) {
+	switch cfg.Notifier.Type {
+	case "log", "":
+		return notifier.NewLogNotifier(logger), nil
+	case "file":
+		file, err := os.OpenFile(cfg.Notifier.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+		if err != nil {
+			return nil, err
+		}
+		closer.Add(func(ctx context.Context) error {
+			return file.Close()
+		})
+		return notifier.NewWriterNotifier(file), nil
+	default:
+		return nil, fmt.Errorf("unknown notifier %q", cfg.Notifier.Type)
+	}
+}
+
+
+func createOutboxSink(cfg *config.Config, closer *closure.Closer) (outbox.Sink, error) {
+	switch cfg.Outbox.Sink {
+	case "stdout", "":
+		return outbox.NewWriterSink(os.Stdout), nil
+	case "file":
+		file, err := os.OpenFile(cfg.Outbox.FilePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if err != nil {
+			return nil, err
+		}
+		closer.Add(func(ctx context.Context) error {
+			return file.Close()
+		})
+		return outbox.NewWriterSink(file), nil
+	case "webhook":
+		return outbox.NewWebhookSink(cfg.Outbox.WebhookURL, time.Duration(cfg.Outbox.SendTimeout)*time.Second), nil
+	default:
+		return nil, fmt.Errorf("unknown outbox sink %q", cfg.Outbox.Sink)
+	}
+}
+
+
+func createGRPCServer(logger *slog.Logger, service service.Service, cfg *config.Config, tokenService token.Token,
+	revocations token.RevocationList, users token.UserStatus, rbacPolicy *rbac.Policy) *grpcserver.Server {
 	logger.Info("Creating GRPC server...")
 
 	grpcServerConfig := &grpcserver.Config{
@@ -190,13 +415,30 @@ func createGRPCServer(logger *slog.Logger, service service.Service, cfg *config.
 	}
 
 	grpcServerImpl := grpccontrollers.NewPVZServer(service)
+	grpcAuthServerImpl := grpccontrollers.NewAuthServer(service)
 	
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
+	accessPolicy := interceptors.DefaultAccessPolicy(rbacPolicy)
+	if !cfg.IsDevelopment() {
+		accessPolicy = accessPolicy.Strict()
+	}
+
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			interceptors.RequestIDUnaryInterceptor(),
 			interceptors.LoggingUnaryInterceptor(logger),
+			interceptors.MetricsUnaryInterceptor(),
+			interceptors.AuthUnaryInterceptor(tokenService, revocations, users, accessPolicy, logger),
+		),
+		grpc.ChainStreamInterceptor(
+			interceptors.RequestIDStreamInterceptor(),
+			interceptors.LoggingStreamInterceptor(logger),
+			interceptors.MetricsStreamInterceptor(),
+			interceptors.AuthStreamInterceptor(tokenService, revocations, users, accessPolicy, logger),
 		),
 	)
 
 	gen.RegisterPVZServiceServer(grpcServer, grpcServerImpl)
+	gen.RegisterAuthServiceServer(grpcServer, grpcAuthServerImpl)
 	reflection.Register(grpcServer)
 	
 	grpcSrv := grpcserver.New(logger, grpcServerConfig, grpcServer)
@@ -217,7 +459,7 @@ func createGateWayServer(logger *slog.Logger, cfg *config.Config) (*httpserver.S
 	}
 
 	ctx := context.Background()
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher))
 
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
 
@@ -228,6 +470,12 @@ func createGateWayServer(logger *slog.Logger, cfg *config.Config) (*httpserver.S
 		log.Printf("Failed to register gateway: %v", err)
 		return nil, err
 	}
+
+	err = gen.RegisterAuthServiceHandlerFromEndpoint(ctx, mux, grpcAddr, opts)
+	if err != nil {
+		log.Printf("Failed to register auth gateway: %v", err)
+		return nil, err
+	}
 	
 	gatewayServer := httpserver.New(logger, gateWayConfig, mux)
 
@@ -237,8 +485,18 @@ func createGateWayServer(logger *slog.Logger, cfg *config.Config) (*httpserver.S
 }
 
 
+// gatewayHeaderMatcher пробрасывает x-request-id из HTTP запроса в gRPC метаданные.
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, "X-Request-Id") {
+		return "x-request-id", true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+
 func createHTTPServer(logger *slog.Logger, cfg *config.Config, authController httpcontrollers.AuthController, 
-	pvzController httpcontrollers.PvzController, tokenService token.Token) *httpserver.Server {
+	pvzController httpcontrollers.PvzController, jwksController httpcontrollers.JWKSController,
+	tokenService token.Token, revocations token.RevocationList, users token.UserStatus, rbacPolicy *rbac.Policy) (*httpserver.Server, error) {
 
 	logger.Info("Creating HTTP server...")
 
@@ -254,16 +512,23 @@ func createHTTPServer(logger *slog.Logger, cfg *config.Config, authController ht
 
 	router := gin.New()
 
+	// по умолчанию gin верит X-Forwarded-For от любого клиента, и ограничение попыток входа по IP
+	// обходится подменой заголовка. Без настроенных прокси ClientIP - адрес соединения.
+	if err := router.SetTrustedProxies(cfg.HTTPServer.TrustedProxies); err != nil {
+		logger.Error("Invalid trusted proxies", slog.String("error", err.Error()))
+		return nil, err
+	}
+
 	router.Use(middleware.Duration())
 	router.Use(cors.New(config))
 
-	SetUpRoutes(router, authController, pvzController, tokenService)
+	SetUpRoutes(router, authController, pvzController, jwksController, tokenService, revocations, users, rbacPolicy, cfg.IsDevelopment())
 
 	httpServer := httpserver.New(logger, httpServerConfig, router)
 
 	logger.Info("HTTP Server Created")
 
-	return httpServer
+	return httpServer, nil
 }
 
 
@@ -3,25 +3,59 @@ package app
 import (
 	"github.com/Ranik23/avito-tech-spring/internal/controllers/http"
 	"github.com/Ranik23/avito-tech-spring/internal/controllers/http/middleware"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/token"
 	"github.com/gin-gonic/gin"
 )
 
 func SetUpRoutes(router *gin.Engine, authController http.AuthController,
-	pvzController http.PvzController, tokenService token.Token) {
+	pvzController http.PvzController, jwksController http.JWKSController,
+	tokenService token.Token, revocations token.RevocationList, users token.UserStatus, policy *rbac.Policy, devMode bool) {
 
-	router.POST("/dummyLogin", authController.DummyLogin)
+	router.GET("/.well-known/jwks.json", jwksController.JWKS)
+
+	// вне режима разработки тестовые токены не выдаются и не принимаются
+	if devMode {
+		router.POST("/dummyLogin", authController.DummyLogin)
+	}
 	router.POST("/register", authController.Register)
 	router.POST("/login", authController.Login)
+	router.POST("/refresh", authController.Refresh)
+	router.POST("/password/reset/request", authController.RequestPasswordReset)
+	router.POST("/password/reset", authController.ResetPassword)
 
 	group := router.Group("/")
-	group.Use(middleware.JwtAuth(tokenService))
+	group.Use(middleware.JwtAuth(tokenService, revocations, users, !devMode))
 	{
-		group.POST("/pvz", pvzController.CreatePvz)
-		group.POST("/receptions", pvzController.CreateReception)
-		group.POST("/products", pvzController.AddProduct)
-		group.POST("/pvz/:pvzId/delete_last_product", pvzController.DeleteLastProduct)
-		group.POST("/pvz/:pvzId/close_last_reception", pvzController.CloseLastReception)
-		group.GET("/pvz", pvzController.GetPvzInfo)
+		group.POST("/logout", authController.Logout)
+		group.POST("/password/change", authController.ChangePassword)
+		group.POST("/pvz", middleware.RequirePermission(policy, rbac.PVZCreate), pvzController.CreatePvz)
+		group.POST("/receptions", middleware.RequirePermission(policy, rbac.ReceptionOpen), pvzController.CreateReception)
+		group.POST("/products", middleware.RequirePermission(policy, rbac.ProductAdd), pvzController.AddProduct)
+		group.POST("/products/batch", middleware.RequirePermission(policy, rbac.ProductAdd), pvzController.AddProducts)
+		group.GET("/products", middleware.RequirePermission(policy, rbac.PVZRead), pvzController.FindProductsByBarcode)
+		group.POST("/pvz/:pvzId/delete_last_product", middleware.RequirePermission(policy, rbac.ProductDelete), pvzController.DeleteLastProduct)
+		group.DELETE("/pvz/:pvzId/products/:productId", middleware.RequirePermission(policy, rbac.ProductDelete), pvzController.DeleteProduct)
+		group.POST("/pvz/:pvzId/products/:productId/restore", middleware.RequirePermission(policy, rbac.ProductDelete), pvzController.RestoreProduct)
+		group.POST("/pvz/:pvzId/close_last_reception", middleware.RequirePermission(policy, rbac.ReceptionClose), pvzController.CloseLastReception)
+		group.POST("/receptions/:receptionId/pause", middleware.RequirePermission(policy, rbac.ReceptionClose), pvzController.PauseReception)
+		group.POST("/receptions/:receptionId/resume", middleware.RequirePermission(policy, rbac.ReceptionOpen), pvzController.ResumeReception)
+		group.POST("/receptions/:receptionId/cancel", middleware.RequirePermission(policy, rbac.ReceptionClose), pvzController.CancelReception)
+		group.POST("/receptions/:receptionId/reopen", middleware.RequirePermission(policy, rbac.ReceptionReopen), pvzController.ReopenReception)
+		group.GET("/receptions/:receptionId/history", middleware.RequirePermission(policy, rbac.PVZRead), pvzController.GetReceptionHistory)
+		group.GET("/pvz", middleware.RequirePermission(policy, rbac.PVZRead), pvzController.GetPvzInfo)
+		group.POST("/pvz/:pvzId/employees", middleware.RequirePermission(policy, rbac.PVZAssign), pvzController.AssignEmployee)
+		group.DELETE("/pvz/:pvzId/employees/:userId", middleware.RequirePermission(policy, rbac.PVZAssign), pvzController.UnassignEmployee)
+		group.GET("/audit", middleware.RequirePermission(policy, rbac.AuditRead), pvzController.GetAuditLog)
+		group.GET("/users", middleware.RequirePermission(policy, rbac.UserManage), authController.ListUsers)
+		group.GET("/users/:userId", middleware.RequirePermission(policy, rbac.UserManage), authController.GetUser)
+		group.PUT("/users/:userId/role", middleware.RequirePermission(policy, rbac.UserManage), authController.UpdateUserRole)
+		group.POST("/users/:userId/deactivate", middleware.RequirePermission(policy, rbac.UserManage), authController.DeactivateUser)
+		group.POST("/users/:userId/activate", middleware.RequirePermission(policy, rbac.UserManage), authController.ActivateUser)
+		group.DELETE("/users/:userId", middleware.RequirePermission(policy, rbac.UserManage), authController.DeleteUser)
+		group.GET("/product_types", pvzController.ListProductTypes)
+		group.POST("/product_types", middleware.RequirePermission(policy, rbac.ProductTypeManage), pvzController.CreateProductType)
+		group.PUT("/product_types/:name", middleware.RequirePermission(policy, rbac.ProductTypeManage), pvzController.UpdateProductType)
+		group.DELETE("/product_types/:name", middleware.RequirePermission(policy, rbac.ProductTypeManage), pvzController.DeleteProductType)
 	}
 }
@@ -1,18 +1,35 @@
 package config
 
 import (
+	"fmt"
+
 	"github.com/joho/godotenv"
 	"github.com/spf13/viper"
 )
 
 
 
+// Окружения. Все, кроме development, считаются боевыми: DummyLogin выключен, dummy токены отклоняются.
+const (
+	EnvDevelopment = "development"
+	EnvProduction  = "production"
+)
+
 type Config struct {           
+	Env				string				`yaml:"Env"`
 	HTTPServer   	HTTPServerConfig   	`yaml:"HTTPServer"`
 	GRPCServer   	GRPCServerConfig   	`yaml:"GRPCServer"`
 	GatewayServer 	GatewayServer		`yaml:"GatewayServer"`
 	Storage      	StorageConfig      	`yaml:"Storage"`
 	MetricServer	MetricServerConfig	`yaml:"MetricServer"`
+	Events			EventsConfig		`yaml:"Events"`
+	Outbox			OutboxConfig		`yaml:"Outbox"`
+	Token			TokenConfig			`yaml:"Token"`
+	RBAC			RBACConfig			`yaml:"RBAC"`
+	LoginLimiter	LoginLimiterConfig	`yaml:"LoginLimiter"`
+	Password		PasswordConfig		`yaml:"Password"`
+	Notifier		NotifierConfig		`yaml:"Notifier"`
+	Hasher			HasherConfig		`yaml:"Hasher"`
 	SecretKey    	string				`yaml:"-"`
 	Cities		 	[]string			`yaml:"Cities"`
 }
@@ -41,6 +58,16 @@ func LoadConfig(configPath, envPath string) (*Config, error) {
 	}
 
 	config.SecretKey = viper.GetString("SECRET_KEY")
+
+	if env := viper.GetString("APP_ENV"); env != "" {
+		config.Env = env
+	}
+	if config.Env == "" {
+		config.Env = EnvProduction
+	}
+	if config.Env != EnvDevelopment && config.Env != EnvProduction {
+		return nil, fmt.Errorf("unknown Env %q", config.Env)
+	}
 	
 	config.Storage.Host = viper.GetString("DB_HOST")
 	config.Storage.Password = viper.GetString("DB_PASSWORD")
@@ -49,4 +76,9 @@ func LoadConfig(configPath, envPath string) (*Config, error) {
 
 
 	return &config, nil
+}
+
+// IsDevelopment - включены ли инструменты разработки, например DummyLogin.
+func (c *Config) IsDevelopment() bool {
+	return c.Env == EnvDevelopment
 }
\ No newline at end of file
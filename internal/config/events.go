@@ -0,0 +1,6 @@
+package config
+
+type EventsConfig struct {
+	HistorySize int		`yaml:"HistorySize"`
+	BufferSize  int		`yaml:"BufferSize"`
+}
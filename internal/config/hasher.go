@@ -0,0 +1,11 @@
+package config
+
+type HasherConfig struct {
+	Algorithm        string `yaml:"Algorithm"` // bcrypt, argon2id
+	BcryptCost       int    `yaml:"BcryptCost"`
+	Argon2Time       uint32 `yaml:"Argon2Time"`
+	Argon2Memory     uint32 `yaml:"Argon2Memory"` // в КиБ
+	Argon2Threads    uint8  `yaml:"Argon2Threads"`
+	Argon2KeyLength  uint32 `yaml:"Argon2KeyLength"`
+	Argon2SaltLength uint32 `yaml:"Argon2SaltLength"`
+}
@@ -4,4 +4,7 @@ type HTTPServerConfig struct {
 	Host 			string		`yaml:"host"`
 	Port 			string		`yaml:"port"`
 	ShutdownTimeout int64		`yaml:"ShutDown"`
+	// TrustedProxies - адреса и подсети прокси, которым можно верить в X-Forwarded-For.
+	// Пустой список - заголовок игнорируется, адрес клиента берется из соединения.
+	TrustedProxies	[]string	`yaml:"TrustedProxies"`
 }
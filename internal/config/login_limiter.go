@@ -0,0 +1,11 @@
+package config
+
+type LoginLimiterConfig struct {
+	Store            string `yaml:"Store"` // memory, postgres
+	MaxEmailAttempts int    `yaml:"MaxEmailAttempts"`
+	MaxIPAttempts    int    `yaml:"MaxIPAttempts"`   // 0 - без ограничения по IP
+	Window           int    `yaml:"Window"`          // в секундах
+	BaseLockout      int    `yaml:"BaseLockout"`     // в секундах
+	MaxLockout       int    `yaml:"MaxLockout"`      // в секундах
+	CleanupInterval  int    `yaml:"CleanupInterval"` // в секундах, 0 - без очистки устаревших записей
+}
@@ -0,0 +1,6 @@
+package config
+
+type NotifierConfig struct {
+	Type     string `yaml:"Type"` // log, file
+	FilePath string `yaml:"FilePath"`
+}
@@ -0,0 +1,13 @@
+package config
+
+type OutboxConfig struct {
+	Enabled      bool   `yaml:"Enabled"`
+	Sink         string `yaml:"Sink"` // stdout, file, webhook
+	FilePath     string `yaml:"FilePath"`
+	WebhookURL   string `yaml:"WebhookURL"`
+	PollInterval int    `yaml:"PollInterval"` // в миллисекундах
+	BatchSize    int    `yaml:"BatchSize"`
+	MaxAttempts  int    `yaml:"MaxAttempts"`
+	RetryBackoff int    `yaml:"RetryBackoff"` // в секундах
+	SendTimeout  int    `yaml:"SendTimeout"`  // в секундах
+}
@@ -0,0 +1,33 @@
+package config
+
+import (
+	"github.com/Ranik23/avito-tech-spring/internal/credentials"
+)
+
+type PasswordConfig struct {
+	MinLength     int  `yaml:"MinLength"`
+	MaxLength     int  `yaml:"MaxLength"`
+	RequireUpper  bool `yaml:"RequireUpper"`
+	RequireLower  bool `yaml:"RequireLower"`
+	RequireDigit  bool `yaml:"RequireDigit"`
+	RequireSymbol bool `yaml:"RequireSymbol"`
+	ResetTokenTTL int  `yaml:"ResetTokenTTL"` // в секундах
+}
+
+// Policy собирает политику паролей. Незаданные длины берутся из credentials.DefaultPasswordPolicy.
+func (p PasswordConfig) Policy() credentials.PasswordPolicy {
+	policy := credentials.DefaultPasswordPolicy()
+	if p.MinLength > 0 {
+		policy.MinLength = p.MinLength
+	}
+	if p.MaxLength > 0 {
+		policy.MaxLength = p.MaxLength
+	}
+
+	policy.RequireUpper = p.RequireUpper
+	policy.RequireLower = p.RequireLower
+	policy.RequireDigit = p.RequireDigit
+	policy.RequireSymbol = p.RequireSymbol
+
+	return policy
+}
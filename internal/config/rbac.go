@@ -0,0 +1,25 @@
+package config
+
+import (
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+)
+
+type RBACConfig struct {
+	Roles             map[string][]string `yaml:"Roles"` // роль -> права, "*" - все права
+	SelfRegisterRoles []string            `yaml:"SelfRegisterRoles"`
+}
+
+// Policy собирает политику доступа. Без секции RBAC в конфиге используются роли employee и moderator.
+func (r RBACConfig) Policy() (*rbac.Policy, error) {
+	roles := r.Roles
+	if len(roles) == 0 {
+		roles = rbac.DefaultRoles()
+	}
+
+	selfRegister := r.SelfRegisterRoles
+	if len(selfRegister) == 0 {
+		selfRegister = rbac.DefaultSelfRegisterRoles()
+	}
+
+	return rbac.NewPolicy(roles, selfRegister)
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"github.com/Ranik23/avito-tech-spring/internal/token"
+)
+
+type TokenConfig struct {
+	Issuer     string `yaml:"Issuer"`
+	Audience   string `yaml:"Audience"`
+	AccessTTL  int    `yaml:"AccessTTL"`  // в секундах
+	RefreshTTL int    `yaml:"RefreshTTL"` // в секундах
+
+	// Ключи асимметричной подписи. Если не заданы ни Keys, ни KeyDir, токены подписываются HS256 с SECRET_KEY.
+	ActiveKeyID       string           `yaml:"ActiveKeyID"` // пустой - ключ с наибольшим kid
+	KeyDir            string           `yaml:"KeyDir"`      // *.pem, kid - имя файла
+	Keys              []TokenKeyConfig `yaml:"Keys"`
+	KeyReloadInterval int              `yaml:"KeyReloadInterval"` // в секундах, 0 - без перечитывания
+}
+
+type TokenKeyConfig struct {
+	ID   string `yaml:"ID"`
+	Path string `yaml:"Path"`
+}
+
+// LoadKeys читает ключи из Keys и KeyDir. Возвращает nil, если асимметричная подпись не настроена.
+func (t TokenConfig) LoadKeys() (*token.KeySet, error) {
+	if t.KeyDir == "" && len(t.Keys) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]token.Key, 0, len(t.Keys))
+	for _, keyConfig := range t.Keys {
+		key, err := token.LoadKeyFile(keyConfig.ID, keyConfig.Path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	if t.KeyDir != "" {
+		dirKeys, err := token.LoadKeyDir(t.KeyDir)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, dirKeys...)
+	}
+
+	return token.NewKeySet(t.ActiveKeyID, keys)
+}
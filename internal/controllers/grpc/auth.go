@@ -0,0 +1,255 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
+	"github.com/Ranik23/avito-tech-spring/internal/controllers/grpc/interceptors"
+	converter "github.com/Ranik23/avito-tech-spring/internal/models/converter/grpc"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+
+type AuthServer struct {
+	pvz_v1.UnimplementedAuthServiceServer
+	service service.Service
+}
+
+
+func NewAuthServer(service service.Service) *AuthServer {
+	return &AuthServer{
+		service: service,
+	}
+}
+
+
+func (a *AuthServer) DummyLogin(ctx context.Context, req *pvz_v1.DummyLoginRequest) (*pvz_v1.DummyLoginResponse, error) {
+	token, err := a.service.DummyLogin(ctx, req.GetRole())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.DummyLoginResponse{
+		Token: token,
+	}, nil
+}
+
+
+func (a *AuthServer) Register(ctx context.Context, req *pvz_v1.RegisterRequest) (*pvz_v1.RegisterResponse, error) {
+	userID, err := a.service.Register(ctx, req.GetEmail(), req.GetPassword(), req.GetRole())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.RegisterResponse{
+		Id: userID,
+		Email: req.GetEmail(),
+		Role: req.GetRole(),
+	}, nil
+}
+
+
+func (a *AuthServer) Login(ctx context.Context, req *pvz_v1.LoginRequest) (*pvz_v1.LoginResponse, error) {
+	pair, err := a.service.Login(ctx, req.GetEmail(), req.GetPassword(), clientIP(ctx))
+	if err != nil {
+		var locked *service.LoginLockedError
+		if errors.As(err, &locked) {
+			// gateway отдаст заголовок как Grpc-Metadata-Retry-After
+			retryAfter := strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds())))
+			_ = grpc.SetHeader(ctx, metadata.Pairs("retry-after", retryAfter))
+		}
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.LoginResponse{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	}, nil
+}
+
+
+// clientIP - адрес клиента для ограничения попыток входа. Берется из адреса соединения: gRPC порт открыт
+// наружу, и x-forwarded-for любой клиент выставит сам. Заголовку верим, только если соединение пришло
+// с loopback, то есть от gateway, который работает в том же процессе. Последний элемент gateway
+// дописывает сам по адресу HTTP клиента.
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		host = p.Addr.String()
+	}
+
+	if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+		return host
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("x-forwarded-for"); len(values) > 0 {
+			forwarded := strings.Split(values[len(values)-1], ",")
+			if ip := strings.TrimSpace(forwarded[len(forwarded)-1]); ip != "" {
+				return ip
+			}
+		}
+	}
+
+	return host
+}
+
+
+func (a *AuthServer) Refresh(ctx context.Context, req *pvz_v1.RefreshRequest) (*pvz_v1.RefreshResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	pair, err := a.service.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.RefreshResponse{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	}, nil
+}
+
+
+// Logout берет jti и exp текущего токена из контекста, их кладет интерсептор авторизации.
+func (a *AuthServer) Logout(ctx context.Context, req *pvz_v1.LogoutRequest) (*pvz_v1.LogoutResponse, error) {
+	userID, _ := interceptors.UserIDFromContext(ctx)
+	jti, ok := interceptors.TokenIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no token provided")
+	}
+	expiresAt, _ := interceptors.TokenExpiresAtFromContext(ctx)
+
+	err := a.service.Logout(ctx, userID, jti, expiresAt, req.GetRefreshToken())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.LogoutResponse{}, nil
+}
+
+
+// ChangePassword меняет пароль пользователя из токена. У dummy токена нет учетной записи.
+func (a *AuthServer) ChangePassword(ctx context.Context, req *pvz_v1.ChangePasswordRequest) (*pvz_v1.ChangePasswordResponse, error) {
+	if interceptors.DummyTokenFromContext(ctx) {
+		return nil, status.Error(codes.PermissionDenied, "dummy token has no account")
+	}
+	userID, _ := interceptors.UserIDFromContext(ctx)
+
+	err := a.service.ChangePassword(ctx, userID, req.GetOldPassword(), req.GetNewPassword())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ChangePasswordResponse{}, nil
+}
+
+
+func (a *AuthServer) RequestPasswordReset(ctx context.Context, req *pvz_v1.RequestPasswordResetRequest) (*pvz_v1.RequestPasswordResetResponse, error) {
+	err := a.service.RequestPasswordReset(ctx, req.GetEmail())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.RequestPasswordResetResponse{}, nil
+}
+
+
+func (a *AuthServer) ResetPassword(ctx context.Context, req *pvz_v1.ResetPasswordRequest) (*pvz_v1.ResetPasswordResponse, error) {
+	err := a.service.ResetPassword(ctx, req.GetToken(), req.GetNewPassword())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ResetPasswordResponse{}, nil
+}
+
+
+func (a *AuthServer) ListUsers(ctx context.Context, req *pvz_v1.ListUsersRequest) (*pvz_v1.ListUsersResponse, error) {
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = service.DefaultPageLimit
+	}
+	if limit < 1 || limit > service.MaxPageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", service.MaxPageLimit)
+	}
+
+	page, err := a.service.ListUsers(ctx, req.GetCursor(), limit)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ListUsersResponse{
+		Users:      converter.FromDomainUsersToGRPC(page.Items),
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+
+func (a *AuthServer) GetUser(ctx context.Context, req *pvz_v1.GetUserRequest) (*pvz_v1.GetUserResponse, error) {
+	user, err := a.service.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.GetUserResponse{User: converter.FromDomainUserToGRPC(user)}, nil
+}
+
+
+func (a *AuthServer) UpdateUserRole(ctx context.Context, req *pvz_v1.UpdateUserRoleRequest) (*pvz_v1.UpdateUserRoleResponse, error) {
+	if req.GetRole() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no role provided")
+	}
+
+	user, err := a.service.UpdateUserRole(ctx, req.GetUserId(), req.GetRole())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.UpdateUserRoleResponse{User: converter.FromDomainUserToGRPC(user)}, nil
+}
+
+
+func (a *AuthServer) DeactivateUser(ctx context.Context, req *pvz_v1.DeactivateUserRequest) (*pvz_v1.DeactivateUserResponse, error) {
+	user, err := a.service.DeactivateUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.DeactivateUserResponse{User: converter.FromDomainUserToGRPC(user)}, nil
+}
+
+
+func (a *AuthServer) ActivateUser(ctx context.Context, req *pvz_v1.ActivateUserRequest) (*pvz_v1.ActivateUserResponse, error) {
+	user, err := a.service.ActivateUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ActivateUserResponse{User: converter.FromDomainUserToGRPC(user)}, nil
+}
+
+
+func (a *AuthServer) DeleteUser(ctx context.Context, req *pvz_v1.DeleteUserRequest) (*pvz_v1.DeleteUserResponse, error) {
+	if err := a.service.DeleteUser(ctx, req.GetUserId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.DeleteUserResponse{}, nil
+}
@@ -0,0 +1,264 @@
+//go:build unit
+
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/Ranik23/avito-tech-spring/internal/controllers/grpc/interceptors"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+	"github.com/Ranik23/avito-tech-spring/internal/service/mock"
+	tokenmock "github.com/Ranik23/avito-tech-spring/internal/token/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthServerDummyLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+
+	mockService.EXPECT().DummyLogin(gomock.Any(), "moderator").Return("token", nil)
+	resp, err := server.DummyLogin(context.Background(), &pvz_v1.DummyLoginRequest{Role: "moderator"})
+	assert.NoError(t, err)
+	assert.Equal(t, "token", resp.Token)
+
+	mockService.EXPECT().DummyLogin(gomock.Any(), "client").Return("", service.ErrInvalidRole)
+	_, err = server.DummyLogin(context.Background(), &pvz_v1.DummyLoginRequest{Role: "client"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthServerRegister(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+
+	mockService.EXPECT().Register(gomock.Any(), "user@example.com", "secret", "employee").Return("1", nil)
+	resp, err := server.Register(context.Background(), &pvz_v1.RegisterRequest{
+		Email: "user@example.com", Password: "secret", Role: "employee",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", resp.Id)
+	assert.Equal(t, "employee", resp.Role)
+
+	mockService.EXPECT().Register(gomock.Any(), "user@example.com", "secret", "employee").Return("", service.ErrAlreadyExists)
+	_, err = server.Register(context.Background(), &pvz_v1.RegisterRequest{
+		Email: "user@example.com", Password: "secret", Role: "employee",
+	})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+}
+
+func TestAuthServerLogin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+
+	tests := []struct {
+		name         string
+		returnErr    error
+		expectedCode codes.Code
+	}{
+		{"success", nil, codes.OK},
+		{"invalid credentials", service.ErrInvalidCredentials, codes.Unauthenticated},
+		{"user not found", service.ErrUserNotFound, codes.NotFound},
+		{"locked", &service.LoginLockedError{RetryAfter: time.Minute}, codes.ResourceExhausted},
+		{"internal", errors.New("db error"), codes.Internal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pair *domain.TokenPair
+			if tt.returnErr == nil {
+				pair = &domain.TokenPair{AccessToken: "token", RefreshToken: "refresh"}
+			}
+			mockService.EXPECT().Login(gomock.Any(), "user@example.com", "secret", "").Return(pair, tt.returnErr)
+
+			resp, err := server.Login(context.Background(), &pvz_v1.LoginRequest{Email: "user@example.com", Password: "secret"})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "token", resp.Token)
+				assert.Equal(t, "refresh", resp.RefreshToken)
+			}
+		})
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	forwarded := metadata.Pairs("x-forwarded-for", "203.0.113.7, 198.51.100.2")
+
+	// gateway подключается к gRPC порту с loopback и дописывает адрес HTTP клиента
+	gatewayPeer := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 5555}}
+	gatewayCtx := metadata.NewIncomingContext(peer.NewContext(context.Background(), gatewayPeer), forwarded)
+	assert.Equal(t, "198.51.100.2", clientIP(gatewayCtx))
+
+	clientPeer := &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 5555}}
+	peerCtx := peer.NewContext(context.Background(), clientPeer)
+	assert.Equal(t, "192.0.2.10", clientIP(peerCtx))
+
+	// прямой клиент не может подменить адрес заголовком
+	forgedCtx := metadata.NewIncomingContext(peer.NewContext(context.Background(), clientPeer), forwarded)
+	assert.Equal(t, "192.0.2.10", clientIP(forgedCtx))
+
+	assert.Equal(t, "127.0.0.1", clientIP(peer.NewContext(context.Background(), gatewayPeer)))
+	assert.Empty(t, clientIP(metadata.NewIncomingContext(context.Background(), forwarded)))
+}
+
+func TestAuthServerRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+
+	mockService.EXPECT().Refresh(gomock.Any(), "refresh").Return(&domain.TokenPair{AccessToken: "token", RefreshToken: "next"}, nil)
+	resp, err := server.Refresh(context.Background(), &pvz_v1.RefreshRequest{RefreshToken: "refresh"})
+	assert.NoError(t, err)
+	assert.Equal(t, "token", resp.Token)
+	assert.Equal(t, "next", resp.RefreshToken)
+
+	mockService.EXPECT().Refresh(gomock.Any(), "stale").Return(nil, service.ErrInvalidRefreshToken)
+	_, err = server.Refresh(context.Background(), &pvz_v1.RefreshRequest{RefreshToken: "stale"})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	_, err = server.Refresh(context.Background(), &pvz_v1.RefreshRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestAuthServerLogout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+	mockToken := tokenmock.NewMockToken(ctrl)
+	mockRevocations := tokenmock.NewMockRevocationList(ctrl)
+	mockUsers := tokenmock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	interceptor := interceptors.AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, interceptors.DefaultAccessPolicy(rbac.DefaultPolicy()), slog.Default())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+
+	mockToken.EXPECT().Parse("good").
+		Return(map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-1", "exp": float64(1700000000)}, nil)
+	mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+	mockService.EXPECT().Logout(gomock.Any(), "1", "jti-1", time.Unix(1700000000, 0), "refresh").Return(nil)
+	_, err := interceptor(ctx, &pvz_v1.LogoutRequest{RefreshToken: "refresh"},
+		&grpc.UnaryServerInfo{FullMethod: pvz_v1.AuthService_Logout_FullMethodName},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return server.Logout(ctx, req.(*pvz_v1.LogoutRequest))
+		})
+	assert.NoError(t, err)
+
+	// без интерсептора в контексте нет jti
+	_, err = server.Logout(context.Background(), &pvz_v1.LogoutRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthServerChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+	mockToken := tokenmock.NewMockToken(ctrl)
+	mockRevocations := tokenmock.NewMockRevocationList(ctrl)
+	mockUsers := tokenmock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	interceptor := interceptors.AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, interceptors.DefaultAccessPolicy(rbac.DefaultPolicy()), slog.Default())
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return server.ChangePassword(ctx, req.(*pvz_v1.ChangePasswordRequest))
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.AuthService_ChangePassword_FullMethodName}
+
+	mockToken.EXPECT().Parse("good").
+		Return(map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-1"}, nil)
+	mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+	mockService.EXPECT().ChangePassword(gomock.Any(), "1", "old", "weak").
+		Return(fmt.Errorf("%w: password must be at least 8 characters", service.ErrWeakPassword))
+	_, err := interceptor(ctx, &pvz_v1.ChangePasswordRequest{OldPassword: "old", NewPassword: "weak"}, info, handler)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockToken.EXPECT().Parse("good").
+		Return(map[string]interface{}{"user_id": "dummyRandomID", "role": "employee", "jti": "jti-2", "dummy": true}, nil)
+	mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-2").Return(false, nil)
+	_, err = interceptor(ctx, &pvz_v1.ChangePasswordRequest{OldPassword: "old", NewPassword: "NewPassword1"}, info, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthServerPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+
+	mockService.EXPECT().RequestPasswordReset(gomock.Any(), "user@example.com").Return(nil)
+	_, err := server.RequestPasswordReset(context.Background(), &pvz_v1.RequestPasswordResetRequest{Email: "user@example.com"})
+	assert.NoError(t, err)
+
+	mockService.EXPECT().ResetPassword(gomock.Any(), "used", "NewPassword1").Return(service.ErrInvalidResetToken)
+	_, err = server.ResetPassword(context.Background(), &pvz_v1.ResetPasswordRequest{Token: "used", NewPassword: "NewPassword1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// сброс пароля доступен без токена
+	policy := interceptors.DefaultAccessPolicy(rbac.DefaultPolicy())
+	assert.True(t, policy.Public[pvz_v1.AuthService_RequestPasswordReset_FullMethodName])
+	assert.True(t, policy.Public[pvz_v1.AuthService_ResetPassword_FullMethodName])
+}
+
+func TestAuthServerUserManagement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewAuthServer(mockService)
+	ctx := context.Background()
+	user := &domain.User{ID: "2", Email: "user@example.com", Role: "moderator"}
+
+	mockService.EXPECT().ListUsers(gomock.Any(), "", service.DefaultPageLimit).
+		Return(&domain.UserPage{Items: []domain.User{*user}, NextCursor: "next"}, nil)
+	listResp, err := server.ListUsers(ctx, &pvz_v1.ListUsersRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, listResp.GetUsers(), 1)
+	assert.Equal(t, "next", listResp.GetNextCursor())
+
+	_, err = server.ListUsers(ctx, &pvz_v1.ListUsersRequest{Limit: 1000})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockService.EXPECT().UpdateUserRole(gomock.Any(), "2", "moderator").Return(user, nil)
+	roleResp, err := server.UpdateUserRole(ctx, &pvz_v1.UpdateUserRoleRequest{UserId: "2", Role: "moderator"})
+	assert.NoError(t, err)
+	assert.Equal(t, "moderator", roleResp.GetUser().GetRole())
+
+	mockService.EXPECT().DeactivateUser(gomock.Any(), "1").Return(nil, service.ErrSelfManagement)
+	_, err = server.DeactivateUser(ctx, &pvz_v1.DeactivateUserRequest{UserId: "1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	mockService.EXPECT().DeleteUser(gomock.Any(), "9").Return(service.ErrUserNotFound)
+	_, err = server.DeleteUser(ctx, &pvz_v1.DeleteUserRequest{UserId: "9"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockService.EXPECT().Login(gomock.Any(), "user@example.com", "Password1", gomock.Any()).Return(nil, service.ErrUserDisabled)
+	_, err = server.Login(ctx, &pvz_v1.LoginRequest{Email: "user@example.com", Password: "Password1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"errors"
+
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+
+var errToCode = []struct {
+	err  error
+	code codes.Code
+}{
+	{service.ErrAlreadyExists, codes.AlreadyExists},
+	{service.ErrNotFound, codes.NotFound},
+	{service.ErrNoPVZFound, codes.NotFound},
+	{service.ErrUserNotFound, codes.NotFound},
+	{service.ErrAlreadyOpen, codes.FailedPrecondition},
+	{service.ErrAllReceptionsClosed, codes.FailedPrecondition},
+	{service.ErrReceptionEmpty, codes.FailedPrecondition},
+	{service.ErrEmpty, codes.FailedPrecondition},
+	{service.ErrInvalidCredentials, codes.Unauthenticated},
+	{service.ErrInvalidRole, codes.InvalidArgument},
+	{service.ErrInvalidCity, codes.InvalidArgument},
+	{service.ErrSequenceExpired, codes.OutOfRange},
+	{service.ErrInvalidBatchSize, codes.InvalidArgument},
+	{service.ErrInvalidPagination, codes.InvalidArgument},
+	{service.ErrInvalidCursor, codes.InvalidArgument},
+	{service.ErrInvalidFilter, codes.InvalidArgument},
+	{service.ErrInvalidRefreshToken, codes.Unauthenticated},
+	{service.ErrDummyLoginDisabled, codes.PermissionDenied},
+	{service.ErrPVZAccessDenied, codes.PermissionDenied},
+	{service.ErrTooManyAttempts, codes.ResourceExhausted},
+	{service.ErrInvalidEmail, codes.InvalidArgument},
+	{service.ErrWeakPassword, codes.InvalidArgument},
+	{service.ErrInvalidResetToken, codes.InvalidArgument},
+	{service.ErrUserDisabled, codes.PermissionDenied},
+	{service.ErrSelfManagement, codes.FailedPrecondition},
+	{service.ErrInsufficientPermissions, codes.PermissionDenied},
+	{service.ErrInvalidProductType, codes.InvalidArgument},
+	{service.ErrProductTypeNotFound, codes.NotFound},
+	{service.ErrInvalidBarcode, codes.InvalidArgument},
+	{service.ErrInvalidOrderID, codes.InvalidArgument},
+	{service.ErrDuplicateBarcode, codes.AlreadyExists},
+	{service.ErrProductNotFound, codes.NotFound},
+	{service.ErrProductNotDeleted, codes.FailedPrecondition},
+	{service.ErrReceptionNotFound, codes.NotFound},
+	{service.ErrReceptionPaused, codes.FailedPrecondition},
+	{service.ErrInvalidReceptionTransition, codes.FailedPrecondition},
+	{service.ErrInvalidReason, codes.InvalidArgument},
+}
+
+// toStatusError переводит ошибки сервисного слоя в gRPC статусы.
+// Неизвестные ошибки наружу не отдаются и превращаются в codes.Internal.
+func toStatusError(err error) error {
+	// в ошибке типа товара есть сам тип и причина, их можно отдать клиенту
+	var invalidType *service.InvalidProductTypeError
+	if errors.As(err, &invalidType) {
+		return status.Error(codes.InvalidArgument, invalidType.Error())
+	}
+
+	for _, mapping := range errToCode {
+		if errors.Is(err, mapping.err) {
+			return status.Error(mapping.code, mapping.err.Error())
+		}
+	}
+	return status.Error(codes.Internal, "Internal Server Error")
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
 	"github.com/Ranik23/avito-tech-spring/internal/models/converter/grpc"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -25,14 +26,449 @@ func NewPVZServer(service service.Service) *PVZServer {
 
 
 func (pvz *PVZServer) GetPVZList(ctx context.Context, req *pvz_v1.GetPVZListRequest) (*pvz_v1.GetPVZListResponse, error) {
-	pvzs, err := pvz.service.GetPVZList(ctx)
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = service.DefaultPVZListLimit
+	}
+	if limit < 1 || limit > service.MaxPVZListLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", service.MaxPVZListLimit)
+	}
+
+	page, err := pvz.service.GetPVZList(ctx, req.GetCursor(), limit)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "Internal Server Error")
+		return nil, toStatusError(err)
 	}
 	
-	grpcPVZs := grpc.FromDomainPvzListToGRPCList(pvzs)
+	grpcPVZs := grpc.FromDomainPvzListToGRPCList(page.Items)
 
 	return &pvz_v1.GetPVZListResponse{
-		Pvzs: grpcPVZs,
+		Pvzs:       grpcPVZs,
+		NextCursor: page.NextCursor,
+	}, nil
+}
+
+
+func (pvz *PVZServer) CreatePVZ(ctx context.Context, req *pvz_v1.CreatePVZRequest) (*pvz_v1.CreatePVZResponse, error) {
+	if req.GetCity() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no city provided")
+	}
+
+	createdPvz, err := pvz.service.CreatePVZ(ctx, req.GetCity())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.CreatePVZResponse{
+		Pvz: grpc.FromDomainPvzToGRPC(createdPvz),
+	}, nil
+}
+
+
+func (pvz *PVZServer) GetPVZSInfo(ctx context.Context, req *pvz_v1.GetPVZSInfoRequest) (*pvz_v1.GetPVZSInfoResponse, error) {
+	page := int(req.GetPage())
+	if page == 0 {
+		page = 1
+	}
+	if page < 1 {
+		return nil, status.Error(codes.InvalidArgument, "page must be positive")
+	}
+
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = service.DefaultPageLimit
+	}
+	if limit < 1 || limit > service.MaxPageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", service.MaxPageLimit)
+	}
+
+	query := domain.PvzInfoQuery{
+		Cities:            req.GetCities(),
+		PvzIDs:            req.GetPvzIds(),
+		ReceptionStatuses: grpc.FromGRPCReceptionStatusesToDomain(req.GetReceptionStatuses()),
+		ProductTypes:      req.GetProductTypes(),
+		Page:              page,
+		Limit:             limit,
+		Cursor:            req.GetCursor(),
+	}
+	if req.GetStartDate() != nil {
+		t := req.GetStartDate().AsTime()
+		query.StartDate = &t
+	}
+	if req.GetEndDate() != nil {
+		t := req.GetEndDate().AsTime()
+		query.EndDate = &t
+	}
+
+	pvzsInfo, err := pvz.service.GetPVZSInfo(ctx, query)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.GetPVZSInfoResponse{
+		Items:      grpc.FromDomainPvzInfoListToGRPCList(pvzsInfo.Items),
+		Total:      int32(pvzsInfo.Total),
+		Page:       int32(pvzsInfo.Page),
+		Limit:      int32(pvzsInfo.Limit),
+		NextPage:   int32(pvzsInfo.NextPage),
+		NextCursor: pvzsInfo.NextCursor,
+	}, nil
+}
+
+
+func (pvz *PVZServer) StartReception(ctx context.Context, req *pvz_v1.StartReceptionRequest) (*pvz_v1.StartReceptionResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+
+	reception, err := pvz.service.StartReception(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.StartReceptionResponse{
+		Reception: grpc.FromDomainReceptionToGRPC(reception),
+	}, nil
+}
+
+
+func (pvz *PVZServer) CloseReception(ctx context.Context, req *pvz_v1.CloseReceptionRequest) (*pvz_v1.CloseReceptionResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+
+	reception, err := pvz.service.CloseReception(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.CloseReceptionResponse{
+		Reception: grpc.FromDomainReceptionToGRPC(reception),
+	}, nil
+}
+
+
+func (pvz *PVZServer) PauseReception(ctx context.Context, req *pvz_v1.PauseReceptionRequest) (*pvz_v1.PauseReceptionResponse, error) {
+	if req.GetReceptionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no receptionId provided")
+	}
+
+	reception, err := pvz.service.PauseReception(ctx, req.GetReceptionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.PauseReceptionResponse{
+		Reception: grpc.FromDomainReceptionToGRPC(reception),
+	}, nil
+}
+
+
+func (pvz *PVZServer) ResumeReception(ctx context.Context, req *pvz_v1.ResumeReceptionRequest) (*pvz_v1.ResumeReceptionResponse, error) {
+	if req.GetReceptionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no receptionId provided")
+	}
+
+	reception, err := pvz.service.ResumeReception(ctx, req.GetReceptionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ResumeReceptionResponse{
+		Reception: grpc.FromDomainReceptionToGRPC(reception),
+	}, nil
+}
+
+
+func (pvz *PVZServer) CancelReception(ctx context.Context, req *pvz_v1.CancelReceptionRequest) (*pvz_v1.CancelReceptionResponse, error) {
+	if req.GetReceptionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no receptionId provided")
+	}
+
+	reception, err := pvz.service.CancelReception(ctx, req.GetReceptionId(), req.GetReason())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.CancelReceptionResponse{
+		Reception: grpc.FromDomainReceptionToGRPC(reception),
+	}, nil
+}
+
+
+func (pvz *PVZServer) ReopenReception(ctx context.Context, req *pvz_v1.ReopenReceptionRequest) (*pvz_v1.ReopenReceptionResponse, error) {
+	if req.GetReceptionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no receptionId provided")
+	}
+
+	reception, err := pvz.service.ReopenReception(ctx, req.GetReceptionId(), req.GetReason())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ReopenReceptionResponse{
+		Reception: grpc.FromDomainReceptionToGRPC(reception),
+	}, nil
+}
+
+
+func (pvz *PVZServer) GetReceptionHistory(ctx context.Context, req *pvz_v1.GetReceptionHistoryRequest) (*pvz_v1.GetReceptionHistoryResponse, error) {
+	if req.GetReceptionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no receptionId provided")
+	}
+
+	history, err := pvz.service.GetReceptionHistory(ctx, req.GetReceptionId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.GetReceptionHistoryResponse{
+		Items: grpc.FromDomainReceptionHistoryToGRPC(history),
+	}, nil
+}
+
+
+func (pvz *PVZServer) AddProduct(ctx context.Context, req *pvz_v1.AddProductRequest) (*pvz_v1.AddProductResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+
+	product, err := pvz.service.AddProduct(ctx, req.GetPvzId(), domain.ProductItem{
+		Type:    req.GetType(),
+		Barcode: req.GetBarcode(),
+		OrderID: req.GetOrderId(),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.AddProductResponse{
+		Product: grpc.FromDomainProductToGRPC(product),
+	}, nil
+}
+
+
+func (pvz *PVZServer) AddProducts(ctx context.Context, req *pvz_v1.AddProductsRequest) (*pvz_v1.AddProductsResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+
+	items := make([]domain.ProductItem, 0, len(req.GetProducts()))
+	for _, item := range req.GetProducts() {
+		items = append(items, domain.ProductItem{
+			Type:    item.GetType(),
+			Barcode: item.GetBarcode(),
+			OrderID: item.GetOrderId(),
+		})
+	}
+
+	products, err := pvz.service.AddProducts(ctx, req.GetPvzId(), items)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.AddProductsResponse{
+		Products: grpc.FromDomainProductsToGRPC(products),
+	}, nil
+}
+
+
+func (pvz *PVZServer) DeleteLastProduct(ctx context.Context, req *pvz_v1.DeleteLastProductRequest) (*pvz_v1.DeleteLastProductResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+
+	if err := pvz.service.DeleteLastProduct(ctx, req.GetPvzId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.DeleteLastProductResponse{}, nil
+}
+
+
+func (pvz *PVZServer) DeleteProduct(ctx context.Context, req *pvz_v1.DeleteProductRequest) (*pvz_v1.DeleteProductResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no productId provided")
+	}
+
+	if err := pvz.service.DeleteProduct(ctx, req.GetPvzId(), req.GetProductId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.DeleteProductResponse{}, nil
+}
+
+
+func (pvz *PVZServer) RestoreProduct(ctx context.Context, req *pvz_v1.RestoreProductRequest) (*pvz_v1.RestoreProductResponse, error) {
+	if req.GetPvzId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no pvzId provided")
+	}
+	if req.GetProductId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no productId provided")
+	}
+
+	product, err := pvz.service.RestoreProduct(ctx, req.GetPvzId(), req.GetProductId())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.RestoreProductResponse{
+		Product: grpc.FromDomainProductToGRPC(product),
+	}, nil
+}
+
+
+func (pvz *PVZServer) FindProductsByBarcode(ctx context.Context, req *pvz_v1.FindProductsByBarcodeRequest) (*pvz_v1.FindProductsByBarcodeResponse, error) {
+	if req.GetBarcode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "no barcode provided")
+	}
+
+	products, err := pvz.service.FindProductsByBarcode(ctx, req.GetBarcode())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.FindProductsByBarcodeResponse{
+		Products: grpc.FromDomainProductsToGRPC(products),
+	}, nil
+}
+
+
+func (pvz *PVZServer) WatchPVZ(req *pvz_v1.WatchPVZRequest, stream pvz_v1.PVZService_WatchPVZServer) error {
+	ctx := stream.Context()
+
+	events, err := pvz.service.WatchPVZ(ctx, req.GetPvzIds(), req.GetFromSequence())
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	for event := range events {
+		if err := stream.Send(grpc.FromDomainPvzEventToGRPC(event)); err != nil {
+			return err
+		}
+	}
+
+	if ctx.Err() != nil {
+		return nil
+	}
+
+	// канал закрыт брокером: клиент не успевал читать события
+	return status.Error(codes.Aborted, "subscriber is too slow, resume from the last received sequence")
+}
+
+
+func (pvz *PVZServer) AssignEmployee(ctx context.Context, req *pvz_v1.AssignEmployeeRequest) (*pvz_v1.AssignEmployeeResponse, error) {
+	if req.GetPvzId() == "" || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "pvzId and userId are required")
+	}
+
+	if err := pvz.service.AssignEmployee(ctx, req.GetPvzId(), req.GetUserId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.AssignEmployeeResponse{}, nil
+}
+
+
+func (pvz *PVZServer) UnassignEmployee(ctx context.Context, req *pvz_v1.UnassignEmployeeRequest) (*pvz_v1.UnassignEmployeeResponse, error) {
+	if req.GetPvzId() == "" || req.GetUserId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "pvzId and userId are required")
+	}
+
+	if err := pvz.service.UnassignEmployee(ctx, req.GetPvzId(), req.GetUserId()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.UnassignEmployeeResponse{}, nil
+}
+
+
+func (pvz *PVZServer) GetAuditLog(ctx context.Context, req *pvz_v1.GetAuditLogRequest) (*pvz_v1.GetAuditLogResponse, error) {
+	limit := int(req.GetLimit())
+	if limit == 0 {
+		limit = service.DefaultPageLimit
+	}
+	if limit < 1 || limit > service.MaxPageLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", service.MaxPageLimit)
+	}
+
+	query := domain.AuditQuery{
+		UserID: req.GetUserId(),
+		PvzIDs: req.GetPvzIds(),
+		Limit:  limit,
+		Cursor: req.GetCursor(),
+	}
+	if req.GetFrom() != nil {
+		t := req.GetFrom().AsTime()
+		query.From = &t
+	}
+	if req.GetTo() != nil {
+		t := req.GetTo().AsTime()
+		query.To = &t
+	}
+
+	page, err := pvz.service.GetAuditLog(ctx, query)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.GetAuditLogResponse{
+		Items:      grpc.FromDomainAuditEntriesToGRPC(page.Items),
+		NextCursor: page.NextCursor,
 	}, nil
-}
\ No newline at end of file
+}
+
+
+func (pvz *PVZServer) ListProductTypes(ctx context.Context, req *pvz_v1.ListProductTypesRequest) (*pvz_v1.ListProductTypesResponse, error) {
+	productTypes, err := pvz.service.ListProductTypes(ctx)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.ListProductTypesResponse{
+		ProductTypes: grpc.FromDomainProductTypesToGRPC(productTypes),
+	}, nil
+}
+
+
+func (pvz *PVZServer) CreateProductType(ctx context.Context, req *pvz_v1.CreateProductTypeRequest) (*pvz_v1.CreateProductTypeResponse, error) {
+	productType, err := pvz.service.CreateProductType(ctx, domain.ProductType{
+		Name:           req.GetName(),
+		Fragile:        req.GetFragile(),
+		MaxWeightGrams: int(req.GetMaxWeightGrams()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.CreateProductTypeResponse{
+		ProductType: grpc.FromDomainProductTypeToGRPC(productType),
+	}, nil
+}
+
+
+func (pvz *PVZServer) UpdateProductType(ctx context.Context, req *pvz_v1.UpdateProductTypeRequest) (*pvz_v1.UpdateProductTypeResponse, error) {
+	productType, err := pvz.service.UpdateProductType(ctx, domain.ProductType{
+		Name:           req.GetName(),
+		Fragile:        req.GetFragile(),
+		MaxWeightGrams: int(req.GetMaxWeightGrams()),
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.UpdateProductTypeResponse{
+		ProductType: grpc.FromDomainProductTypeToGRPC(productType),
+	}, nil
+}
+
+
+func (pvz *PVZServer) DeleteProductType(ctx context.Context, req *pvz_v1.DeleteProductTypeRequest) (*pvz_v1.DeleteProductTypeResponse, error) {
+	if err := pvz.service.DeleteProductType(ctx, req.GetName()); err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pvz_v1.DeleteProductTypeResponse{}, nil
+}
@@ -6,12 +6,19 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"github.com/Ranik23/avito-tech-spring/internal/service/mock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestGetPVZList(t *testing.T) {
@@ -32,8 +39,8 @@ func TestGetPVZList(t *testing.T) {
 			name: "success",
 			mockExpect: func() {
 				mockService.EXPECT().
-					GetPVZList(gomock.Any()).
-					Return([]domain.Pvz{{ID: "1", City: "Moscow"}}, nil)
+					GetPVZList(gomock.Any(), "", 100).
+					Return(&domain.PvzPage{Items: []domain.Pvz{{ID: "1", City: "Moscow"}}}, nil)
 			},
 			request: &pvz_v1.GetPVZListRequest{},
 			expectedResponse: &pvz_v1.GetPVZListResponse{
@@ -46,16 +53,53 @@ func TestGetPVZList(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			name: "success with cursor",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZList(gomock.Any(), "cursor", 1).
+					Return(&domain.PvzPage{Items: []domain.Pvz{{ID: "2", City: "Kazan"}}, NextCursor: "next"}, nil)
+			},
+			request: &pvz_v1.GetPVZListRequest{Cursor: "cursor", Limit: 1},
+			expectedResponse: &pvz_v1.GetPVZListResponse{
+				Pvzs: []*pvz_v1.PVZ{
+					{
+						Id:   "2",
+						City: "Kazan",
+					},
+				},
+				NextCursor: "next",
+			},
+			expectedError: nil,
+		},
+		{
+			name:             "invalid limit",
+			mockExpect:       func() {},
+			request:          &pvz_v1.GetPVZListRequest{Limit: 1001},
+			expectedResponse: nil,
+			expectedError:    status.Error(codes.InvalidArgument, "limit must be between 1 and 1000"),
+		},
+		{
+			name: "invalid cursor",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZList(gomock.Any(), "broken", 100).
+					Return(nil, service.ErrInvalidCursor)
+			},
+			request:          &pvz_v1.GetPVZListRequest{Cursor: "broken"},
+			expectedResponse: nil,
+			expectedError:    status.Error(codes.InvalidArgument, service.ErrInvalidCursor.Error()),
+		},
 		{
 			name: "service error",
 			mockExpect: func() {
 				mockService.EXPECT().
-					GetPVZList(gomock.Any()).
+					GetPVZList(gomock.Any(), "", 100).
 					Return(nil, errors.New("internal service error"))
 			},
 			request:          &pvz_v1.GetPVZListRequest{},
 			expectedResponse: nil,
-			expectedError:    errors.New("internal service error"),
+			expectedError:    status.Error(codes.Internal, "Internal Server Error"),
 		},
 	}
 
@@ -70,6 +114,7 @@ func TestGetPVZList(t *testing.T) {
 				assert.Equal(t, tt.expectedError.Error(), err.Error())
 			} else {
 				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedResponse.NextCursor, resp.NextCursor)
 				for i := 0; i < len(tt.expectedResponse.Pvzs); i++ {
 					assert.Equal(t, tt.expectedResponse.Pvzs[i].City, resp.Pvzs[i].City)
 					assert.Equal(t, tt.expectedResponse.Pvzs[i].Id, resp.Pvzs[i].Id)
@@ -78,3 +123,835 @@ func TestGetPVZList(t *testing.T) {
 		})
 	}
 }
+
+
+func TestCreatePVZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.CreatePVZRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					CreatePVZ(gomock.Any(), "Moscow").
+					Return(&domain.Pvz{ID: "1", City: "Moscow"}, nil)
+			},
+			request:      &pvz_v1.CreatePVZRequest{City: "Moscow"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "empty city",
+			mockExpect:   func() {},
+			request:      &pvz_v1.CreatePVZRequest{},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid city",
+			mockExpect: func() {
+				mockService.EXPECT().
+					CreatePVZ(gomock.Any(), "London").
+					Return(nil, service.ErrInvalidCity)
+			},
+			request:      &pvz_v1.CreatePVZRequest{City: "London"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			resp, err := server.CreatePVZ(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "1", resp.Pvz.Id)
+				assert.Equal(t, "Moscow", resp.Pvz.City)
+			}
+		})
+	}
+}
+
+func TestGetPVZSInfo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	start := timestamppb.New(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC))
+	end := timestamppb.New(time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.GetPVZSInfoRequest
+		expectedCode codes.Code
+		expectedNext int32
+		expectedCursor string
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{
+						StartDate: ptr(start.AsTime()),
+						EndDate:   ptr(end.AsTime()),
+						Page:      2,
+						Limit:     10,
+					}).
+					Return(&domain.PvzInfoPage{
+						Items: []domain.PvzInfo{{
+							Pvz: domain.Pvz{ID: "1", City: "Moscow"},
+							Receptions: []domain.ReceptionInfo{{
+								Reception: domain.Reception{ID: "2", PvzID: "1", Status: "closed"},
+								Products:  []domain.Product{{ID: "3", ReceptionID: "2", Type: "обувь"}},
+							}},
+						}},
+						Total:    25,
+						Page:     2,
+						Limit:    10,
+						NextPage: 3,
+					}, nil)
+			},
+			request:      &pvz_v1.GetPVZSInfoRequest{StartDate: start, EndDate: end, Page: 2, Limit: 10},
+			expectedCode: codes.OK,
+			expectedNext: 3,
+		},
+		{
+			name:         "invalid page",
+			mockExpect:   func() {},
+			request:      &pvz_v1.GetPVZSInfoRequest{StartDate: start, EndDate: end, Page: -1, Limit: 10},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "invalid limit",
+			mockExpect:   func() {},
+			request:      &pvz_v1.GetPVZSInfoRequest{StartDate: start, EndDate: end, Page: 1, Limit: 31},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "defaults without dates",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{Page: 1, Limit: 10}).
+					Return(&domain.PvzInfoPage{
+						Items: []domain.PvzInfo{{
+							Pvz: domain.Pvz{ID: "1", City: "Moscow"},
+							Receptions: []domain.ReceptionInfo{{
+								Reception: domain.Reception{ID: "2", PvzID: "1", Status: "closed"},
+								Products:  []domain.Product{{ID: "3", ReceptionID: "2", Type: "обувь"}},
+							}},
+						}},
+						Total: 1,
+						Page:  1,
+						Limit: 10,
+					}, nil)
+			},
+			request:      &pvz_v1.GetPVZSInfoRequest{},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "with cursor",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{Page: 1, Limit: 10, Cursor: "cursor"}).
+					Return(&domain.PvzInfoPage{
+						Items: []domain.PvzInfo{{
+							Pvz: domain.Pvz{ID: "1", City: "Moscow"},
+							Receptions: []domain.ReceptionInfo{{
+								Reception: domain.Reception{ID: "2", PvzID: "1", Status: "closed"},
+								Products:  []domain.Product{{ID: "3", ReceptionID: "2", Type: "обувь"}},
+							}},
+						}},
+						Total:      25,
+						Limit:      10,
+						NextCursor: "next",
+					}, nil)
+			},
+			request:      &pvz_v1.GetPVZSInfoRequest{Cursor: "cursor"},
+			expectedCode: codes.OK,
+			expectedCursor: "next",
+		},
+		{
+			name: "with filters",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{
+						Cities:            []string{"Kazan"},
+						PvzIDs:            []string{"1"},
+						ReceptionStatuses: []string{"closed", "in_progress"},
+						ProductTypes:      []string{"электроника"},
+						Page:              1,
+						Limit:             10,
+					}).
+					Return(&domain.PvzInfoPage{
+						Items: []domain.PvzInfo{{
+							Pvz: domain.Pvz{ID: "1", City: "Kazan"},
+							Receptions: []domain.ReceptionInfo{{
+								Reception: domain.Reception{ID: "2", PvzID: "1", Status: "closed"},
+								Products:  []domain.Product{{ID: "3", ReceptionID: "2", Type: "электроника"}},
+							}},
+						}},
+						Total: 1,
+						Page:  1,
+						Limit: 10,
+					}, nil)
+			},
+			request: &pvz_v1.GetPVZSInfoRequest{
+				Cities:  []string{"Kazan"},
+				PvzIds:  []string{"1"},
+				ReceptionStatuses: []pvz_v1.ReceptionStatus{
+					pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED,
+					pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+				},
+				ProductTypes: []string{"электроника"},
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "invalid filter",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{PvzIDs: []string{"abc"}, Page: 1, Limit: 10}).
+					Return(nil, service.ErrInvalidFilter)
+			},
+			request:      &pvz_v1.GetPVZSInfoRequest{PvzIds: []string{"abc"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid cursor",
+			mockExpect: func() {
+				mockService.EXPECT().
+					GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{Page: 1, Limit: 10, Cursor: "broken"}).
+					Return(nil, service.ErrInvalidCursor)
+			},
+			request:      &pvz_v1.GetPVZSInfoRequest{Cursor: "broken"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			resp, err := server.GetPVZSInfo(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Len(t, resp.Items, 1)
+				assert.Equal(t, tt.expectedNext, resp.NextPage)
+				assert.Equal(t, tt.expectedCursor, resp.NextCursor)
+				assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED, resp.Items[0].Receptions[0].Reception.Status)
+				assert.Equal(t, "3", resp.Items[0].Receptions[0].Products[0].Id)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestStartReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.StartReceptionRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					StartReception(gomock.Any(), "1").
+					Return(&domain.Reception{ID: "2", PvzID: "1", Status: "in_progress"}, nil)
+			},
+			request:      &pvz_v1.StartReceptionRequest{PvzId: "1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "already open",
+			mockExpect: func() {
+				mockService.EXPECT().
+					StartReception(gomock.Any(), "1").
+					Return(nil, service.ErrAlreadyOpen)
+			},
+			request:      &pvz_v1.StartReceptionRequest{PvzId: "1"},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "no pvz id",
+			mockExpect:   func() {},
+			request:      &pvz_v1.StartReceptionRequest{},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			resp, err := server.StartReception(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "2", resp.Reception.Id)
+				assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS, resp.Reception.Status)
+			}
+		})
+	}
+}
+
+func TestCloseReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.CloseReceptionRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					CloseReception(gomock.Any(), "1").
+					Return(&domain.Reception{ID: "2", PvzID: "1", Status: "closed"}, nil)
+			},
+			request:      &pvz_v1.CloseReceptionRequest{PvzId: "1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "all receptions closed",
+			mockExpect: func() {
+				mockService.EXPECT().
+					CloseReception(gomock.Any(), "1").
+					Return(nil, service.ErrAllReceptionsClosed)
+			},
+			request:      &pvz_v1.CloseReceptionRequest{PvzId: "1"},
+			expectedCode: codes.FailedPrecondition,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			_, err := server.CloseReception(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestAddProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.AddProductRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					AddProduct(gomock.Any(), "1", domain.ProductItem{Type: "электроника", Barcode: "4601234567890", OrderID: "ORD-1"}).
+					Return(&domain.Product{ID: "5", ReceptionID: "2", Type: "электроника", Barcode: "4601234567890", OrderID: "ORD-1"}, nil)
+			},
+			request:      &pvz_v1.AddProductRequest{PvzId: "1", Type: "электроника", Barcode: "4601234567890", OrderId: "ORD-1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "service error",
+			mockExpect: func() {
+				mockService.EXPECT().
+					AddProduct(gomock.Any(), "1", domain.ProductItem{Type: "электроника"}).
+					Return(nil, errors.New("db error"))
+			},
+			request:      &pvz_v1.AddProductRequest{PvzId: "1", Type: "электроника"},
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "unknown type",
+			mockExpect: func() {
+				mockService.EXPECT().
+					AddProduct(gomock.Any(), "1", domain.ProductItem{Type: "electronic"}).
+					Return(nil, &service.InvalidProductTypeError{Type: "electronic", Reason: "not in the product type catalog"})
+			},
+			request:      &pvz_v1.AddProductRequest{PvzId: "1", Type: "electronic"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "duplicate barcode",
+			mockExpect: func() {
+				mockService.EXPECT().
+					AddProduct(gomock.Any(), "1", domain.ProductItem{Type: "обувь", Barcode: "4601234567890"}).
+					Return(nil, service.ErrDuplicateBarcode)
+			},
+			request:      &pvz_v1.AddProductRequest{PvzId: "1", Type: "обувь", Barcode: "4601234567890"},
+			expectedCode: codes.AlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			resp, err := server.AddProduct(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, "5", resp.Product.Id)
+				assert.Equal(t, "4601234567890", resp.Product.Barcode)
+				assert.Equal(t, "ORD-1", resp.Product.OrderId)
+			}
+		})
+	}
+}
+
+func TestAddProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.AddProductsRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					AddProducts(gomock.Any(), "1", []domain.ProductItem{{Type: "электроника"}, {Type: "обувь", Barcode: "4601234567890"}}).
+					Return([]domain.Product{{ID: "5", Type: "электроника"}, {ID: "6", Type: "обувь"}}, nil)
+			},
+			request: &pvz_v1.AddProductsRequest{PvzId: "1", Products: []*pvz_v1.ProductItem{
+				{Type: "электроника"}, {Type: "обувь", Barcode: "4601234567890"},
+			}},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "no pvz id",
+			mockExpect:   func() {},
+			request:      &pvz_v1.AddProductsRequest{},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid batch size",
+			mockExpect: func() {
+				mockService.EXPECT().
+					AddProducts(gomock.Any(), "1", []domain.ProductItem{}).
+					Return(nil, service.ErrInvalidBatchSize)
+			},
+			request:      &pvz_v1.AddProductsRequest{PvzId: "1"},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			resp, err := server.AddProducts(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Len(t, resp.Products, 2)
+				assert.Equal(t, "5", resp.Products[0].Id)
+				assert.Equal(t, "6", resp.Products[1].Id)
+			}
+		})
+	}
+}
+
+func TestDeleteAndRestoreProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	_, err := server.DeleteProduct(context.Background(), &pvz_v1.DeleteProductRequest{PvzId: "1"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockService.EXPECT().DeleteProduct(gomock.Any(), "1", "5").Return(nil)
+	_, err = server.DeleteProduct(context.Background(), &pvz_v1.DeleteProductRequest{PvzId: "1", ProductId: "5"})
+	assert.NoError(t, err)
+
+	mockService.EXPECT().DeleteProduct(gomock.Any(), "1", "404").Return(service.ErrProductNotFound)
+	_, err = server.DeleteProduct(context.Background(), &pvz_v1.DeleteProductRequest{PvzId: "1", ProductId: "404"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockService.EXPECT().RestoreProduct(gomock.Any(), "1", "5").Return(&domain.Product{ID: "5", ReceptionID: "2"}, nil)
+	resp, err := server.RestoreProduct(context.Background(), &pvz_v1.RestoreProductRequest{PvzId: "1", ProductId: "5"})
+	require.NoError(t, err)
+	assert.Equal(t, "5", resp.Product.Id)
+
+	mockService.EXPECT().RestoreProduct(gomock.Any(), "1", "6").Return(nil, service.ErrProductNotDeleted)
+	_, err = server.RestoreProduct(context.Background(), &pvz_v1.RestoreProductRequest{PvzId: "1", ProductId: "6"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestReceptionTransitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	_, err := server.PauseReception(context.Background(), &pvz_v1.PauseReceptionRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	mockService.EXPECT().PauseReception(gomock.Any(), "2").
+		Return(&domain.Reception{ID: "2", PvzID: "1", Status: domain.ReceptionPaused}, nil)
+	pauseResp, err := server.PauseReception(context.Background(), &pvz_v1.PauseReceptionRequest{ReceptionId: "2"})
+	require.NoError(t, err)
+	assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_PAUSED, pauseResp.Reception.Status)
+
+	mockService.EXPECT().ResumeReception(gomock.Any(), "2").Return(nil, service.ErrInvalidReceptionTransition)
+	_, err = server.ResumeReception(context.Background(), &pvz_v1.ResumeReceptionRequest{ReceptionId: "2"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	mockService.EXPECT().CancelReception(gomock.Any(), "2", "брак").
+		Return(&domain.Reception{ID: "2", PvzID: "1", Status: domain.ReceptionCancelled}, nil)
+	cancelResp, err := server.CancelReception(context.Background(), &pvz_v1.CancelReceptionRequest{ReceptionId: "2", Reason: "брак"})
+	require.NoError(t, err)
+	assert.Equal(t, pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED, cancelResp.Reception.Status)
+
+	mockService.EXPECT().ReopenReception(gomock.Any(), "404", "").Return(nil, service.ErrReceptionNotFound)
+	_, err = server.ReopenReception(context.Background(), &pvz_v1.ReopenReceptionRequest{ReceptionId: "404"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockService.EXPECT().GetReceptionHistory(gomock.Any(), "2").Return([]domain.ReceptionStatusChange{
+		{ID: "1", ReceptionID: "2", ToStatus: domain.ReceptionInProgress, Action: domain.ReceptionActionStart},
+		{ID: "2", ReceptionID: "2", FromStatus: domain.ReceptionInProgress, ToStatus: domain.ReceptionCancelled,
+			Action: domain.ReceptionActionCancel, Reason: "брак"},
+	}, nil)
+	historyResp, err := server.GetReceptionHistory(context.Background(), &pvz_v1.GetReceptionHistoryRequest{ReceptionId: "2"})
+	require.NoError(t, err)
+	require.Len(t, historyResp.Items, 2)
+	assert.Equal(t, "брак", historyResp.Items[1].Reason)
+}
+
+func TestFindProductsByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.FindProductsByBarcodeRequest
+		expectedCode codes.Code
+		expectedLen  int
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().
+					FindProductsByBarcode(gomock.Any(), "4601234567890").
+					Return([]domain.Product{{ID: "5", Barcode: "4601234567890"}}, nil)
+			},
+			request:      &pvz_v1.FindProductsByBarcodeRequest{Barcode: "4601234567890"},
+			expectedCode: codes.OK,
+			expectedLen:  1,
+		},
+		{
+			name:         "no barcode",
+			mockExpect:   func() {},
+			request:      &pvz_v1.FindProductsByBarcodeRequest{},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid barcode",
+			mockExpect: func() {
+				mockService.EXPECT().
+					FindProductsByBarcode(gomock.Any(), " ").
+					Return(nil, service.ErrInvalidBarcode)
+			},
+			request:      &pvz_v1.FindProductsByBarcodeRequest{Barcode: " "},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			resp, err := server.FindProductsByBarcode(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Len(t, resp.Products, tt.expectedLen)
+			}
+		})
+	}
+}
+
+func TestDeleteLastProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.DeleteLastProductRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().DeleteLastProduct(gomock.Any(), "1").Return(nil)
+			},
+			request:      &pvz_v1.DeleteLastProductRequest{PvzId: "1"},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "reception empty",
+			mockExpect: func() {
+				mockService.EXPECT().DeleteLastProduct(gomock.Any(), "1").Return(service.ErrReceptionEmpty)
+			},
+			request:      &pvz_v1.DeleteLastProductRequest{PvzId: "1"},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name: "not assigned",
+			mockExpect: func() {
+				mockService.EXPECT().DeleteLastProduct(gomock.Any(), "2").Return(service.ErrPVZAccessDenied)
+			},
+			request:      &pvz_v1.DeleteLastProductRequest{PvzId: "2"},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			_, err := server.DeleteLastProduct(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestAssignEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		request      *pvz_v1.AssignEmployeeRequest
+		expectedCode codes.Code
+	}{
+		{
+			name: "success",
+			mockExpect: func() {
+				mockService.EXPECT().AssignEmployee(gomock.Any(), "1", "7").Return(nil)
+			},
+			request:      &pvz_v1.AssignEmployeeRequest{PvzId: "1", UserId: "7"},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "no user id",
+			mockExpect:   func() {},
+			request:      &pvz_v1.AssignEmployeeRequest{PvzId: "1"},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name: "user not found",
+			mockExpect: func() {
+				mockService.EXPECT().AssignEmployee(gomock.Any(), "1", "404").Return(service.ErrUserNotFound)
+			},
+			request:      &pvz_v1.AssignEmployeeRequest{PvzId: "1", UserId: "404"},
+			expectedCode: codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			_, err := server.AssignEmployee(context.Background(), tt.request)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestUnassignEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	mockService.EXPECT().UnassignEmployee(gomock.Any(), "1", "7").Return(nil)
+	mockService.EXPECT().UnassignEmployee(gomock.Any(), "1", "8").Return(service.ErrNotFound)
+
+	_, err := server.UnassignEmployee(context.Background(), &pvz_v1.UnassignEmployeeRequest{PvzId: "1", UserId: "7"})
+	assert.NoError(t, err)
+
+	_, err = server.UnassignEmployee(context.Background(), &pvz_v1.UnassignEmployeeRequest{PvzId: "1", UserId: "8"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*pvz_v1.PVZEvent
+}
+
+func (f *fakeWatchStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeWatchStream) Send(event *pvz_v1.PVZEvent) error {
+	f.sent = append(f.sent, event)
+	return nil
+}
+
+func TestWatchPVZ(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	t.Run("stream events until cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		events := make(chan domain.PvzEvent, 2)
+		events <- domain.PvzEvent{Sequence: 1, Type: domain.EventReceptionStarted, PvzID: "1"}
+		events <- domain.PvzEvent{Sequence: 2, Type: domain.EventProductAdded, PvzID: "1", ProductID: "3"}
+		cancel()
+		close(events)
+
+		mockService.EXPECT().WatchPVZ(gomock.Any(), []string{"1"}, uint64(0)).Return(events, nil)
+
+		stream := &fakeWatchStream{ctx: ctx}
+		err := server.WatchPVZ(&pvz_v1.WatchPVZRequest{PvzIds: []string{"1"}}, stream)
+
+		assert.NoError(t, err)
+		assert.Len(t, stream.sent, 2)
+		assert.Equal(t, pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED, stream.sent[1].Type)
+		assert.Equal(t, uint64(2), stream.sent[1].Sequence)
+	})
+
+	t.Run("slow subscriber", func(t *testing.T) {
+		events := make(chan domain.PvzEvent)
+		close(events)
+
+		mockService.EXPECT().WatchPVZ(gomock.Any(), gomock.Any(), uint64(0)).Return(events, nil)
+
+		err := server.WatchPVZ(&pvz_v1.WatchPVZRequest{}, &fakeWatchStream{ctx: context.Background()})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+	})
+
+	t.Run("sequence expired", func(t *testing.T) {
+		mockService.EXPECT().WatchPVZ(gomock.Any(), gomock.Any(), uint64(5)).Return(nil, service.ErrSequenceExpired)
+
+		err := server.WatchPVZ(&pvz_v1.WatchPVZRequest{FromSequence: 5}, &fakeWatchStream{ctx: context.Background()})
+		assert.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+}
+
+func TestGetAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+
+	from := time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)
+	mockService.EXPECT().GetAuditLog(gomock.Any(), domain.AuditQuery{UserID: "7", From: &from, Limit: service.DefaultPageLimit}).
+		Return(&domain.AuditPage{Items: []domain.AuditEntry{{ID: "1", Action: domain.AuditReceptionStarted}}, NextCursor: "next"}, nil)
+
+	resp, err := server.GetAuditLog(context.Background(), &pvz_v1.GetAuditLogRequest{UserId: "7", From: timestamppb.New(from)})
+	require.NoError(t, err)
+	require.Len(t, resp.GetItems(), 1)
+	assert.Equal(t, domain.AuditReceptionStarted, resp.GetItems()[0].GetAction())
+	assert.Equal(t, "next", resp.GetNextCursor())
+
+	_, err = server.GetAuditLog(context.Background(), &pvz_v1.GetAuditLogRequest{Limit: 100})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestProductTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockService := mock.NewMockService(ctrl)
+
+	server := NewPVZServer(mockService)
+	ctx := context.Background()
+
+	mockService.EXPECT().ListProductTypes(gomock.Any()).
+		Return([]domain.ProductType{{Name: "обувь"}, {Name: "электроника", Fragile: true}}, nil)
+	listResp, err := server.ListProductTypes(ctx, &pvz_v1.ListProductTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, listResp.ProductTypes, 2)
+	assert.True(t, listResp.ProductTypes[1].Fragile)
+
+	mockService.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "посуда", Fragile: true, MaxWeightGrams: 5000}).
+		Return(&domain.ProductType{Name: "посуда", Fragile: true, MaxWeightGrams: 5000}, nil)
+	createResp, err := server.CreateProductType(ctx, &pvz_v1.CreateProductTypeRequest{Name: "посуда", Fragile: true, MaxWeightGrams: 5000})
+	require.NoError(t, err)
+	assert.Equal(t, int32(5000), createResp.ProductType.MaxWeightGrams)
+
+	// причина ошибки типа товара доходит до клиента
+	mockService.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "посуда", MaxWeightGrams: -1}).
+		Return(nil, &service.InvalidProductTypeError{Type: "посуда", Reason: "max weight must not be negative"})
+	_, err = server.CreateProductType(ctx, &pvz_v1.CreateProductTypeRequest{Name: "посуда", MaxWeightGrams: -1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Contains(t, status.Convert(err).Message(), "max weight must not be negative")
+
+	mockService.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "обувь"}).Return(nil, service.ErrAlreadyExists)
+	_, err = server.CreateProductType(ctx, &pvz_v1.CreateProductTypeRequest{Name: "обувь"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	mockService.EXPECT().UpdateProductType(gomock.Any(), domain.ProductType{Name: "книги"}).Return(nil, service.ErrProductTypeNotFound)
+	_, err = server.UpdateProductType(ctx, &pvz_v1.UpdateProductTypeRequest{Name: "книги"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	mockService.EXPECT().DeleteProductType(gomock.Any(), "посуда").Return(nil)
+	_, err = server.DeleteProductType(ctx, &pvz_v1.DeleteProductTypeRequest{Name: "посуда"})
+	assert.NoError(t, err)
+}
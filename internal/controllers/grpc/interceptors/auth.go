@@ -0,0 +1,257 @@
+package interceptors
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/Ranik23/avito-tech-spring/internal/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type ctxKey string
+
+const (
+	userIDKey    ctxKey = "user_id"
+	roleKey      ctxKey = "role"
+	tokenIDKey   ctxKey = "jti"
+	expiresAtKey ctxKey = "exp"
+	dummyKey     ctxKey = "dummy"
+)
+
+// AccessPolicy описывает, какое право RBAC нужно для каждого gRPC метода.
+// Методы из Public доступны без токена, из Authenticated - с любым действующим токеном,
+// методы, которых нет ни в одном списке, запрещены. RejectDummyTokens отклоняет токены, выпущенные DummyLogin.
+type AccessPolicy struct {
+	Public            map[string]bool
+	Authenticated     map[string]bool
+	Permissions       map[string]rbac.Permission
+	RBAC              *rbac.Policy
+	RejectDummyTokens bool
+}
+
+// DefaultAccessPolicy требует те же права, что и соответствующие HTTP ручки в SetUpRoutes.
+func DefaultAccessPolicy(policy *rbac.Policy) AccessPolicy {
+	return AccessPolicy{
+		Public: map[string]bool{
+			pvz_v1.AuthService_DummyLogin_FullMethodName:                     true,
+			pvz_v1.AuthService_Register_FullMethodName:                       true,
+			pvz_v1.AuthService_Login_FullMethodName:                          true,
+			pvz_v1.AuthService_Refresh_FullMethodName:                        true,
+			pvz_v1.AuthService_RequestPasswordReset_FullMethodName:           true,
+			pvz_v1.AuthService_ResetPassword_FullMethodName:                  true,
+			"/grpc.reflection.v1.ServerReflection/ServerReflectionInfo":      true,
+			"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo": true,
+		},
+		Authenticated: map[string]bool{
+			pvz_v1.AuthService_Logout_FullMethodName:          true,
+			pvz_v1.AuthService_ChangePassword_FullMethodName:  true,
+			pvz_v1.PVZService_ListProductTypes_FullMethodName: true,
+		},
+		Permissions: map[string]rbac.Permission{
+			pvz_v1.PVZService_GetPVZList_FullMethodName:            rbac.PVZRead,
+			pvz_v1.PVZService_GetPVZSInfo_FullMethodName:           rbac.PVZRead,
+			pvz_v1.PVZService_CreatePVZ_FullMethodName:             rbac.PVZCreate,
+			pvz_v1.PVZService_StartReception_FullMethodName:        rbac.ReceptionOpen,
+			pvz_v1.PVZService_CloseReception_FullMethodName:        rbac.ReceptionClose,
+			pvz_v1.PVZService_PauseReception_FullMethodName:        rbac.ReceptionClose,
+			pvz_v1.PVZService_ResumeReception_FullMethodName:       rbac.ReceptionOpen,
+			pvz_v1.PVZService_CancelReception_FullMethodName:       rbac.ReceptionClose,
+			pvz_v1.PVZService_ReopenReception_FullMethodName:       rbac.ReceptionReopen,
+			pvz_v1.PVZService_GetReceptionHistory_FullMethodName:   rbac.PVZRead,
+			pvz_v1.PVZService_AddProduct_FullMethodName:            rbac.ProductAdd,
+			pvz_v1.PVZService_AddProducts_FullMethodName:           rbac.ProductAdd,
+			pvz_v1.PVZService_DeleteLastProduct_FullMethodName:     rbac.ProductDelete,
+			pvz_v1.PVZService_DeleteProduct_FullMethodName:         rbac.ProductDelete,
+			pvz_v1.PVZService_RestoreProduct_FullMethodName:        rbac.ProductDelete,
+			pvz_v1.PVZService_FindProductsByBarcode_FullMethodName: rbac.PVZRead,
+			pvz_v1.PVZService_WatchPVZ_FullMethodName:              rbac.PVZRead,
+			pvz_v1.PVZService_AssignEmployee_FullMethodName:        rbac.PVZAssign,
+			pvz_v1.PVZService_UnassignEmployee_FullMethodName:      rbac.PVZAssign,
+			pvz_v1.PVZService_GetAuditLog_FullMethodName:           rbac.AuditRead,
+			pvz_v1.AuthService_ListUsers_FullMethodName:            rbac.UserManage,
+			pvz_v1.AuthService_GetUser_FullMethodName:              rbac.UserManage,
+			pvz_v1.AuthService_UpdateUserRole_FullMethodName:       rbac.UserManage,
+			pvz_v1.AuthService_DeactivateUser_FullMethodName:       rbac.UserManage,
+			pvz_v1.AuthService_ActivateUser_FullMethodName:         rbac.UserManage,
+			pvz_v1.AuthService_DeleteUser_FullMethodName:           rbac.UserManage,
+			pvz_v1.PVZService_CreateProductType_FullMethodName:     rbac.ProductTypeManage,
+			pvz_v1.PVZService_UpdateProductType_FullMethodName:     rbac.ProductTypeManage,
+			pvz_v1.PVZService_DeleteProductType_FullMethodName:     rbac.ProductTypeManage,
+		},
+		RBAC: policy,
+	}
+}
+
+// Strict возвращает политику для боевого окружения: DummyLogin закрыт, dummy токены не принимаются.
+func (p AccessPolicy) Strict() AccessPolicy {
+	public := make(map[string]bool, len(p.Public))
+	for method, ok := range p.Public {
+		if method != pvz_v1.AuthService_DummyLogin_FullMethodName {
+			public[method] = ok
+		}
+	}
+
+	p.Public = public
+	p.RejectDummyTokens = true
+	return p
+}
+
+func AuthUnaryInterceptor(tokenService token.Token, revocations token.RevocationList, users token.UserStatus, policy AccessPolicy, logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		authCtx, err := authorize(ctx, info.FullMethod, tokenService, revocations, users, policy)
+		if err != nil {
+			logger.Warn("gRPC call rejected", slog.String("method", info.FullMethod), slog.Any("error", err))
+			return nil, err
+		}
+		return handler(authCtx, req)
+	}
+}
+
+func AuthStreamInterceptor(tokenService token.Token, revocations token.RevocationList, users token.UserStatus, policy AccessPolicy, logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		authCtx, err := authorize(ss.Context(), info.FullMethod, tokenService, revocations, users, policy)
+		if err != nil {
+			logger.Warn("gRPC stream rejected", slog.String("method", info.FullMethod), slog.Any("error", err))
+			return err
+		}
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: authCtx})
+	}
+}
+
+// UserIDFromContext возвращает user_id, положенный в контекст интерсептором авторизации.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	userID, ok := ctx.Value(userIDKey).(string)
+	return userID, ok
+}
+
+// DummyTokenFromContext сообщает, пришел ли запрос с токеном DummyLogin.
+func DummyTokenFromContext(ctx context.Context) bool {
+	dummy, _ := ctx.Value(dummyKey).(bool)
+	return dummy
+}
+
+// RoleFromContext возвращает роль, положенную в контекст интерсептором авторизации.
+func RoleFromContext(ctx context.Context) (string, bool) {
+	role, ok := ctx.Value(roleKey).(string)
+	return role, ok
+}
+
+// TokenIDFromContext возвращает jti токена, с которым пришел запрос.
+func TokenIDFromContext(ctx context.Context) (string, bool) {
+	jti, ok := ctx.Value(tokenIDKey).(string)
+	return jti, ok
+}
+
+// TokenExpiresAtFromContext возвращает exp токена, с которым пришел запрос.
+func TokenExpiresAtFromContext(ctx context.Context) (time.Time, bool) {
+	expiresAt, ok := ctx.Value(expiresAtKey).(time.Time)
+	return expiresAt, ok
+}
+
+func authorize(ctx context.Context, method string, tokenService token.Token, revocations token.RevocationList, users token.UserStatus, policy AccessPolicy) (context.Context, error) {
+	if policy.Public[method] {
+		return ctx, nil
+	}
+
+	permission, needsPermission := policy.Permissions[method]
+	if !needsPermission && !policy.Authenticated[method] {
+		return nil, status.Error(codes.PermissionDenied, "method is not allowed")
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 || values[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "no token provided")
+	}
+
+	claims, err := tokenService.Parse(strings.TrimPrefix(values[0], "Bearer "))
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	jti, ok := token.TokenID(claims)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no jti provided")
+	}
+
+	dummy := token.IsDummy(claims)
+	if dummy && policy.RejectDummyTokens {
+		return nil, status.Error(codes.Unauthenticated, "dummy tokens are not accepted")
+	}
+
+	role, ok := claims["role"].(string)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "no role provided")
+	}
+	role = strings.ToLower(role)
+
+	// роль, удаленная из конфига, не проходит даже для методов без права
+	if !policy.RBAC.HasRole(role) || (needsPermission && !policy.RBAC.Allowed(role, permission)) {
+		return nil, status.Errorf(codes.PermissionDenied, "%s has no access", role)
+	}
+
+	// в список отозванных ходим последними, чтобы не нагружать БД заведомо отклоненными запросами
+	revoked, err := revocations.IsAccessTokenRevoked(ctx, jti)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to check token revocation")
+	}
+	if revoked {
+		return nil, status.Error(codes.Unauthenticated, "token has been revoked")
+	}
+
+	userID, hasUserID := claims["user_id"].(string)
+
+	// dummy токены не привязаны к учетной записи, отключать у них нечего
+	if !dummy {
+		if !hasUserID {
+			return nil, status.Error(codes.Unauthenticated, "no user_id provided")
+		}
+
+		disabled, err := users.IsUserDisabled(ctx, userID)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "failed to check user status")
+		}
+		if disabled {
+			return nil, status.Error(codes.Unauthenticated, "user is disabled")
+		}
+	}
+
+	ctx = context.WithValue(ctx, roleKey, role)
+	ctx = context.WithValue(ctx, tokenIDKey, jti)
+	ctx = context.WithValue(ctx, dummyKey, dummy)
+	if expiresAt, ok := token.ExpiresAt(claims); ok {
+		ctx = context.WithValue(ctx, expiresAtKey, expiresAt)
+	}
+	if hasUserID {
+		ctx = context.WithValue(ctx, userIDKey, userID)
+	}
+	ctx = rbac.WithActor(ctx, rbac.Actor{UserID: userID, Role: role, Dummy: dummy})
+
+	return ctx, nil
+}
+
+type wrappedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (w *wrappedStream) Context() context.Context {
+	return w.ctx
+}
@@ -0,0 +1,429 @@
+//go:build unit
+
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/Ranik23/avito-tech-spring/internal/token/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthUnaryInterceptor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	interceptor := AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, DefaultAccessPolicy(rbac.DefaultPolicy()), slog.Default())
+
+	exp := float64(time.Now().Add(time.Hour).Unix())
+
+	tests := []struct {
+		name         string
+		method       string
+		authHeader   string
+		mockExpect   func()
+		expectedCode codes.Code
+	}{
+		{
+			name:         "public method without token",
+			method:       pvz_v1.AuthService_Login_FullMethodName,
+			mockExpect:   func() {},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "no token",
+			method:       pvz_v1.PVZService_CreatePVZ_FullMethodName,
+			mockExpect:   func() {},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "invalid token",
+			method:     pvz_v1.PVZService_CreatePVZ_FullMethodName,
+			authHeader: "Bearer bad",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("bad").Return(nil, errors.New("invalid token"))
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "wrong role",
+			method:     pvz_v1.PVZService_CreatePVZ_FullMethodName,
+			authHeader: "Bearer good",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-1"}, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "no jti",
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer good",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "1", "role": "employee"}, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "revoked token",
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer good",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-1"}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(true, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "revocation check fails",
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer good",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-1"}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, errors.New("db error"))
+			},
+			expectedCode: codes.Internal,
+		},
+		{
+			name:         "refresh is public",
+			method:       pvz_v1.AuthService_Refresh_FullMethodName,
+			mockExpect:   func() {},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "allowed role",
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer good",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "1", "role": "Employee", "jti": "jti-1", "exp": exp}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "unknown method",
+			method:       "/pvz.v1.PVZService/Unknown",
+			mockExpect:   func() {},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			ctx := context.Background()
+			if tt.authHeader != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authHeader))
+			}
+
+			var handlerCtx context.Context
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCtx = ctx
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedCode == codes.OK && tt.authHeader != "" {
+				userID, _ := UserIDFromContext(handlerCtx)
+				role, _ := RoleFromContext(handlerCtx)
+				assert.Equal(t, "1", userID)
+				assert.Equal(t, "employee", role)
+
+				jti, _ := TokenIDFromContext(handlerCtx)
+				expiresAt, _ := TokenExpiresAtFromContext(handlerCtx)
+				assert.Equal(t, "jti-1", jti)
+				assert.Equal(t, int64(exp), expiresAt.Unix())
+			}
+		})
+	}
+}
+
+func TestAuthUnaryInterceptor_DummyTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
+	dummyClaims := map[string]interface{}{"user_id": "dummyRandomID", "role": "employee", "jti": "jti-1", "dummy": true}
+	realClaims := map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-2"}
+
+	tests := []struct {
+		name          string
+		policy        AccessPolicy
+		method        string
+		authHeader    string
+		mockExpect    func()
+		expectedCode  codes.Code
+		expectedDummy bool
+	}{
+		{
+			name:         "dummy login is public in development",
+			policy:       DefaultAccessPolicy(rbac.DefaultPolicy()),
+			method:       pvz_v1.AuthService_DummyLogin_FullMethodName,
+			mockExpect:   func() {},
+			expectedCode: codes.OK,
+		},
+		{
+			name:       "dummy token accepted in development",
+			policy:     DefaultAccessPolicy(rbac.DefaultPolicy()),
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer dummy",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("dummy").Return(dummyClaims, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+			},
+			expectedCode:  codes.OK,
+			expectedDummy: true,
+		},
+		{
+			name:         "dummy login is closed in strict mode",
+			policy:       DefaultAccessPolicy(rbac.DefaultPolicy()).Strict(),
+			method:       pvz_v1.AuthService_DummyLogin_FullMethodName,
+			mockExpect:   func() {},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:       "dummy token rejected in strict mode",
+			policy:     DefaultAccessPolicy(rbac.DefaultPolicy()).Strict(),
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer dummy",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("dummy").Return(dummyClaims, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:       "real token accepted in strict mode",
+			policy:     DefaultAccessPolicy(rbac.DefaultPolicy()).Strict(),
+			method:     pvz_v1.PVZService_AddProduct_FullMethodName,
+			authHeader: "Bearer real",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("real").Return(realClaims, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-2").Return(false, nil)
+			},
+			expectedCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			interceptor := AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, tt.policy, slog.Default())
+
+			ctx := context.Background()
+			if tt.authHeader != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authHeader))
+			}
+
+			var handlerCtx context.Context
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handlerCtx = ctx
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, tt.expectedDummy, DummyTokenFromContext(handlerCtx))
+			}
+		})
+	}
+}
+
+func TestAccessPolicy_StrictKeepsDefault(t *testing.T) {
+	policy := DefaultAccessPolicy(rbac.DefaultPolicy())
+	_ = policy.Strict()
+
+	assert.True(t, policy.Public[pvz_v1.AuthService_DummyLogin_FullMethodName])
+	assert.False(t, policy.RejectDummyTokens)
+}
+
+func TestAuthUnaryInterceptor_ConfiguredRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
+	rbacPolicy, err := rbac.NewPolicy(map[string][]string{
+		"admin":   {"*"},
+		"auditor": {"pvz:read"},
+	}, nil)
+	assert.NoError(t, err)
+	interceptor := AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, DefaultAccessPolicy(rbacPolicy), slog.Default())
+
+	tests := []struct {
+		name         string
+		role         string
+		method       string
+		revocation   bool
+		expectedCode codes.Code
+	}{
+		{name: "admin creates pvz", role: "admin", method: pvz_v1.PVZService_CreatePVZ_FullMethodName, revocation: true, expectedCode: codes.OK},
+		{name: "auditor reads pvz", role: "auditor", method: pvz_v1.PVZService_GetPVZList_FullMethodName, revocation: true, expectedCode: codes.OK},
+		{name: "auditor cannot add products", role: "auditor", method: pvz_v1.PVZService_AddProduct_FullMethodName, expectedCode: codes.PermissionDenied},
+		{name: "auditor can logout", role: "auditor", method: pvz_v1.AuthService_Logout_FullMethodName, revocation: true, expectedCode: codes.OK},
+		{name: "removed role cannot logout", role: "employee", method: pvz_v1.AuthService_Logout_FullMethodName, expectedCode: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "1", "role": tt.role, "jti": "jti-1"}, nil)
+			if tt.revocation {
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+			}
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestAuthUnaryInterceptor_SetsActor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	interceptor := AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, DefaultAccessPolicy(rbac.DefaultPolicy()), slog.Default())
+
+	mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "7", "role": "Employee", "jti": "jti-1"}, nil)
+	mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		actor, ok := rbac.ActorFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, rbac.Actor{UserID: "7", Role: "employee"}, actor)
+		return "ok", nil
+	}
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_StartReception_FullMethodName}, handler)
+	assert.NoError(t, err)
+}
+
+func TestAuthUnaryInterceptor_AssignRequiresPermission(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	interceptor := AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, DefaultAccessPolicy(rbac.DefaultPolicy()), slog.Default())
+
+	mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "7", "role": "employee", "jti": "jti-1"}, nil)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_AssignEmployee_FullMethodName}, handler)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestAuthUnaryInterceptor_DisabledUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	interceptor := AuthUnaryInterceptor(mockToken, mockRevocations, mockUsers, DefaultAccessPolicy(rbac.DefaultPolicy()), slog.Default())
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer good"))
+	info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.AuthService_ListUsers_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+
+	tests := []struct {
+		name         string
+		mockExpect   func()
+		expectedCode codes.Code
+	}{
+		{
+			name: "active moderator",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "7", "role": "moderator", "jti": "jti-1"}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+				mockUsers.EXPECT().IsUserDisabled(gomock.Any(), "7").Return(false, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "disabled moderator",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "7", "role": "moderator", "jti": "jti-1"}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+				mockUsers.EXPECT().IsUserDisabled(gomock.Any(), "7").Return(true, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name: "status check fails",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "7", "role": "moderator", "jti": "jti-1"}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+				mockUsers.EXPECT().IsUserDisabled(gomock.Any(), "7").Return(false, assert.AnError)
+			},
+			expectedCode: codes.Internal,
+		},
+		{
+			name: "dummy token is not checked",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "dummyRandomID", "role": "moderator", "jti": "jti-2", "dummy": true}, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-2").Return(false, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name: "employee has no user:manage",
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("good").Return(map[string]interface{}{"user_id": "8", "role": "employee", "jti": "jti-3"}, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			_, err := interceptor(ctx, nil, info, handler)
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
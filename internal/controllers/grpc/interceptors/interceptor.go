@@ -3,15 +3,24 @@ package interceptors
 import (
 	"context"
 	"log/slog"
+	"strings"
 	"time"
 
-	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
-	"github.com/Ranik23/avito-tech-spring/internal/metrics"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 )
 
+// sensitiveMetadata - ключи метаданных, значения которых нельзя писать в логи.
+var sensitiveMetadata = map[string]bool{
+	"authorization":             true,
+	"grpcgateway-authorization": true,
+	"cookie":                    true,
+	"grpcgateway-cookie":        true,
+	"x-api-key":                 true,
+}
+
+const redacted = "[REDACTED]"
 
 func LoggingUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 	return func(
@@ -20,34 +29,67 @@ func LoggingUnaryInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		defer metrics.RequestsTotal.Inc()
 		startTime := time.Now()
 
-		md, _ := metadata.FromIncomingContext(ctx)
-
 		resp, err := handler(ctx, req)
 
-		attrs := []slog.Attr{
-			slog.String("method", info.FullMethod),
-			slog.Duration("duration", time.Since(startTime)),
-			slog.Any("headers", md),
-		}
+		logCall(ctx, logger, info.FullMethod, startTime, err)
 
-		st, _ := status.FromError(err)
-		attrs = append(attrs,
-			slog.String("status_code", st.Code().String()),
-			slog.String("status", st.Code().String()),
-		)
-		if err != nil {
-			attrs = append(attrs, slog.Any("error", err))
-			logger.LogAttrs(ctx, slog.LevelError, "gRPC call failed", attrs...)
-			return nil, err // нельзя привести же ошибку к dto, есть еще вариант сделать это на уровне выше, то есть на самом HTTP
-			// сервере, но там нет интерсепторов 
-		}
+		return resp, err
+	}
+}
 
-		logger.LogAttrs(ctx, slog.LevelInfo, "gRPC call completed", attrs...)
+func LoggingStreamInterceptor(logger *slog.Logger) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		startTime := time.Now()
+
+		err := handler(srv, ss)
+
+		logCall(ss.Context(), logger, info.FullMethod, startTime, err)
+
+		return err
+	}
+}
 
-		return resp.(*pvz_v1.GetPVZListResponse), nil 
-		
+func logCall(ctx context.Context, logger *slog.Logger, method string, startTime time.Time, err error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	st, _ := status.FromError(err)
+
+	attrs := []slog.Attr{
+		slog.String("method", method),
+		slog.Duration("duration", time.Since(startTime)),
+		slog.Any("headers", redactMetadata(md)),
+		slog.String("status_code", st.Code().String()),
+	}
+
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		attrs = append(attrs, slog.String("request_id", requestID))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.Any("error", err))
+		logger.LogAttrs(ctx, slog.LevelError, "gRPC call failed", attrs...)
+		return
+	}
+
+	logger.LogAttrs(ctx, slog.LevelInfo, "gRPC call completed", attrs...)
+}
+
+// redactMetadata возвращает копию метаданных, в которой значения чувствительных ключей заменены.
+func redactMetadata(md metadata.MD) metadata.MD {
+	result := make(metadata.MD, len(md))
+	for key, values := range md {
+		if sensitiveMetadata[strings.ToLower(key)] {
+			result[key] = []string{redacted}
+			continue
+		}
+		result[key] = values
 	}
-}
\ No newline at end of file
+	return result
+}
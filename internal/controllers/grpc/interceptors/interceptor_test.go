@@ -0,0 +1,125 @@
+//go:build unit
+
+package interceptors
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/Ranik23/avito-tech-spring/api/proto/gen/pvz_v1"
+	"github.com/Ranik23/avito-tech-spring/internal/metrics"
+	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLoggingUnaryInterceptor_AnyResponseType(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	interceptor := LoggingUnaryInterceptor(logger)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"authorization", "Bearer secret-token",
+		"user-agent", "grpcurl",
+	))
+
+	resp, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_CreatePVZ_FullMethodName},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return &pvz_v1.CreatePVZResponse{}, nil
+		})
+
+	assert.NoError(t, err)
+	assert.IsType(t, &pvz_v1.CreatePVZResponse{}, resp)
+	assert.NotContains(t, buf.String(), "secret-token")
+	assert.Contains(t, buf.String(), redacted)
+	assert.Contains(t, buf.String(), "grpcurl")
+}
+
+func TestLoggingUnaryInterceptor_Error(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	interceptor := LoggingUnaryInterceptor(logger)
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_AddProduct_FullMethodName},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.FailedPrecondition, "all receptions closed")
+		})
+
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, buf.String(), "FailedPrecondition")
+}
+
+func TestRequestIDUnaryInterceptor(t *testing.T) {
+	interceptor := RequestIDUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_GetPVZList_FullMethodName}
+
+	var got string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = RequestIDFromContext(ctx)
+		return nil, nil
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, "req-1"))
+	_, err := interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "req-1", got)
+
+	_, err = interceptor(context.Background(), nil, info, handler)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, got)
+	assert.NotEqual(t, "req-1", got)
+}
+
+func TestRequestIDUnaryInterceptor_InvalidClientID(t *testing.T) {
+	interceptor := RequestIDUnaryInterceptor()
+	info := &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_GetPVZList_FullMethodName}
+
+	var got string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got, _ = RequestIDFromContext(ctx)
+		return nil, nil
+	}
+
+	for _, requestID := range []string{
+		strings.Repeat("a", maxRequestIDLength+1),
+		"req 1",
+		"req-1\nlevel=ERROR",
+		"запрос",
+	} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, requestID))
+		_, err := interceptor(ctx, nil, info, handler)
+		assert.NoError(t, err)
+		assert.NotEqual(t, requestID, got)
+		assert.NoError(t, uuid.Validate(got))
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDHeader, strings.Repeat("a", maxRequestIDLength)))
+	_, err := interceptor(ctx, nil, info, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, strings.Repeat("a", maxRequestIDLength), got)
+}
+
+func TestMetricsUnaryInterceptor(t *testing.T) {
+	interceptor := MetricsUnaryInterceptor()
+
+	counter := metrics.GRPCRequestsTotal.WithLabelValues(pvz_v1.PVZService_GetPVZList_FullMethodName, codes.Unknown.String())
+	before := testutil.ToFloat64(counter)
+
+	_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: pvz_v1.PVZService_GetPVZList_FullMethodName},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, errors.New("boom")
+		})
+
+	assert.Error(t, err)
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
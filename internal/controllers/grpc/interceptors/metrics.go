@@ -0,0 +1,52 @@
+package interceptors
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func MetricsUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		startTime := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		observe(info.FullMethod, startTime, err)
+
+		return resp, err
+	}
+}
+
+func MetricsStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		startTime := time.Now()
+
+		err := handler(srv, ss)
+
+		observe(info.FullMethod, startTime, err)
+
+		return err
+	}
+}
+
+func observe(method string, startTime time.Time, err error) {
+	code := status.Code(err).String()
+
+	metrics.RequestsTotal.Inc()
+	metrics.GRPCRequestDuration.WithLabelValues(method).Observe(time.Since(startTime).Seconds())
+	metrics.GRPCRequestsTotal.WithLabelValues(method, code).Inc()
+}
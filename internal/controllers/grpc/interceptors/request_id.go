@@ -0,0 +1,81 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	requestIDHeader        = "x-request-id"
+	requestIDKey    ctxKey = "request_id"
+
+	maxRequestIDLength = 128
+)
+
+// RequestIDUnaryInterceptor берет x-request-id из метаданных или генерирует новый, если клиент
+// его не передал или передал слишком длинный либо с символами вне [A-Za-z0-9._-],
+// кладет его в контекст и возвращает клиенту в заголовках ответа.
+func RequestIDUnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		ctx = withRequestID(ctx)
+		return handler(ctx, req)
+	}
+}
+
+func RequestIDStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		return handler(srv, &wrappedStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+// RequestIDFromContext возвращает идентификатор запроса, выставленный интерсептором.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	requestID, ok := ctx.Value(requestIDKey).(string)
+	return requestID, ok
+}
+
+func withRequestID(ctx context.Context) context.Context {
+	var requestID string
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	if values := md.Get(requestIDHeader); len(values) > 0 && isValidRequestID(values[0]) {
+		requestID = values[0]
+	} else {
+		requestID = uuid.NewString()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDHeader, requestID))
+
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+// isValidRequestID пропускает только короткие идентификаторы из [A-Za-z0-9._-]: значение попадает
+// в логи и заголовки ответа, и клиент не должен протаскивать туда произвольные данные.
+func isValidRequestID(requestID string) bool {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		return false
+	}
+
+	for _, c := range requestID {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '.', c == '_', c == '-':
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -2,8 +2,12 @@ package http
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
+	"math"
+	"strconv"
 
+	converter "github.com/Ranik23/avito-tech-spring/internal/models/converter/http"
 	"github.com/Ranik23/avito-tech-spring/internal/models/dto"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"github.com/gin-gonic/gin"
@@ -13,6 +17,18 @@ type AuthController interface {
 	Login(c *gin.Context)
 	Register(c *gin.Context)
 	DummyLogin(c *gin.Context)
+	Refresh(c *gin.Context)
+	Logout(c *gin.Context)
+	ChangePassword(c *gin.Context)
+	RequestPasswordReset(c *gin.Context)
+	ResetPassword(c *gin.Context)
+
+	ListUsers(c *gin.Context)
+	GetUser(c *gin.Context)
+	UpdateUserRole(c *gin.Context)
+	DeactivateUser(c *gin.Context)
+	ActivateUser(c *gin.Context)
+	DeleteUser(c *gin.Context)
 }
 
 type authController struct {
@@ -43,13 +59,19 @@ func (a *authController) DummyLogin(c *gin.Context) {
 	token, err := a.service.DummyLogin(c, req.Role)
 	if err != nil {
 		a.logger.Error("DummyLogin service error", slog.String("error", err.Error()))
+		if errors.Is(err, service.ErrDummyLoginDisabled) {
+			c.JSON(403, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
 		c.JSON(500, dto.Error{
 			Message: err.Error(),
 		})
 		return
 	}
 
-	a.logger.Info("DummyLogin successful", slog.String("token", token))
+	a.logger.Info("DummyLogin successful", slog.String("role", req.Role))
 	c.JSON(200, dto.DummyLoginResp{
 		Token: token,
 	})
@@ -68,8 +90,18 @@ func (a *authController) Login(c *gin.Context) {
 
 	a.logger.Info("Login request received", slog.String("email", req.Email))
 
-	token, err := a.service.Login(c, req.Email, req.Password)
+	pair, err := a.service.Login(c, req.Email, req.Password, c.ClientIP())
 	if err != nil {
+		var locked *service.LoginLockedError
+		if errors.As(err, &locked) {
+			a.logger.Warn("Login locked", slog.String("email", req.Email))
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(locked.RetryAfter.Seconds()))))
+			c.JSON(429, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
 		if errors.Is(err, service.ErrInvalidCredentials) {
 			a.logger.Warn("Invalid credentials", slog.String("email", req.Email))
 			c.JSON(401, dto.Error{
@@ -78,6 +110,14 @@ func (a *authController) Login(c *gin.Context) {
 			return
 		}
 
+		if errors.Is(err, service.ErrUserDisabled) {
+			a.logger.Warn("Disabled user login", slog.String("email", req.Email))
+			c.JSON(403, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
 		a.logger.Error("Login service error", slog.String("error", err.Error()))
 		c.JSON(500, dto.Error{
 			Message: err.Error(),
@@ -85,9 +125,96 @@ func (a *authController) Login(c *gin.Context) {
 		return
 	}
 
-	a.logger.Info("Login successful", slog.String("email", req.Email), slog.String("token", token))
+	a.logger.Info("Login successful", slog.String("email", req.Email))
 	c.JSON(200, dto.LoginResp{
-		Token: token,
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	})
+}
+
+func (a *authController) Refresh(c *gin.Context) {
+	var req dto.RefreshReq
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.logger.Error("Refresh failed", slog.String("error", err.Error()))
+		c.JSON(400, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if req.RefreshToken == "" {
+		c.JSON(400, dto.Error{
+			Message: "refreshToken is required",
+		})
+		return
+	}
+
+	pair, err := a.service.Refresh(c, req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			a.logger.Warn("Invalid refresh token")
+			c.JSON(401, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
+		if errors.Is(err, service.ErrUserDisabled) {
+			a.logger.Warn("Refresh for disabled user")
+			c.JSON(403, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
+		a.logger.Error("Refresh service error", slog.String("error", err.Error()))
+		c.JSON(500, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, dto.RefreshResp{
+		Token:        pair.AccessToken,
+		RefreshToken: pair.RefreshToken,
+	})
+}
+
+// Logout вызывается за JwtAuth, поэтому jti и срок действия текущего токена уже лежат в контексте.
+func (a *authController) Logout(c *gin.Context) {
+	var req dto.LogoutReq
+
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			a.logger.Error("Logout failed", slog.String("error", err.Error()))
+			c.JSON(400, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+	}
+
+	err := a.service.Logout(c, c.GetString("user_id"), c.GetString("jti"), c.GetTime("token_expires_at"), req.RefreshToken)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRefreshToken) {
+			a.logger.Warn("Invalid refresh token on logout", slog.String("userID", c.GetString("user_id")))
+			c.JSON(401, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
+		a.logger.Error("Logout service error", slog.String("error", err.Error()))
+		c.JSON(500, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	a.logger.Info("Logout successful", slog.String("userID", c.GetString("user_id")))
+	c.JSON(200, gin.H{
+		"Description": "Токены отозваны",
 	})
 }
 
@@ -107,6 +234,12 @@ func (a *authController) Register(c *gin.Context) {
 	userID, err := a.service.Register(c, req.Email, req.Password, req.Role)
 	if err != nil {
 		a.logger.Error("Register service error", slog.String("error", err.Error()))
+		if errors.Is(err, service.ErrInvalidRole) || errors.Is(err, service.ErrInvalidEmail) || errors.Is(err, service.ErrWeakPassword) {
+			c.JSON(400, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
 		c.JSON(500, dto.Error{
 			Message: err.Error(),
 		})
@@ -120,3 +253,242 @@ func (a *authController) Register(c *gin.Context) {
 		Role:  req.Role,
 	})
 }
+
+// ChangePassword вызывается за JwtAuth. У dummy токена нет учетной записи, сменить ему пароль нельзя.
+func (a *authController) ChangePassword(c *gin.Context) {
+	if c.GetBool("dummy") {
+		c.JSON(403, dto.Error{
+			Message: "dummy token has no account",
+		})
+		return
+	}
+
+	var req dto.ChangePasswordReq
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.logger.Error("ChangePassword failed", slog.String("error", err.Error()))
+		c.JSON(400, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	userID := c.GetString("user_id")
+
+	err := a.service.ChangePassword(c, userID, req.OldPassword, req.NewPassword)
+	if err != nil {
+		a.logger.Warn("ChangePassword service error", slog.String("userID", userID), slog.String("error", err.Error()))
+		switch {
+		case errors.Is(err, service.ErrWeakPassword):
+			c.JSON(400, dto.Error{
+				Message: err.Error(),
+			})
+		case errors.Is(err, service.ErrInvalidCredentials):
+			c.JSON(401, dto.Error{
+				Message: err.Error(),
+			})
+		case errors.Is(err, service.ErrUserNotFound):
+			c.JSON(404, dto.Error{
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(500, dto.Error{
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	a.logger.Info("Password changed", slog.String("userID", userID))
+	c.JSON(200, gin.H{
+		"Description": "Пароль изменен",
+	})
+}
+
+// RequestPasswordReset отвечает одинаково для известного и неизвестного email.
+func (a *authController) RequestPasswordReset(c *gin.Context) {
+	var req dto.RequestPasswordResetReq
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.logger.Error("RequestPasswordReset failed", slog.String("error", err.Error()))
+		c.JSON(400, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := a.service.RequestPasswordReset(c, req.Email)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidEmail) {
+			c.JSON(400, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
+		a.logger.Error("RequestPasswordReset service error", slog.String("error", err.Error()))
+		c.JSON(500, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(202, gin.H{
+		"Description": "Если email зарегистрирован, на него отправлена инструкция по сбросу пароля",
+	})
+}
+
+func (a *authController) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordReq
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.logger.Error("ResetPassword failed", slog.String("error", err.Error()))
+		c.JSON(400, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	err := a.service.ResetPassword(c, req.Token, req.NewPassword)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidResetToken) || errors.Is(err, service.ErrWeakPassword) {
+			a.logger.Warn("ResetPassword rejected", slog.String("error", err.Error()))
+			c.JSON(400, dto.Error{
+				Message: err.Error(),
+			})
+			return
+		}
+
+		a.logger.Error("ResetPassword service error", slog.String("error", err.Error()))
+		c.JSON(500, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	c.JSON(200, gin.H{
+		"Description": "Пароль изменен",
+	})
+}
+
+func (a *authController) ListUsers(c *gin.Context) {
+	limit := service.DefaultPageLimit
+	if value := c.Query("limit"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 || n > service.MaxPageLimit {
+			c.JSON(400, dto.Error{
+				Message: fmt.Sprintf("limit must be between 1 and %d", service.MaxPageLimit),
+			})
+			return
+		}
+		limit = n
+	}
+
+	page, err := a.service.ListUsers(withActor(c), c.Query("cursor"), limit)
+	if err != nil {
+		a.logger.Warn("ListUsers service error", slog.String("error", err.Error()))
+		a.userError(c, err)
+		return
+	}
+
+	c.JSON(200, converter.FromDomainUserPageToDtoListUsersResp(page))
+}
+
+func (a *authController) GetUser(c *gin.Context) {
+	user, err := a.service.GetUser(withActor(c), c.Param("userId"))
+	if err != nil {
+		a.logger.Warn("GetUser service error", slog.String("error", err.Error()))
+		a.userError(c, err)
+		return
+	}
+
+	c.JSON(200, converter.FromDomainUserToDtoUser(user))
+}
+
+func (a *authController) UpdateUserRole(c *gin.Context) {
+	var req dto.UpdateUserRoleReq
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		a.logger.Error("UpdateUserRole failed", slog.String("error", err.Error()))
+		c.JSON(400, dto.Error{
+			Message: err.Error(),
+		})
+		return
+	}
+
+	if req.Role == "" {
+		c.JSON(400, dto.Error{
+			Message: "role is required",
+		})
+		return
+	}
+
+	user, err := a.service.UpdateUserRole(withActor(c), c.Param("userId"), req.Role)
+	if err != nil {
+		a.logger.Warn("UpdateUserRole service error", slog.String("error", err.Error()))
+		a.userError(c, err)
+		return
+	}
+
+	c.JSON(200, converter.FromDomainUserToDtoUser(user))
+}
+
+func (a *authController) DeactivateUser(c *gin.Context) {
+	user, err := a.service.DeactivateUser(withActor(c), c.Param("userId"))
+	if err != nil {
+		a.logger.Warn("DeactivateUser service error", slog.String("error", err.Error()))
+		a.userError(c, err)
+		return
+	}
+
+	c.JSON(200, converter.FromDomainUserToDtoUser(user))
+}
+
+func (a *authController) ActivateUser(c *gin.Context) {
+	user, err := a.service.ActivateUser(withActor(c), c.Param("userId"))
+	if err != nil {
+		a.logger.Warn("ActivateUser service error", slog.String("error", err.Error()))
+		a.userError(c, err)
+		return
+	}
+
+	c.JSON(200, converter.FromDomainUserToDtoUser(user))
+}
+
+func (a *authController) DeleteUser(c *gin.Context) {
+	userID := c.Param("userId")
+
+	if err := a.service.DeleteUser(withActor(c), userID); err != nil {
+		a.logger.Warn("DeleteUser service error", slog.String("error", err.Error()))
+		a.userError(c, err)
+		return
+	}
+
+	a.logger.Info("User deleted", slog.String("userID", userID))
+	c.JSON(200, gin.H{
+		"Description": "Пользователь удален",
+	})
+}
+
+// userError переводит ошибки управления пользователями в HTTP статусы.
+func (a *authController) userError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidRole), errors.Is(err, service.ErrSelfManagement),
+		errors.Is(err, service.ErrInvalidPagination), errors.Is(err, service.ErrInvalidCursor):
+		c.JSON(400, dto.Error{
+			Message: err.Error(),
+		})
+	case errors.Is(err, service.ErrInsufficientPermissions):
+		c.JSON(403, dto.Error{
+			Message: err.Error(),
+		})
+	case errors.Is(err, service.ErrUserNotFound):
+		c.JSON(404, dto.Error{
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(500, dto.Error{
+			Message: err.Error(),
+		})
+	}
+}
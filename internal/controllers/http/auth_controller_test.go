@@ -5,11 +5,14 @@ package http
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"github.com/Ranik23/avito-tech-spring/internal/service/mock"
 	"github.com/gin-gonic/gin"
@@ -62,6 +65,16 @@ func TestDummyLogin(t *testing.T) {
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
+		{
+			name:        "Disabled",
+			requestBody: `{"role":"employee"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					DummyLogin(gomock.Any(), "employee").
+					Return("", service.ErrDummyLoginDisabled)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
 	}
 
 	for _, tt := range tests {
@@ -102,14 +115,15 @@ func TestLogin(t *testing.T) {
 		mockExpect     func()
 		expectedStatus int
 		expectedToken  string
+		expectedRetryAfter string
 	}{
 		{
 			name:        "Success",
 			requestBody: `{"email":"test@example.com", "password":"1234"}`,
 			mockExpect: func() {
 				mockAuthService.EXPECT().
-					Login(gomock.Any(), "test@example.com", "1234").
-					Return("jwt-token", nil)
+					Login(gomock.Any(), "test@example.com", "1234", "192.0.2.1").
+					Return(&domain.TokenPair{AccessToken: "jwt-token", RefreshToken: "refresh-token"}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedToken:  "jwt-token",
@@ -125,21 +139,42 @@ func TestLogin(t *testing.T) {
 			requestBody: `{"email":"wrong@example.com", "password":"wrong"}`,
 			mockExpect: func() {
 				mockAuthService.EXPECT().
-					Login(gomock.Any(), "wrong@example.com", "wrong").
-					Return("", service.ErrInvalidCredentials)
+					Login(gomock.Any(), "wrong@example.com", "wrong", "192.0.2.1").
+					Return(nil, service.ErrInvalidCredentials)
 			},
 			expectedStatus: http.StatusUnauthorized,
 		},
+		{
+			name:        "Disabled",
+			requestBody: `{"email":"disabled@example.com", "password":"1234"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Login(gomock.Any(), "disabled@example.com", "1234", "192.0.2.1").
+					Return(nil, service.ErrUserDisabled)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
 		{
 			name:        "Internal Error",
 			requestBody: `{"email":"fail@example.com", "password":"1234"}`,
 			mockExpect: func() {
 				mockAuthService.EXPECT().
-					Login(gomock.Any(), "fail@example.com", "1234").
-					Return("", errors.New("db error"))
+					Login(gomock.Any(), "fail@example.com", "1234", "192.0.2.1").
+					Return(nil, errors.New("db error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
+		{
+			name:        "Locked",
+			requestBody: `{"email":"locked@example.com", "password":"1234"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Login(gomock.Any(), "locked@example.com", "1234", "192.0.2.1").
+					Return(nil, &service.LoginLockedError{RetryAfter: 90*time.Second + time.Millisecond})
+			},
+			expectedStatus:     http.StatusTooManyRequests,
+			expectedRetryAfter: "91",
+		},
 	}
 
 	for _, tt := range tests {
@@ -154,8 +189,10 @@ func TestLogin(t *testing.T) {
 			controller.Login(c)
 
 			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedRetryAfter, w.Header().Get("Retry-After"))
 			if tt.expectedStatus == http.StatusOK {
 				assert.Contains(t, w.Body.String(), tt.expectedToken)
+				assert.Contains(t, w.Body.String(), `"refreshToken":"refresh-token"`)
 			}
 		})
 	}
@@ -206,6 +243,36 @@ func TestRegister(t *testing.T) {
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
+		{
+			name:        "Role Not Allowed",
+			requestBody: `{"email":"admin@example.com", "password":"1234", "role":"admin"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Register(gomock.Any(), "admin@example.com", "1234", "admin").
+					Return("", service.ErrInvalidRole)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Invalid Email",
+			requestBody: `{"email":"user", "password":"1234", "role":"employee"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Register(gomock.Any(), "user", "1234", "employee").
+					Return("", fmt.Errorf("%w: email is malformed", service.ErrInvalidEmail))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Weak Password",
+			requestBody: `{"email":"user@example.com", "password":"1234", "role":"employee"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Register(gomock.Any(), "user@example.com", "1234", "employee").
+					Return("", fmt.Errorf("%w: password must be at least 8 characters", service.ErrWeakPassword))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -227,3 +294,488 @@ func TestRegister(t *testing.T) {
 	}
 }
 
+func TestRefresh(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewAuthController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"refreshToken":"refresh"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Refresh(gomock.Any(), "refresh").
+					Return(&domain.TokenPair{AccessToken: "jwt-token", RefreshToken: "next"}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Missing token",
+			requestBody:    `{}`,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Invalid token",
+			requestBody: `{"refreshToken":"stale"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Refresh(gomock.Any(), "stale").
+					Return(nil, service.ErrInvalidRefreshToken)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "Internal Error",
+			requestBody: `{"refreshToken":"refresh"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Refresh(gomock.Any(), "refresh").
+					Return(nil, errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/refresh", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			controller.Refresh(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			if tt.expectedStatus == http.StatusOK {
+				assert.Contains(t, w.Body.String(), `"token":"jwt-token"`)
+				assert.Contains(t, w.Body.String(), `"refreshToken":"next"`)
+			}
+		})
+	}
+}
+
+func TestLogout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewAuthController(svc, slog.Default())
+
+	expiresAt := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:        "With refresh token",
+			requestBody: `{"refreshToken":"refresh"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Logout(gomock.Any(), "1", "jti-1", expiresAt, "refresh").
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Without body",
+			requestBody: ``,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Logout(gomock.Any(), "1", "jti-1", expiresAt, "").
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Foreign refresh token",
+			requestBody: `{"refreshToken":"foreign"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					Logout(gomock.Any(), "1", "jti-1", expiresAt, "foreign").
+					Return(service.ErrInvalidRefreshToken)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/logout", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("user_id", "1")
+			c.Set("jti", "jti-1")
+			c.Set("token_expires_at", expiresAt)
+
+			controller.Logout(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewAuthController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		dummy          bool
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"oldPassword":"old", "newPassword":"NewPassword1"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					ChangePassword(gomock.Any(), "1", "old", "NewPassword1").
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Wrong old password",
+			requestBody: `{"oldPassword":"wrong", "newPassword":"NewPassword1"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					ChangePassword(gomock.Any(), "1", "wrong", "NewPassword1").
+					Return(service.ErrInvalidCredentials)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:        "Weak password",
+			requestBody: `{"oldPassword":"old", "newPassword":"new"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					ChangePassword(gomock.Any(), "1", "old", "new").
+					Return(fmt.Errorf("%w: password must be at least 8 characters", service.ErrWeakPassword))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Dummy token",
+			requestBody:    `{"oldPassword":"old", "newPassword":"NewPassword1"}`,
+			dummy:          true,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/password/change", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("user_id", "1")
+			c.Set("dummy", tt.dummy)
+
+			controller.ChangePassword(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestRequestPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewAuthController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:        "Accepted",
+			requestBody: `{"email":"user@example.com"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					RequestPasswordReset(gomock.Any(), "user@example.com").
+					Return(nil)
+			},
+			expectedStatus: http.StatusAccepted,
+		},
+		{
+			name:        "Invalid email",
+			requestBody: `{"email":"user"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					RequestPasswordReset(gomock.Any(), "user").
+					Return(fmt.Errorf("%w: email is malformed", service.ErrInvalidEmail))
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/password/reset/request", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			controller.RequestPasswordReset(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewAuthController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:        "Success",
+			requestBody: `{"token":"reset", "newPassword":"NewPassword1"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					ResetPassword(gomock.Any(), "reset", "NewPassword1").
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:        "Used token",
+			requestBody: `{"token":"used", "newPassword":"NewPassword1"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					ResetPassword(gomock.Any(), "used", "NewPassword1").
+					Return(service.ErrInvalidResetToken)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Internal Error",
+			requestBody: `{"token":"reset", "newPassword":"NewPassword1"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().
+					ResetPassword(gomock.Any(), "reset", "NewPassword1").
+					Return(errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewBufferString(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+
+			controller.ResetPassword(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestUserManagement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewAuthController(svc, slog.Default())
+
+	user := &domain.User{ID: "2", Email: "user@example.com", Role: "employee"}
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		route          string
+		handler        gin.HandlerFunc
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:    "List users",
+			method:  http.MethodGet,
+			path:    "/users?limit=5",
+			route:   "/users",
+			handler: controller.ListUsers,
+			mockExpect: func() {
+				mockAuthService.EXPECT().ListUsers(gomock.Any(), "", 5).
+					Return(&domain.UserPage{Items: []domain.User{*user}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "List users invalid limit",
+			method:         http.MethodGet,
+			path:           "/users?limit=100",
+			route:          "/users",
+			handler:        controller.ListUsers,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Get unknown user",
+			method:  http.MethodGet,
+			path:    "/users/9",
+			route:   "/users/:userId",
+			handler: controller.GetUser,
+			mockExpect: func() {
+				mockAuthService.EXPECT().GetUser(gomock.Any(), "9").Return(nil, service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:        "Update role",
+			method:      http.MethodPut,
+			path:        "/users/2/role",
+			route:       "/users/:userId/role",
+			handler:     controller.UpdateUserRole,
+			requestBody: `{"role":"moderator"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().UpdateUserRole(gomock.Any(), "2", "moderator").Return(user, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Update role without role",
+			method:         http.MethodPut,
+			path:           "/users/2/role",
+			route:          "/users/:userId/role",
+			handler:        controller.UpdateUserRole,
+			requestBody:    `{}`,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Deactivate self",
+			method:  http.MethodPost,
+			path:    "/users/1/deactivate",
+			route:   "/users/:userId/deactivate",
+			handler: controller.DeactivateUser,
+			mockExpect: func() {
+				mockAuthService.EXPECT().DeactivateUser(gomock.Any(), "1").Return(nil, service.ErrSelfManagement)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "Grant role with foreign permissions",
+			method:      http.MethodPut,
+			path:        "/users/2/role",
+			route:       "/users/:userId/role",
+			handler:     controller.UpdateUserRole,
+			requestBody: `{"role": "admin"}`,
+			mockExpect: func() {
+				mockAuthService.EXPECT().UpdateUserRole(gomock.Any(), "2", "admin").Return(nil, service.ErrInsufficientPermissions)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:    "Activate",
+			method:  http.MethodPost,
+			path:    "/users/2/activate",
+			route:   "/users/:userId/activate",
+			handler: controller.ActivateUser,
+			mockExpect: func() {
+				mockAuthService.EXPECT().ActivateUser(gomock.Any(), "2").Return(user, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Delete",
+			method:  http.MethodDelete,
+			path:    "/users/2",
+			route:   "/users/:userId",
+			handler: controller.DeleteUser,
+			mockExpect: func() {
+				mockAuthService.EXPECT().DeleteUser(gomock.Any(), "2").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Delete fails",
+			method:  http.MethodDelete,
+			path:    "/users/2",
+			route:   "/users/:userId",
+			handler: controller.DeleteUser,
+			mockExpect: func() {
+				mockAuthService.EXPECT().DeleteUser(gomock.Any(), "2").Return(errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			router := gin.New()
+			router.Handle(tt.method, tt.route, tt.handler)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(tt.method, tt.path, bytes.NewBufferString(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package http
+
+import (
+	"log/slog"
+
+	"github.com/Ranik23/avito-tech-spring/internal/token"
+	"github.com/gin-gonic/gin"
+)
+
+type JWKSController interface {
+	JWKS(c *gin.Context)
+}
+
+type jwksController struct {
+	token  token.Token
+	logger *slog.Logger
+}
+
+func NewJWKSController(token token.Token, logger *slog.Logger) JWKSController {
+	return &jwksController{
+		token:  token,
+		logger: logger,
+	}
+}
+
+// JWKS отдает публичные ключи проверки подписи. Клиенты кэшируют ответ на 5 минут,
+// поэтому новый ключ стоит публиковать заранее, до того как он станет активным.
+func (j *jwksController) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(200, j.token.JWKS())
+}
@@ -0,0 +1,44 @@
+//go:build unit
+
+package http
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ranik23/avito-tech-spring/internal/token"
+	tokenmock "github.com/Ranik23/avito-tech-spring/internal/token/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestJWKS(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := tokenmock.NewMockToken(ctrl)
+	controller := NewJWKSController(mockToken, slog.Default())
+
+	jwks := token.JWKS{Keys: []token.JWK{
+		{Kty: "OKP", Kid: "2025-05", Use: "sig", Alg: "EdDSA", Crv: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"},
+	}}
+	mockToken.EXPECT().JWKS().Return(jwks)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil)
+
+	controller.JWKS(c)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.NotEmpty(t, w.Header().Get("Cache-Control"))
+
+	var body token.JWKS
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+	assert.Equal(t, jwks, body)
+	assert.NotContains(t, w.Body.String(), `"n"`)
+}
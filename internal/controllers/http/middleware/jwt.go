@@ -11,7 +11,10 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func JwtAuth(tokenService token.Token) gin.HandlerFunc {
+// JwtAuth проверяет подпись и claims токена, затем список отозванных токенов и то, что учетная запись не отключена.
+// В контекст кладутся user_id, role, jti и token_expires_at, последние два нужны для /logout,
+// а также dummy для токенов DummyLogin. В строгом режиме такие токены отклоняются.
+func JwtAuth(tokenService token.Token, revocations token.RevocationList, users token.UserStatus, strict bool) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		authHeader := c.GetHeader("Authorization")
 		if authHeader == "" {
@@ -21,9 +24,9 @@ func JwtAuth(tokenService token.Token) gin.HandlerFunc {
 			return
 		}
 
-		token := strings.TrimPrefix(authHeader, "Bearer ")
+		tokenString := strings.TrimPrefix(authHeader, "Bearer ")
 		
-		claims, err := tokenService.Parse(token)
+		claims, err := tokenService.Parse(tokenString)
 		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
 				Message: err.Error(),
@@ -31,7 +34,68 @@ func JwtAuth(tokenService token.Token) gin.HandlerFunc {
 			return
 		}
 
-		if userID, ok := claims["user_id"].(string); ok {
+		jti, ok := token.TokenID(claims)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
+				Message: "no jti provided",
+			})
+			return
+		}
+
+		dummy := token.IsDummy(claims)
+		if dummy && strict {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
+				Message: "dummy tokens are not accepted",
+			})
+			return
+		}
+
+		revoked, err := revocations.IsAccessTokenRevoked(c, jti)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, dto.Error{
+				Message: "failed to check token revocation",
+			})
+			return
+		}
+		if revoked {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
+				Message: "token has been revoked",
+			})
+			return
+		}
+
+		userID, hasUserID := claims["user_id"].(string)
+
+		// dummy токены не привязаны к учетной записи, отключать у них нечего
+		if !dummy {
+			if !hasUserID {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
+					Message: "no user_id provided",
+				})
+				return
+			}
+
+			disabled, err := users.IsUserDisabled(c, userID)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusInternalServerError, dto.Error{
+					Message: "failed to check user status",
+				})
+				return
+			}
+			if disabled {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
+					Message: "user is disabled",
+				})
+				return
+			}
+		}
+
+		c.Set("jti", jti)
+		c.Set("dummy", dummy)
+		if expiresAt, ok := token.ExpiresAt(claims); ok {
+			c.Set("token_expires_at", expiresAt)
+		}
+		if hasUserID {
 			c.Set("user_id", userID)
 		}
 		if role, ok := claims["role"].(string); ok {
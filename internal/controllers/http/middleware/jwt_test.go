@@ -0,0 +1,143 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ranik23/avito-tech-spring/internal/token/mock"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestJwtAuth_DummyTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+	mockUsers.EXPECT().IsUserDisabled(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+
+	dummyClaims := map[string]interface{}{"user_id": "dummyRandomID", "role": "employee", "jti": "jti-1", "dummy": true}
+	realClaims := map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-2"}
+
+	tests := []struct {
+		name           string
+		strict         bool
+		mockExpect     func()
+		expectedStatus int
+		expectedDummy  bool
+	}{
+		{
+			name:   "dummy token accepted in development",
+			strict: false,
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("token").Return(dummyClaims, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedDummy:  true,
+		},
+		{
+			name:   "dummy token rejected in strict mode",
+			strict: true,
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("token").Return(dummyClaims, nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "real token accepted in strict mode",
+			strict: true,
+			mockExpect: func() {
+				mockToken.EXPECT().Parse("token").Return(realClaims, nil)
+				mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-2").Return(false, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			var dummy bool
+			router := gin.New()
+			router.GET("/", JwtAuth(mockToken, mockRevocations, mockUsers, tt.strict), func(c *gin.Context) {
+				dummy = c.GetBool("dummy")
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer token")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedDummy, dummy)
+		})
+	}
+}
+
+func TestJwtAuth_DisabledUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := mock.NewMockToken(ctrl)
+	mockRevocations := mock.NewMockRevocationList(ctrl)
+	mockUsers := mock.NewMockUserStatus(ctrl)
+
+	claims := map[string]interface{}{"user_id": "1", "role": "employee", "jti": "jti-1"}
+
+	tests := []struct {
+		name           string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name: "active user",
+			mockExpect: func() {
+				mockUsers.EXPECT().IsUserDisabled(gomock.Any(), "1").Return(false, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "disabled user",
+			mockExpect: func() {
+				mockUsers.EXPECT().IsUserDisabled(gomock.Any(), "1").Return(true, nil)
+			},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "status check fails",
+			mockExpect: func() {
+				mockUsers.EXPECT().IsUserDisabled(gomock.Any(), "1").Return(false, assert.AnError)
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockToken.EXPECT().Parse("token").Return(claims, nil)
+			mockRevocations.EXPECT().IsAccessTokenRevoked(gomock.Any(), "jti-1").Return(false, nil)
+			tt.mockExpect()
+
+			router := gin.New()
+			router.GET("/", JwtAuth(mockToken, mockRevocations, mockUsers, true), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.Header.Set("Authorization", "Bearer token")
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/dto"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/gin-gonic/gin"
+)
+
+// RequirePermission пропускает запрос, только если у роли из JwtAuth есть право permission.
+// gRPC интерсептор авторизации проверяет права по той же политике.
+func RequirePermission(policy *rbac.Policy, permission rbac.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		role, ok := c.Get("role")
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
+				Message: "no role provided",
+			})
+			return
+		}
+
+		roleStr, ok := role.(string)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusBadRequest, dto.Error{
+				Message: "role must be a string",
+			})
+			return
+		}
+
+		if !policy.Allowed(roleStr, permission) {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.Error{
+				Message: fmt.Sprintf("%s has no access", roleStr),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
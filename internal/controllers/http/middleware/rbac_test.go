@@ -0,0 +1,56 @@
+//go:build unit
+
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequirePermission(t *testing.T) {
+	policy, err := rbac.NewPolicy(map[string][]string{
+		"employee":  {"pvz:read", "product:add"},
+		"moderator": {"pvz:read", "pvz:create"},
+		"auditor":   {"pvz:read"},
+	}, nil)
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		role           interface{}
+		permission     rbac.Permission
+		expectedStatus int
+	}{
+		{name: "allowed", role: "employee", permission: rbac.ProductAdd, expectedStatus: http.StatusOK},
+		{name: "role case is ignored", role: "Moderator", permission: rbac.PVZCreate, expectedStatus: http.StatusOK},
+		{name: "role from config", role: "auditor", permission: rbac.PVZRead, expectedStatus: http.StatusOK},
+		{name: "no permission", role: "moderator", permission: rbac.ProductAdd, expectedStatus: http.StatusForbidden},
+		{name: "employee cannot create pvz", role: "employee", permission: rbac.PVZCreate, expectedStatus: http.StatusForbidden},
+		{name: "unknown role", role: "client", permission: rbac.PVZRead, expectedStatus: http.StatusForbidden},
+		{name: "no role", role: nil, permission: rbac.PVZRead, expectedStatus: http.StatusUnauthorized},
+		{name: "role is not a string", role: 1, permission: rbac.PVZRead, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.GET("/", func(c *gin.Context) {
+				if tt.role != nil {
+					c.Set("role", tt.role)
+				}
+			}, RequirePermission(policy, tt.permission), func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
@@ -11,7 +12,9 @@ import (
 	"time"
 
 	converter "github.com/Ranik23/avito-tech-spring/internal/models/converter/http"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
 	"github.com/Ranik23/avito-tech-spring/internal/models/dto"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"github.com/gin-gonic/gin"
 )
@@ -20,9 +23,25 @@ type PvzController interface {
 	CreatePvz(c *gin.Context)
 	GetPvzInfo(c *gin.Context)
 	CloseLastReception(c *gin.Context)
+	PauseReception(c *gin.Context)
+	ResumeReception(c *gin.Context)
+	CancelReception(c *gin.Context)
+	ReopenReception(c *gin.Context)
+	GetReceptionHistory(c *gin.Context)
 	DeleteLastProduct(c *gin.Context)
+	DeleteProduct(c *gin.Context)
+	RestoreProduct(c *gin.Context)
 	CreateReception(c *gin.Context)
 	AddProduct(c *gin.Context)
+	AddProducts(c *gin.Context)
+	FindProductsByBarcode(c *gin.Context)
+	AssignEmployee(c *gin.Context)
+	UnassignEmployee(c *gin.Context)
+	GetAuditLog(c *gin.Context)
+	ListProductTypes(c *gin.Context)
+	CreateProductType(c *gin.Context)
+	UpdateProductType(c *gin.Context)
+	DeleteProductType(c *gin.Context)
 }
 
 type pvzController struct {
@@ -38,10 +57,6 @@ func NewPVZController(service service.Service, logger *slog.Logger) PvzControlle
 }
 
 func (p *pvzController) CloseLastReception(c *gin.Context) {
-	if !p.check(c, "employee") {
-		return
-	}
-
 	pvzID := c.Param("pvzId")
 	if pvzID == "" {
 		c.JSON(http.StatusBadRequest, dto.Error{
@@ -51,8 +66,12 @@ func (p *pvzController) CloseLastReception(c *gin.Context) {
 		return
 	}
 	
-	reception, err := p.service.CloseReception(c, pvzID)
+	reception, err := p.service.CloseReception(withActor(c), pvzID)
 	if err != nil {
+		if errors.Is(err, service.ErrPVZAccessDenied) {
+			c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+			return
+		}
 		if errors.Is(err, service.ErrAllReceptionsClosed) {
 			c.JSON(http.StatusBadGateway, dto.Error{
 				Message: err.Error(),
@@ -69,12 +88,92 @@ func (p *pvzController) CloseLastReception(c *gin.Context) {
 }
 
 
-func (p *pvzController) AddProduct(c *gin.Context) {
-	if !p.check(c, "employee") {
-		p.logger.Error("Invalid role for AddProduct")
+func (p *pvzController) PauseReception(c *gin.Context) {
+	reception, err := p.service.PauseReception(withActor(c), c.Param("receptionId"))
+	if err != nil {
+		p.receptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converter.FromDomainReceptionToDtoReception(reception))
+}
+
+func (p *pvzController) ResumeReception(c *gin.Context) {
+	reception, err := p.service.ResumeReception(withActor(c), c.Param("receptionId"))
+	if err != nil {
+		p.receptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converter.FromDomainReceptionToDtoReception(reception))
+}
+
+func (p *pvzController) CancelReception(c *gin.Context) {
+	var req dto.ReceptionReasonReq
+	if !p.bindOptionalJSON(c, &req) {
+		return
+	}
+
+	reception, err := p.service.CancelReception(withActor(c), c.Param("receptionId"), req.Reason)
+	if err != nil {
+		p.receptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converter.FromDomainReceptionToDtoReception(reception))
+}
+
+func (p *pvzController) ReopenReception(c *gin.Context) {
+	var req dto.ReceptionReasonReq
+	if !p.bindOptionalJSON(c, &req) {
+		return
+	}
+
+	reception, err := p.service.ReopenReception(withActor(c), c.Param("receptionId"), req.Reason)
+	if err != nil {
+		p.receptionError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, converter.FromDomainReceptionToDtoReception(reception))
+}
+
+func (p *pvzController) GetReceptionHistory(c *gin.Context) {
+	history, err := p.service.GetReceptionHistory(withActor(c), c.Param("receptionId"))
+	if err != nil {
+		p.receptionError(c, err)
 		return
 	}
 
+	c.JSON(http.StatusOK, converter.FromDomainReceptionHistoryToDtoGetReceptionHistoryResp(history))
+}
+
+// receptionError отвечает на ошибки переходов приемки по ее id.
+func (p *pvzController) receptionError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPVZAccessDenied):
+		c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrReceptionNotFound):
+		c.JSON(http.StatusNotFound, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrInvalidReceptionTransition) || errors.Is(err, service.ErrAlreadyOpen) ||
+		errors.Is(err, service.ErrInvalidReason):
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+	}
+}
+
+// bindOptionalJSON разбирает тело запроса, если оно есть: пустое тело не ошибка.
+func (p *pvzController) bindOptionalJSON(c *gin.Context, req any) bool {
+	if err := c.ShouldBindJSON(req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+		return false
+	}
+	return true
+}
+
+
+func (p *pvzController) AddProduct(c *gin.Context) {
 	var req dto.PostProductReq
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -82,9 +181,23 @@ func (p *pvzController) AddProduct(c *gin.Context) {
 		return
 	}
 
-	product, err := p.service.AddProduct(c, req.PvzID, req.Type)
+	product, err := p.service.AddProduct(withActor(c), req.PvzID, domain.ProductItem{
+		Type:    req.Type,
+		Barcode: req.Barcode,
+		OrderID: req.OrderID,
+	})
 	if err != nil {
-		if errors.Is(err, service.ErrAllReceptionsClosed) {
+		if errors.Is(err, service.ErrPVZAccessDenied) {
+			c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrDuplicateBarcode) {
+			c.JSON(http.StatusConflict, dto.Error{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrAllReceptionsClosed) || errors.Is(err, service.ErrReceptionPaused) ||
+			errors.Is(err, service.ErrInvalidProductType) || errors.Is(err, service.ErrInvalidBarcode) ||
+			errors.Is(err, service.ErrInvalidOrderID) {
 			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
 			return
 		}
@@ -96,11 +209,67 @@ func (p *pvzController) AddProduct(c *gin.Context) {
 	c.JSON(http.StatusCreated, converter.FromDomainProductToDtoPostProductResp(product))
 }
 
-func (p *pvzController) CreateReception(c *gin.Context) {
-	if !p.check(c, "employee") {
+func (p *pvzController) AddProducts(c *gin.Context) {
+	var req dto.PostProductsBatchReq
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+		return
+	}
+
+	items := make([]domain.ProductItem, 0, len(req.Products))
+	for _, item := range req.Products {
+		items = append(items, domain.ProductItem{
+			Type:    item.Type,
+			Barcode: item.Barcode,
+			OrderID: item.OrderID,
+		})
+	}
+
+	products, err := p.service.AddProducts(withActor(c), req.PvzID, items)
+	if err != nil {
+		if errors.Is(err, service.ErrPVZAccessDenied) {
+			c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrDuplicateBarcode) {
+			c.JSON(http.StatusConflict, dto.Error{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrAllReceptionsClosed) || errors.Is(err, service.ErrReceptionPaused) ||
+			errors.Is(err, service.ErrInvalidBatchSize) || errors.Is(err, service.ErrInvalidProductType) ||
+			errors.Is(err, service.ErrInvalidBarcode) || errors.Is(err, service.ErrInvalidOrderID) {
+			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusCreated, converter.FromDomainProductsToDtoPostProductsBatchResp(products))
+}
+
+func (p *pvzController) FindProductsByBarcode(c *gin.Context) {
+	barcode := c.Query("barcode")
+	if barcode == "" {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: "barcode not provided"})
+		return
+	}
+
+	products, err := p.service.FindProductsByBarcode(withActor(c), barcode)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidBarcode) {
+			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
 		return
 	}
 
+	c.JSON(http.StatusOK, converter.FromDomainProductsToDtoFindProductsResp(products))
+}
+
+func (p *pvzController) CreateReception(c *gin.Context) {
 	var req dto.CreateReceptionReq
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -108,8 +277,16 @@ func (p *pvzController) CreateReception(c *gin.Context) {
 		return
 	}
 
-	reception, err := p.service.StartReception(context.TODO(), req.PvzId)
+	reception, err := p.service.StartReception(withActor(c), req.PvzId)
 	if err != nil {
+		if errors.Is(err, service.ErrPVZAccessDenied) {
+			c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrAlreadyOpen) {
+			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
 		return
 	}
@@ -119,18 +296,19 @@ func (p *pvzController) CreateReception(c *gin.Context) {
 
 
 func (p *pvzController) DeleteLastProduct(c *gin.Context) {
-	if !p.check(c, "employee") {
-		return
-	}
-
 	pvzID := c.Param("pvzId")
 	if pvzID == "" {
 		c.JSON(http.StatusBadRequest,dto.Error{Message: "pvzID not provided"})
 		return
 	}
 
-	if err := p.service.DeleteLastProduct(c, pvzID); err != nil {
-		if errors.Is(err, service.ErrAllReceptionsClosed) || errors.Is(err, service.ErrReceptionEmpty) {
+	if err := p.service.DeleteLastProduct(withActor(c), pvzID); err != nil {
+		if errors.Is(err, service.ErrPVZAccessDenied) {
+			c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+			return
+		}
+		if errors.Is(err, service.ErrAllReceptionsClosed) || errors.Is(err, service.ErrReceptionPaused) ||
+			errors.Is(err, service.ErrReceptionEmpty) {
 			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
 			return
 		}
@@ -144,11 +322,46 @@ func (p *pvzController) DeleteLastProduct(c *gin.Context) {
 }
 
 
-func (p *pvzController) CreatePvz(c *gin.Context) {
-	if !p.check(c, "moderator") {
+func (p *pvzController) DeleteProduct(c *gin.Context) {
+	if err := p.service.DeleteProduct(withActor(c), c.Param("pvzId"), c.Param("productId")); err != nil {
+		p.productError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Description" : "Товар Удален",
+	})
+}
+
+func (p *pvzController) RestoreProduct(c *gin.Context) {
+	product, err := p.service.RestoreProduct(withActor(c), c.Param("pvzId"), c.Param("productId"))
+	if err != nil {
+		p.productError(c, err)
 		return
 	}
 
+	c.JSON(http.StatusOK, converter.FromDomainProductToDtoPostProductResp(product))
+}
+
+// productError отвечает на ошибки удаления и восстановления конкретного товара.
+func (p *pvzController) productError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrPVZAccessDenied):
+		c.JSON(http.StatusForbidden, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrDuplicateBarcode):
+		c.JSON(http.StatusConflict, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrAllReceptionsClosed) || errors.Is(err, service.ErrReceptionPaused) ||
+		errors.Is(err, service.ErrProductNotDeleted):
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+	}
+}
+
+
+func (p *pvzController) CreatePvz(c *gin.Context) {
 	var req dto.CreatePvzReq 
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -156,7 +369,7 @@ func (p *pvzController) CreatePvz(c *gin.Context) {
 		return
 	}
 
-	pvz, err := p.service.CreatePVZ(c, req.City)
+	pvz, err := p.service.CreatePVZ(withActor(c), req.City)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
 		return
@@ -167,25 +380,18 @@ func (p *pvzController) CreatePvz(c *gin.Context) {
 
 
 func (p *pvzController) GetPvzInfo(c *gin.Context) {
-	if !p.check(c, "employee", "moderator") {
-		return
-	}
-
-	pageStr := c.Query("page")
-	limitStr := c.Query("limit")
-
-	page, err := strconv.Atoi(pageStr)
-	if err != nil || page < 1 {
+	page, ok := p.parseIntParam(c, "page", 1)
+	if !ok || page < 1 {
 		c.JSON(http.StatusBadRequest, dto.Error{
-			Message: "failed to convert to int",
+			Message: "page must be a positive integer",
 		})
 		return
 	}
 
-	limit, err := strconv.Atoi(limitStr)
-	if err != nil || limit < 1 || limit > 30 {
+	limit, ok := p.parseIntParam(c, "limit", service.DefaultPageLimit)
+	if !ok || limit < 1 || limit > service.MaxPageLimit {
 		c.JSON(http.StatusBadRequest, dto.Error{
-			Message: "failed to convert to int",
+			Message: fmt.Sprintf("limit must be between 1 and %d", service.MaxPageLimit),
 		})
 		return
 	}
@@ -193,7 +399,7 @@ func (p *pvzController) GetPvzInfo(c *gin.Context) {
 	startDate, ok := p.parseTimeParam(c, "startDate")
 	if !ok {
 		c.JSON(http.StatusBadRequest, dto.Error{
-			Message: "failed to get startDate",
+			Message: "invalid startDate format",
 		})
 		return
 	}
@@ -201,66 +407,274 @@ func (p *pvzController) GetPvzInfo(c *gin.Context) {
 	endDate, ok := p.parseTimeParam(c, "endDate")
 	if !ok {
 		c.JSON(http.StatusBadRequest, dto.Error{
-			Message: "failed to get endDate",
+			Message: "invalid endDate format",
 		})
 		return
 	}
 
-	_, err = p.service.GetPVZSInfo(c, startDate, endDate, page, limit)
+	query := domain.PvzInfoQuery{
+		StartDate:         startDate,
+		EndDate:           endDate,
+		Cities:            p.parseListParam(c, "city"),
+		PvzIDs:            p.parseListParam(c, "pvzId"),
+		ReceptionStatuses: p.parseListParam(c, "receptionStatus"),
+		ProductTypes:      p.parseListParam(c, "productType"),
+		Page:              page,
+		Limit:             limit,
+		Cursor:            c.Query("cursor"),
+	}
+
+	pvzsInfo, err := p.service.GetPVZSInfo(withActor(c), query)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidPagination) || errors.Is(err, service.ErrInvalidCursor) ||
+			errors.Is(err, service.ErrInvalidFilter) || errors.Is(err, service.ErrInvalidCity) ||
+			errors.Is(err, service.ErrInvalidProductType) {
+			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, dto.Error{
 			Message: err.Error(),
 		})
 		return
 	}
+
+	c.JSON(http.StatusOK, converter.FromDomainPvzInfoPageToDtoGetPvzInfoListResp(pvzsInfo))
 }
 
 
-func (p *pvzController) parseTimeParam(c *gin.Context, param string) (time.Time, bool) {
-	value := c.Query(param)
-	if value == "" {
-		return time.Now(), false
+func (p *pvzController) AssignEmployee(c *gin.Context) {
+	pvzID := c.Param("pvzId")
+	if pvzID == "" {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: "pvzId not provided"})
+		return
 	}
-	t, err := time.Parse(time.RFC3339, value)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.Error{Message: fmt.Sprintf("invalid %s format", param)})
-		return time.Now(), false
+
+	var req dto.AssignEmployeeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+		return
+	}
+	if req.UserID == "" {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: "userId not provided"})
+		return
+	}
+
+	if err := p.service.AssignEmployee(withActor(c), pvzID, req.UserID); err != nil {
+		if errors.Is(err, service.ErrNoPVZFound) || errors.Is(err, service.ErrUserNotFound) {
+			c.JSON(http.StatusNotFound, dto.Error{Message: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Description" : "Сотрудник назначен на ПВЗ",
+	})
+}
+
+
+func (p *pvzController) UnassignEmployee(c *gin.Context) {
+	pvzID := c.Param("pvzId")
+	userID := c.Param("userId")
+	if pvzID == "" || userID == "" {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: "pvzId and userId are required"})
+		return
+	}
+
+	if err := p.service.UnassignEmployee(withActor(c), pvzID, userID); err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			c.JSON(http.StatusNotFound, dto.Error{Message: err.Error()})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+		return
 	}
-	return t, true
+
+	c.JSON(http.StatusOK, gin.H{
+		"Description" : "Сотрудник снят с ПВЗ",
+	})
 }
 
 
-func (p *pvzController) check(c *gin.Context, allowedRoles ...string) bool {
-	role, exists := c.Get("role")
-	if !exists {
-		c.AbortWithStatusJSON(http.StatusUnauthorized, dto.Error{
-			Message: "no role provided",
+// GetAuditLog отдает журнал действий от новых записей к старым, фильтры userId, pvzId, from и to необязательны.
+func (p *pvzController) GetAuditLog(c *gin.Context) {
+	limit, ok := p.parseIntParam(c, "limit", service.DefaultPageLimit)
+	if !ok || limit < 1 || limit > service.MaxPageLimit {
+		c.JSON(http.StatusBadRequest, dto.Error{
+			Message: fmt.Sprintf("limit must be between 1 and %d", service.MaxPageLimit),
 		})
-		return false
+		return
 	}
 
-	roleStr, ok := role.(string) 
+	from, ok := p.parseTimeParam(c, "from")
 	if !ok {
-		c.AbortWithStatusJSON(http.StatusBadRequest, dto.Error{
-			Message: "role must be a string",
-		})
-		return false
+		c.JSON(http.StatusBadRequest, dto.Error{Message: "invalid from format"})
+		return
+	}
+
+	to, ok := p.parseTimeParam(c, "to")
+	if !ok {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: "invalid to format"})
+		return
 	}
 
-	roleStr = strings.ToLower(roleStr)
+	query := domain.AuditQuery{
+		UserID: c.Query("userId"),
+		PvzIDs: p.parseListParam(c, "pvzId"),
+		From:   from,
+		To:     to,
+		Limit:  limit,
+		Cursor: c.Query("cursor"),
+	}
 
-	for _, allowedRole := range allowedRoles {
-		if roleStr == strings.ToLower(allowedRole) {
-			return true
+	page, err := p.service.GetAuditLog(withActor(c), query)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidPagination) || errors.Is(err, service.ErrInvalidCursor) ||
+			errors.Is(err, service.ErrInvalidFilter) {
+			c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+			return
 		}
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, converter.FromDomainAuditPageToDtoGetAuditLogResp(page))
+}
+
+
+func (p *pvzController) ListProductTypes(c *gin.Context) {
+	productTypes, err := p.service.ListProductTypes(c)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+		return
 	}
 
-	c.AbortWithStatusJSON(http.StatusForbidden, dto.Error{
-		Message: fmt.Sprintf("%s has no access", roleStr),
+	c.JSON(http.StatusOK, converter.FromDomainProductTypesToDtoListProductTypesResp(productTypes))
+}
+
+
+func (p *pvzController) CreateProductType(c *gin.Context) {
+	var req dto.CreateProductTypeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+		return
+	}
+
+	productType, err := p.service.CreateProductType(c, domain.ProductType{
+		Name:           req.Name,
+		Fragile:        req.Fragile,
+		MaxWeightGrams: req.MaxWeightGrams,
+	})
+	if err != nil {
+		p.productTypeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, converter.FromDomainProductTypeToDtoProductType(productType))
+}
+
+
+func (p *pvzController) UpdateProductType(c *gin.Context) {
+	var req dto.UpdateProductTypeReq
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+		return
+	}
+
+	productType, err := p.service.UpdateProductType(c, domain.ProductType{
+		Name:           c.Param("name"),
+		Fragile:        req.Fragile,
+		MaxWeightGrams: req.MaxWeightGrams,
 	})
+	if err != nil {
+		p.productTypeError(c, err)
+		return
+	}
 
-	return false
+	c.JSON(http.StatusOK, converter.FromDomainProductTypeToDtoProductType(productType))
 }
 
 
+func (p *pvzController) DeleteProductType(c *gin.Context) {
+	if err := p.service.DeleteProductType(c, c.Param("name")); err != nil {
+		p.productTypeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"Description" : "Тип товара удален",
+	})
+}
 
+
+// productTypeError переводит ошибки справочника типов товаров в HTTP статусы.
+func (p *pvzController) productTypeError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, service.ErrInvalidProductType):
+		c.JSON(http.StatusBadRequest, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrProductTypeNotFound):
+		c.JSON(http.StatusNotFound, dto.Error{Message: err.Error()})
+	case errors.Is(err, service.ErrAlreadyExists):
+		c.JSON(http.StatusConflict, dto.Error{Message: err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.Error{Message: err.Error()})
+	}
+}
+
+
+// withActor переносит пользователя, которого JwtAuth положил в c.Set, в rbac.Actor: по нему сервис
+// ограничивает сотрудника назначенными ПВЗ. Без роли контекст возвращается как есть.
+func withActor(c *gin.Context) context.Context {
+	role, ok := c.Get("role")
+	if !ok {
+		return c
+	}
+	roleStr, ok := role.(string)
+	if !ok {
+		return c
+	}
+	return rbac.WithActor(c, rbac.Actor{UserID: c.GetString("user_id"), Role: roleStr, Dummy: c.GetBool("dummy")})
+}
+
+
+// parseListParam собирает значения повторяющегося параметра, каждое значение может быть списком через запятую.
+// Возвращает nil, если параметр не передан.
+func (p *pvzController) parseListParam(c *gin.Context, param string) []string {
+	var result []string
+	for _, value := range c.QueryArray(param) {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				result = append(result, item)
+			}
+		}
+	}
+	return result
+}
+
+// parseIntParam возвращает def, если параметр не передан.
+func (p *pvzController) parseIntParam(c *gin.Context, param string, def int) (int, bool) {
+	value := c.Query(param)
+	if value == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, false
+	}
+	return n, true
+}
+
+
+// parseTimeParam возвращает nil, если параметр не передан.
+func (p *pvzController) parseTimeParam(c *gin.Context, param string) (*time.Time, bool) {
+	value := c.Query(param)
+	if value == "" {
+		return nil, true
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, false
+	}
+	return &t, true
+}
@@ -4,14 +4,18 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 	"github.com/Ranik23/avito-tech-spring/internal/service/mock"
 	"github.com/gin-gonic/gin"
@@ -51,13 +55,6 @@ func TestCloseLastReception(t *testing.T) {
 			mockExpect:     func() {},
 			expectedStatus: http.StatusBadRequest,
 		},
-		{
-			name:   "Forbidden role",
-			role:   "client",
-			pvzId:  "123",
-			mockExpect: func() {},
-			expectedStatus: http.StatusForbidden,
-		},
 		{
 			name:   "Service Error",
 			role:   "employee",
@@ -113,11 +110,11 @@ func TestAddProduct(t *testing.T) {
 	}{
 		{
 			name: "success",
-			body: `{"pvzId":"123","type":"electronics"}`,
+			body: `{"pvzId":"123","type":"electronics","barcode":"4601234567890","orderId":"ORD-1"}`,
 			role: "employee",
 			mockExpect: func() {
 				mockPVZService.EXPECT().
-					AddProduct(gomock.Any(), "123", "electronics").
+					AddProduct(gomock.Any(), "123", domain.ProductItem{Type: "electronics", Barcode: "4601234567890", OrderID: "ORD-1"}).
 					Return(&domain.Product{}, nil)
 			},
 			expectedStatus: http.StatusCreated,
@@ -135,17 +132,32 @@ func TestAddProduct(t *testing.T) {
 			role: "employee",
 			mockExpect: func() {
 				mockPVZService.EXPECT().
-					AddProduct(gomock.Any(), "123", "fail").
+					AddProduct(gomock.Any(), "123", domain.ProductItem{Type: "fail"}).
 					Return(nil, errors.New("error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 		},
 		{
-			name:           "unauthorized role",
-			body:           `{"pvzId":"123","type":"x"}`,
-			role:           "client",
-			mockExpect:     func() {},
-			expectedStatus: http.StatusForbidden,
+			name: "unknown type",
+			body: `{"pvzId":"123","type":"electronic"}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					AddProduct(gomock.Any(), "123", domain.ProductItem{Type: "electronic"}).
+					Return(nil, &service.InvalidProductTypeError{Type: "electronic", Reason: "not in the product type catalog"})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "duplicate barcode",
+			body: `{"pvzId":"123","type":"обувь","barcode":"4601234567890"}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					AddProduct(gomock.Any(), "123", domain.ProductItem{Type: "обувь", Barcode: "4601234567890"}).
+					Return(nil, service.ErrDuplicateBarcode)
+			},
+			expectedStatus: http.StatusConflict,
 		},
 	}
 
@@ -164,6 +176,160 @@ func TestAddProduct(t *testing.T) {
 	}
 }
 
+func TestAddProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		body           string
+		role           string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name: "success",
+			body: `{"pvzId":"123","products":[{"type":"обувь","barcode":"4601234567890"},{"type":"одежда"}]}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					AddProducts(gomock.Any(), "123", []domain.ProductItem{{Type: "обувь", Barcode: "4601234567890"}, {Type: "одежда"}}).
+					Return([]domain.Product{{ID: "1"}, {ID: "2"}}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "invalid json",
+			body:           `{"pvzId":"123","products":"x"}`,
+			role:           "employee",
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "empty batch",
+			body: `{"pvzId":"123","products":[]}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					AddProducts(gomock.Any(), "123", []domain.ProductItem{}).
+					Return(nil, service.ErrInvalidBatchSize)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "no open reception",
+			body: `{"pvzId":"123","products":[{"type":"обувь"}]}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					AddProducts(gomock.Any(), "123", []domain.ProductItem{{Type: "обувь"}}).
+					Return(nil, service.ErrAllReceptionsClosed)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "duplicate barcode",
+			body: `{"pvzId":"123","products":[{"type":"обувь","barcode":"1"},{"type":"одежда","barcode":"1"}]}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					AddProducts(gomock.Any(), "123", []domain.ProductItem{{Type: "обувь", Barcode: "1"}, {Type: "одежда", Barcode: "1"}}).
+					Return(nil, service.ErrDuplicateBarcode)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodPost, "/products/batch", bytes.NewBufferString(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("role", tt.role)
+
+			controller.AddProducts(c)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestFindProductsByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		query          string
+		mockExpect     func()
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:  "success",
+			query: "?barcode=4601234567890",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					FindProductsByBarcode(gomock.Any(), "4601234567890").
+					Return([]domain.Product{{ID: "1", Type: "обувь", ReceptionID: "r1", Barcode: "4601234567890", OrderID: "ORD-1"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `"barcode":"4601234567890","orderId":"ORD-1"`,
+		},
+		{
+			name:  "not found",
+			query: "?barcode=404",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					FindProductsByBarcode(gomock.Any(), "404").
+					Return([]domain.Product{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"items":[]}`,
+		},
+		{
+			name:           "no barcode",
+			query:          "",
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "invalid barcode",
+			query: "?barcode=%20",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					FindProductsByBarcode(gomock.Any(), " ").
+					Return(nil, service.ErrInvalidBarcode)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Request = httptest.NewRequest(http.MethodGet, "/products"+tt.query, nil)
+
+			controller.FindProductsByBarcode(c)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
+
 func TestCreateReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -210,12 +376,32 @@ func TestCreateReception(t *testing.T) {
 			expectedStatus: http.StatusInternalServerError,
 		},
 		{
-			name:           "unauthorized",
-			body:           `{"pvzId":"x"}`,
-			role:           "client",
-			mockExpect:     func() {},
+			name: "not assigned",
+			body: `{"pvzId":"999"}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					StartReception(gomock.Any(), "999").
+					DoAndReturn(func(ctx context.Context, pvzID string) (*domain.Reception, error) {
+						actor, ok := rbac.ActorFromContext(ctx)
+						assert.True(t, ok)
+						assert.Equal(t, rbac.Actor{UserID: "7", Role: "employee"}, actor)
+						return nil, service.ErrPVZAccessDenied
+					})
+			},
 			expectedStatus: http.StatusForbidden,
 		},
+		{
+			name: "already open",
+			body: `{"pvzId":"pvz1"}`,
+			role: "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					StartReception(gomock.Any(), "pvz1").
+					Return(nil, service.ErrAlreadyOpen)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -226,6 +412,7 @@ func TestCreateReception(t *testing.T) {
 			c.Request = httptest.NewRequest(http.MethodPost, "/create-reception", bytes.NewBufferString(tt.body))
 			c.Request.Header.Set("Content-Type", "application/json")
 			c.Set("role", tt.role)
+			c.Set("user_id", "7")
 
 			controller.CreateReception(c)
 			assert.Equal(t, tt.expectedStatus, w.Code)
@@ -279,10 +466,14 @@ func TestDeleteLastProduct(t *testing.T) {
 			expectedStatus: http.StatusInternalServerError,
 		},
 		{
-			name:           "unauthorized",
-			pvzID:          "123",
-			role:           "client",
-			mockExpect:     func() {},
+			name:  "not assigned",
+			pvzID: "999",
+			role:  "employee",
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					DeleteLastProduct(gomock.Any(), "999").
+					Return(service.ErrPVZAccessDenied)
+			},
 			expectedStatus: http.StatusForbidden,
 		},
 	}
@@ -303,152 +494,476 @@ func TestDeleteLastProduct(t *testing.T) {
 	}
 }
 
-func TestCreatePvz(t *testing.T) {
+func TestDeleteAndRestoreProduct(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	mockPVZService := mock.NewMockPVZService(ctrl)
 	mockAuthService := mock.NewMockAuthService(ctrl)
-
+	mockPVZService := mock.NewMockPVZService(ctrl)
 	svc := service.NewService(mockAuthService, mockPVZService)
 	controller := NewPVZController(svc, slog.Default())
 
 	tests := []struct {
 		name           string
-		role           string
-		requestBody    string
+		restore        bool
+		productID      string
 		mockExpect     func()
 		expectedStatus int
-		expectedID     string
-		expectedCity   string
 	}{
 		{
-			name:        "success",
-			role:        "moderator",
-			requestBody: `{"city": "Moscow"}`,
+			name:      "delete",
+			productID: "5",
 			mockExpect: func() {
-				mockPVZService.EXPECT().
-					CreatePVZ(gomock.Any(), "Moscow").
-					Return(&domain.Pvz{ID: "pvz1", City: "Moscow"}, nil)
+				mockPVZService.EXPECT().DeleteProduct(gomock.Any(), "123", "5").Return(nil)
 			},
-			expectedStatus: http.StatusCreated,
-			expectedID:     "pvz1",
-			expectedCity:   "Moscow",
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name:        "invalid JSON",
-			role:        "moderator",
-			requestBody: `{"city": 123}`,
-			mockExpect:  func() {},
+			name:      "delete not found",
+			productID: "404",
+			mockExpect: func() {
+				mockPVZService.EXPECT().DeleteProduct(gomock.Any(), "123", "404").Return(service.ErrProductNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:      "delete without open reception",
+			productID: "5",
+			mockExpect: func() {
+				mockPVZService.EXPECT().DeleteProduct(gomock.Any(), "123", "5").Return(service.ErrAllReceptionsClosed)
+			},
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name:        "unauthorized",
-			role:        "employee",
-			requestBody: `{"city": "Moscow"}`,
-			mockExpect:  func() {},
-			expectedStatus: http.StatusForbidden,
+			name:      "restore",
+			restore:   true,
+			productID: "5",
+			mockExpect: func() {
+				mockPVZService.EXPECT().RestoreProduct(gomock.Any(), "123", "5").
+					Return(&domain.Product{ID: "5", ReceptionID: "r1", Type: "обувь"}, nil)
+			},
+			expectedStatus: http.StatusOK,
 		},
 		{
-			name:        "service error",
-			role:        "moderator",
-			requestBody: `{"city": "Moscow"}`,
+			name:      "restore not deleted",
+			restore:   true,
+			productID: "5",
 			mockExpect: func() {
-				mockPVZService.EXPECT().
-					CreatePVZ(gomock.Any(), "Moscow").
-					Return(nil, errors.New("service error"))
+				mockPVZService.EXPECT().RestoreProduct(gomock.Any(), "123", "5").Return(nil, service.ErrProductNotDeleted)
 			},
-			expectedStatus: http.StatusInternalServerError,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:      "restore duplicate barcode",
+			restore:   true,
+			productID: "5",
+			mockExpect: func() {
+				mockPVZService.EXPECT().RestoreProduct(gomock.Any(), "123", "5").Return(nil, service.ErrDuplicateBarcode)
+			},
+			expectedStatus: http.StatusConflict,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockExpect()
-
 			w := httptest.NewRecorder()
-			req := httptest.NewRequest(http.MethodPost, "/pvz", strings.NewReader(tt.requestBody))
-			req.Header.Set("Content-Type", "application/json")
-
 			c, _ := gin.CreateTestContext(w)
-			c.Request = req
-			c.Set("role", tt.role)
-
-			controller.CreatePvz(c)
+			c.Params = gin.Params{{Key: "pvzId", Value: "123"}, {Key: "productId", Value: tt.productID}}
+			c.Set("role", "employee")
 
-			assert.Equal(t, tt.expectedStatus, w.Code)
-
-			if tt.expectedStatus == http.StatusCreated {
-				assert.Contains(t, w.Body.String(), `"id":"`+tt.expectedID+`"`)
-				assert.Contains(t, w.Body.String(), `"city":"`+tt.expectedCity+`"`)
+			if tt.restore {
+				c.Request = httptest.NewRequest(http.MethodPost, "/pvz/123/products/"+tt.productID+"/restore", nil)
+				controller.RestoreProduct(c)
+			} else {
+				c.Request = httptest.NewRequest(http.MethodDelete, "/pvz/123/products/"+tt.productID, nil)
+				controller.DeleteProduct(c)
 			}
+			assert.Equal(t, tt.expectedStatus, w.Code)
 		})
 	}
 }
 
-func TestGetPvzInfo(t *testing.T) {
-    ctrl := gomock.NewController(t)
-    defer ctrl.Finish()
+func TestDeleteAndRestoreProduct_NonNumericID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	mockPVZService := mock.NewMockPVZService(ctrl)
-	mockAuthService := mock.NewMockAuthService(ctrl)
+	// настоящий сервис без репозиториев: нечисловой id не должен дойти до БД
+	pvzService := service.NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+	svc := service.NewService(mock.NewMockAuthService(ctrl), pvzService)
+	controller := NewPVZController(svc, slog.Default())
 
-	service := service.NewService(mockAuthService, mockPVZService)
-    controller := NewPVZController(service, slog.Default())
+	for _, restore := range []bool{false, true} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+		c.Params = gin.Params{{Key: "pvzId", Value: "123"}, {Key: "productId", Value: "abc"}}
+		c.Set("role", "employee")
 
-    tests := []struct {
-        name           string
-        role           string
-        queryParams    map[string]string
-        mockExpect      func()
-        expectedStatus int
-    }{
-        {
-            name: "success with valid params",
-            role: "employee",
-            queryParams: map[string]string{
-                "startDate": "2023-01-01T00:00:00Z",
-                "endDate":   "2023-01-31T00:00:00Z",
-                "page":      "1",
-                "limit":     "10",
-            },
-            mockExpect: func() {
-                mockPVZService.EXPECT().
-                    GetPVZSInfo(gomock.Any(), gomock.Any(), gomock.Any(), 1, 10).
-                    Return([]domain.PvzInfo{}, nil).AnyTimes()
-            },
-            expectedStatus: http.StatusOK,
-        },
-        {
-            name: "missing startDate",
-            role: "employee",
-            queryParams: map[string]string{
-                "endDate": "2023-01-31T00:00:00Z",
-            },
-            mockExpect:      func() {},
-            expectedStatus: http.StatusBadRequest,
-        },
-        {
-            name: "invalid page number",
-            role: "employee",
-            queryParams: map[string]string{
-                "startDate": "2023-01-01T00:00:00Z",
-                "endDate":   "2023-01-31T00:00:00Z",
-                "page":     "invalid",
-            },
-            mockExpect:      func() {},
-            expectedStatus: http.StatusBadRequest,
-        },
-        {
-            name: "service error",
+		if restore {
+			c.Request = httptest.NewRequest(http.MethodPost, "/pvz/123/products/abc/restore", nil)
+			controller.RestoreProduct(c)
+		} else {
+			c.Request = httptest.NewRequest(http.MethodDelete, "/pvz/123/products/abc", nil)
+			controller.DeleteProduct(c)
+		}
+		assert.Equal(t, http.StatusNotFound, w.Code, "restore=%v", restore)
+	}
+}
+
+func TestReceptionTransitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		action         string
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:   "pause",
+			action: "pause",
+			mockExpect: func() {
+				mockPVZService.EXPECT().PauseReception(gomock.Any(), "7").
+					Return(&domain.Reception{ID: "7", PvzID: "123", Status: domain.ReceptionPaused}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "resume invalid transition",
+			action: "resume",
+			mockExpect: func() {
+				mockPVZService.EXPECT().ResumeReception(gomock.Any(), "7").Return(nil, service.ErrInvalidReceptionTransition)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "cancel without body",
+			action: "cancel",
+			mockExpect: func() {
+				mockPVZService.EXPECT().CancelReception(gomock.Any(), "7", "").
+					Return(&domain.Reception{ID: "7", PvzID: "123", Status: domain.ReceptionCancelled}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "cancel invalid body",
+			action:         "cancel",
+			requestBody:    `{"reason": 1}`,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "reopen with reason",
+			action:      "reopen",
+			requestBody: `{"reason": "пропущен товар"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().ReopenReception(gomock.Any(), "7", "пропущен товар").
+					Return(&domain.Reception{ID: "7", PvzID: "123", Status: domain.ReceptionInProgress}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "reopen with another open reception",
+			action: "reopen",
+			mockExpect: func() {
+				mockPVZService.EXPECT().ReopenReception(gomock.Any(), "7", "").Return(nil, service.ErrAlreadyOpen)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "history",
+			action: "history",
+			mockExpect: func() {
+				mockPVZService.EXPECT().GetReceptionHistory(gomock.Any(), "7").Return([]domain.ReceptionStatusChange{
+					{ID: "1", ReceptionID: "7", ToStatus: domain.ReceptionInProgress, Action: domain.ReceptionActionStart},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "history not found",
+			action: "history",
+			mockExpect: func() {
+				mockPVZService.EXPECT().GetReceptionHistory(gomock.Any(), "7").Return(nil, service.ErrReceptionNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	handlers := map[string]gin.HandlerFunc{
+		"pause":   controller.PauseReception,
+		"resume":  controller.ResumeReception,
+		"cancel":  controller.CancelReception,
+		"reopen":  controller.ReopenReception,
+		"history": controller.GetReceptionHistory,
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Params = gin.Params{{Key: "receptionId", Value: "7"}}
+			c.Request = httptest.NewRequest(http.MethodPost, "/receptions/7/"+tt.action, strings.NewReader(tt.requestBody))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("role", "moderator")
+
+			handlers[tt.action](c)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestCreatePvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	mockAuthService := mock.NewMockAuthService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		role           string
+		requestBody    string
+		mockExpect     func()
+		expectedStatus int
+		expectedID     string
+		expectedCity   string
+	}{
+		{
+			name:        "success",
+			role:        "moderator",
+			requestBody: `{"city": "Moscow"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					CreatePVZ(gomock.Any(), "Moscow").
+					DoAndReturn(func(ctx context.Context, city string) (*domain.Pvz, error) {
+						// пользователь нужен для записи аудита pvz_created
+						actor, ok := rbac.ActorFromContext(ctx)
+						assert.True(t, ok)
+						assert.Equal(t, rbac.Actor{UserID: "7", Role: "moderator"}, actor)
+						return &domain.Pvz{ID: "pvz1", City: "Moscow"}, nil
+					})
+			},
+			expectedStatus: http.StatusCreated,
+			expectedID:     "pvz1",
+			expectedCity:   "Moscow",
+		},
+		{
+			name:        "invalid JSON",
+			role:        "moderator",
+			requestBody: `{"city": 123}`,
+			mockExpect:  func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "service error",
+			role:        "moderator",
+			requestBody: `{"city": "Moscow"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					CreatePVZ(gomock.Any(), "Moscow").
+					Return(nil, errors.New("service error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/pvz", strings.NewReader(tt.requestBody))
+			req.Header.Set("Content-Type", "application/json")
+
+			c, _ := gin.CreateTestContext(w)
+			c.Request = req
+			c.Set("role", tt.role)
+			c.Set("user_id", "7")
+
+			controller.CreatePvz(c)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				assert.Contains(t, w.Body.String(), `"id":"`+tt.expectedID+`"`)
+				assert.Contains(t, w.Body.String(), `"city":"`+tt.expectedCity+`"`)
+			}
+		})
+	}
+}
+
+func TestGetPvzInfo(t *testing.T) {
+    ctrl := gomock.NewController(t)
+    defer ctrl.Finish()
+
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	mockAuthService := mock.NewMockAuthService(ctrl)
+
+	svc := service.NewService(mockAuthService, mockPVZService)
+    controller := NewPVZController(svc, slog.Default())
+
+    tests := []struct {
+        name           string
+        role           string
+        queryParams    map[string]string
+        mockExpect      func()
+        expectedStatus int
+    }{
+        {
+            name: "success with valid params",
             role: "employee",
             queryParams: map[string]string{
                 "startDate": "2023-01-01T00:00:00Z",
                 "endDate":   "2023-01-31T00:00:00Z",
+                "page":      "1",
+                "limit":     "10",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{
+                        StartDate: ptr(time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)),
+                        EndDate:   ptr(time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)),
+                        Page:      1,
+                        Limit:     10,
+                    }).
+                    Return(&domain.PvzInfoPage{Page: 1, Limit: 10}, nil)
             },
-            mockExpect: func() {},
+            expectedStatus: http.StatusOK,
+        },
+        {
+            name: "missing startDate",
+            role: "employee",
+            queryParams: map[string]string{
+                "endDate": "2023-01-31T00:00:00Z",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{
+                        EndDate: ptr(time.Date(2023, time.January, 31, 0, 0, 0, 0, time.UTC)),
+                        Page:    1,
+                        Limit:   10,
+                    }).
+                    Return(&domain.PvzInfoPage{Page: 1, Limit: 10}, nil)
+            },
+            expectedStatus: http.StatusOK,
+        },
+        {
+            name: "invalid startDate",
+            role: "employee",
+            queryParams: map[string]string{
+                "startDate": "yesterday",
+            },
+            mockExpect:      func() {},
+            expectedStatus: http.StatusBadRequest,
+        },
+        {
+            name: "limit too large",
+            role: "employee",
+            queryParams: map[string]string{
+                "limit": "31",
+            },
+            mockExpect:      func() {},
+            expectedStatus: http.StatusBadRequest,
+        },
+        {
+            name: "invalid page number",
+            role: "employee",
+            queryParams: map[string]string{
+                "startDate": "2023-01-01T00:00:00Z",
+                "endDate":   "2023-01-31T00:00:00Z",
+                "page":     "invalid",
+            },
+            mockExpect:      func() {},
             expectedStatus: http.StatusBadRequest,
         },
+        {
+            name: "success with cursor",
+            role: "employee",
+            queryParams: map[string]string{
+                "cursor": "cHZ6OjE",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{Page: 1, Limit: 10, Cursor: "cHZ6OjE"}).
+                    Return(&domain.PvzInfoPage{Limit: 10, NextCursor: "cHZ6OjEx"}, nil)
+            },
+            expectedStatus: http.StatusOK,
+        },
+        {
+            name: "success with filters",
+            role: "moderator",
+            queryParams: map[string]string{
+                "city":            "Kazan,Moscow",
+                "pvzId":           "1",
+                "receptionStatus": "closed",
+                "productType":     "электроника",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{
+                        Cities:            []string{"Kazan", "Moscow"},
+                        PvzIDs:            []string{"1"},
+                        ReceptionStatuses: []string{"closed"},
+                        ProductTypes:      []string{"электроника"},
+                        Page:              1,
+                        Limit:             10,
+                    }).
+                    Return(&domain.PvzInfoPage{Page: 1, Limit: 10}, nil)
+            },
+            expectedStatus: http.StatusOK,
+        },
+        {
+            name: "invalid filter",
+            role: "employee",
+            queryParams: map[string]string{
+                "receptionStatus": "lost",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{ReceptionStatuses: []string{"lost"}, Page: 1, Limit: 10}).
+                    Return(nil, service.ErrInvalidFilter)
+            },
+            expectedStatus: http.StatusBadRequest,
+        },
+        {
+            name: "invalid cursor",
+            role: "employee",
+            queryParams: map[string]string{
+                "cursor": "broken",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), domain.PvzInfoQuery{Page: 1, Limit: 10, Cursor: "broken"}).
+                    Return(nil, service.ErrInvalidCursor)
+            },
+            expectedStatus: http.StatusBadRequest,
+        },
+        {
+            name: "service error",
+            role: "employee",
+            queryParams: map[string]string{
+                "startDate": "2023-01-01T00:00:00Z",
+                "endDate":   "2023-01-31T00:00:00Z",
+            },
+            mockExpect: func() {
+                mockPVZService.EXPECT().
+                    GetPVZSInfo(gomock.Any(), gomock.Any()).
+                    Return(nil, errors.New("db error"))
+            },
+            expectedStatus: http.StatusInternalServerError,
+        },
     }
 
     for _, tt := range tests {
@@ -475,4 +990,347 @@ func TestGetPvzInfo(t *testing.T) {
             }
         })
     }
-}
\ No newline at end of file
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestAssignEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		pvzID          string
+		body           string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:  "success",
+			pvzID: "1",
+			body:  `{"userId":"7"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().AssignEmployee(gomock.Any(), "1", "7").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "no userId",
+			pvzID:          "1",
+			body:           `{}`,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "pvz not found",
+			pvzID: "404",
+			body:  `{"userId":"7"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().AssignEmployee(gomock.Any(), "404", "7").Return(service.ErrNoPVZFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "user not found",
+			pvzID: "1",
+			body:  `{"userId":"404"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().AssignEmployee(gomock.Any(), "1", "404").Return(service.ErrUserNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:  "service error",
+			pvzID: "1",
+			body:  `{"userId":"8"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().AssignEmployee(gomock.Any(), "1", "8").Return(errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Params = gin.Params{{Key: "pvzId", Value: tt.pvzID}}
+			c.Request = httptest.NewRequest(http.MethodPost, "/pvz/"+tt.pvzID+"/employees", bytes.NewBufferString(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("role", "moderator")
+
+			controller.AssignEmployee(c)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestUnassignEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		userID         string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:   "success",
+			userID: "7",
+			mockExpect: func() {
+				mockPVZService.EXPECT().UnassignEmployee(gomock.Any(), "1", "7").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "not assigned",
+			userID: "8",
+			mockExpect: func() {
+				mockPVZService.EXPECT().UnassignEmployee(gomock.Any(), "1", "8").Return(service.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "no userId",
+			userID:         "",
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Params = gin.Params{{Key: "pvzId", Value: "1"}, {Key: "userId", Value: tt.userID}}
+			c.Request = httptest.NewRequest(http.MethodDelete, "/pvz/1/employees/"+tt.userID, nil)
+			c.Set("role", "moderator")
+
+			controller.UnassignEmployee(c)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestGetAuditLog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		queryParams    map[string]string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name: "filters",
+			queryParams: map[string]string{
+				"userId": "7",
+				"pvzId":  "1,2",
+				"from":   "2025-05-01T00:00:00Z",
+				"limit":  "5",
+			},
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					GetAuditLog(gomock.Any(), domain.AuditQuery{
+						UserID: "7",
+						PvzIDs: []string{"1", "2"},
+						From:   ptr(time.Date(2025, time.May, 1, 0, 0, 0, 0, time.UTC)),
+						Limit:  5,
+					}).
+					Return(&domain.AuditPage{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid to",
+			queryParams:    map[string]string{"to": "tomorrow"},
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid limit",
+			queryParams:    map[string]string{"limit": "100"},
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:        "invalid cursor",
+			queryParams: map[string]string{"cursor": "bad"},
+			mockExpect: func() {
+				mockPVZService.EXPECT().
+					GetAuditLog(gomock.Any(), domain.AuditQuery{Limit: 10, Cursor: "bad"}).
+					Return(nil, service.ErrInvalidCursor)
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+
+			query := url.Values{}
+			for key, value := range tt.queryParams {
+				query.Set(key, value)
+			}
+			c.Request = httptest.NewRequest(http.MethodGet, "/audit?"+query.Encode(), nil)
+			c.Set("role", "auditor")
+
+			controller.GetAuditLog(c)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
+
+func TestProductTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuthService := mock.NewMockAuthService(ctrl)
+	mockPVZService := mock.NewMockPVZService(ctrl)
+	svc := service.NewService(mockAuthService, mockPVZService)
+	controller := NewPVZController(svc, slog.Default())
+
+	tests := []struct {
+		name           string
+		method         string
+		typeName       string
+		body           string
+		mockExpect     func()
+		expectedStatus int
+	}{
+		{
+			name:   "list",
+			method: http.MethodGet,
+			mockExpect: func() {
+				mockPVZService.EXPECT().ListProductTypes(gomock.Any()).
+					Return([]domain.ProductType{{Name: "обувь"}, {Name: "электроника", Fragile: true}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:   "create",
+			method: http.MethodPost,
+			body:   `{"name":"посуда","fragile":true,"maxWeightGrams":5000}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "посуда", Fragile: true, MaxWeightGrams: 5000}).
+					Return(&domain.ProductType{Name: "посуда", Fragile: true, MaxWeightGrams: 5000}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:   "create duplicate",
+			method: http.MethodPost,
+			body:   `{"name":"обувь"}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "обувь"}).
+					Return(nil, service.ErrAlreadyExists)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+		{
+			name:   "create invalid",
+			method: http.MethodPost,
+			body:   `{"name":"посуда","maxWeightGrams":-1}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "посуда", MaxWeightGrams: -1}).
+					Return(nil, &service.InvalidProductTypeError{Type: "посуда", Reason: "max weight must not be negative"})
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "create invalid json",
+			method:         http.MethodPost,
+			body:           `{"name":1}`,
+			mockExpect:     func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:     "update",
+			method:   http.MethodPut,
+			typeName: "посуда",
+			body:     `{"fragile":false,"maxWeightGrams":3000}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().UpdateProductType(gomock.Any(), domain.ProductType{Name: "посуда", MaxWeightGrams: 3000}).
+					Return(&domain.ProductType{Name: "посуда", MaxWeightGrams: 3000}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "update not found",
+			method:   http.MethodPut,
+			typeName: "книги",
+			body:     `{}`,
+			mockExpect: func() {
+				mockPVZService.EXPECT().UpdateProductType(gomock.Any(), domain.ProductType{Name: "книги"}).
+					Return(nil, service.ErrProductTypeNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:     "delete",
+			method:   http.MethodDelete,
+			typeName: "посуда",
+			mockExpect: func() {
+				mockPVZService.EXPECT().DeleteProductType(gomock.Any(), "посуда").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:     "delete error",
+			method:   http.MethodDelete,
+			typeName: "посуда",
+			mockExpect: func() {
+				mockPVZService.EXPECT().DeleteProductType(gomock.Any(), "посуда").Return(errors.New("db error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+			w := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(w)
+			c.Params = gin.Params{{Key: "name", Value: tt.typeName}}
+			c.Request = httptest.NewRequest(tt.method, "/product_types", bytes.NewBufferString(tt.body))
+			c.Request.Header.Set("Content-Type", "application/json")
+			c.Set("role", "moderator")
+
+			switch tt.method {
+			case http.MethodGet:
+				controller.ListProductTypes(c)
+			case http.MethodPost:
+				controller.CreateProductType(c)
+			case http.MethodPut:
+				controller.UpdateProductType(c)
+			case http.MethodDelete:
+				controller.DeleteProductType(c)
+			}
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package credentials
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode"
+)
+
+// MaxEmailLength - ограничение RFC 5321 на длину адреса.
+const MaxEmailLength = 254
+
+// PasswordPolicy - требования к паролю при регистрации, смене и сбросе.
+// На вход они не действуют: старые пароли продолжают работать после ужесточения политики.
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int // bcrypt учитывает только первые 72 байта, более длинный пароль молча обрезался бы
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// DefaultPasswordPolicy - политика, если в конфиге она не задана.
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength: 8,
+		MaxLength: 72,
+	}
+}
+
+// Validate возвращает ошибку с описанием первого нарушенного требования.
+func (p PasswordPolicy) Validate(password string) error {
+	if strings.TrimSpace(password) == "" {
+		return errors.New("password is empty")
+	}
+
+	if len([]rune(password)) < p.MinLength {
+		return fmt.Errorf("password must be at least %d characters", p.MinLength)
+	}
+
+	if p.MaxLength > 0 && len(password) > p.MaxLength {
+		return fmt.Errorf("password must be at most %d bytes", p.MaxLength)
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	switch {
+	case p.RequireUpper && !upper:
+		return errors.New("password must contain an uppercase letter")
+	case p.RequireLower && !lower:
+		return errors.New("password must contain a lowercase letter")
+	case p.RequireDigit && !digit:
+		return errors.New("password must contain a digit")
+	case p.RequireSymbol && !symbol:
+		return errors.New("password must contain a symbol")
+	}
+
+	return nil
+}
+
+// ValidateEmail принимает только голый адрес вида local@domain.tld, без имени и угловых скобок.
+func ValidateEmail(email string) error {
+	if email == "" {
+		return errors.New("email is empty")
+	}
+
+	if len(email) > MaxEmailLength {
+		return fmt.Errorf("email must be at most %d characters", MaxEmailLength)
+	}
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return errors.New("email is malformed")
+	}
+
+	domain := email[strings.LastIndex(email, "@")+1:]
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return errors.New("email domain is malformed")
+	}
+
+	return nil
+}
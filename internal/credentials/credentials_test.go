@@ -0,0 +1,83 @@
+//go:build unit
+
+package credentials
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateEmail(t *testing.T) {
+	tests := []struct {
+		email string
+		valid bool
+	}{
+		{"user@example.com", true},
+		{"first.last+tag@mail.example.ru", true},
+		{"", false},
+		{"user", false},
+		{"user@localhost", false},
+		{"user@example.", false},
+		{"User <user@example.com>", false},
+		{" user@example.com", false},
+		{"user@@example.com", false},
+		{strings.Repeat("a", 250) + "@example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.email, func(t *testing.T) {
+			err := ValidateEmail(tt.email)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestPasswordPolicy_Validate(t *testing.T) {
+	policy := PasswordPolicy{
+		MinLength:    8,
+		MaxLength:    72,
+		RequireUpper: true,
+		RequireLower: true,
+		RequireDigit: true,
+	}
+
+	tests := []struct {
+		name     string
+		password string
+		errPart  string
+	}{
+		{"valid", "Passw0rdX", ""},
+		{"empty", "", "empty"},
+		{"spaces only", "          ", "empty"},
+		{"short", "Pa1", "at least 8"},
+		{"too long", "Aa1" + strings.Repeat("x", 70), "at most 72"},
+		{"no upper", "passw0rdx", "uppercase"},
+		{"no lower", "PASSW0RDX", "lowercase"},
+		{"no digit", "Passwordx", "digit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password)
+			if tt.errPart == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.errPart)
+		})
+	}
+}
+
+func TestPasswordPolicy_RequireSymbol(t *testing.T) {
+	policy := DefaultPasswordPolicy()
+	policy.RequireSymbol = true
+
+	assert.Error(t, policy.Validate("password1"))
+	assert.NoError(t, policy.Validate("password1!"))
+}
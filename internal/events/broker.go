@@ -0,0 +1,135 @@
+package events
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+)
+
+var (
+	ErrSequenceExpired = errors.New("sequence expired")
+)
+
+// Broker раздает события ПВЗ подписчикам. Каждое событие получает
+// монотонно растущий номер, по которому клиент может продолжить чтение после переподключения.
+type Broker interface {
+	Publish(event domain.PvzEvent) domain.PvzEvent
+	Subscribe(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error)
+}
+
+type subscriber struct {
+	ch     chan domain.PvzEvent
+	pvzIDs []string
+	closed bool
+}
+
+func (s *subscriber) matches(event domain.PvzEvent) bool {
+	return len(s.pvzIDs) == 0 || slices.Contains(s.pvzIDs, event.PvzID)
+}
+
+type broker struct {
+	mu          sync.Mutex
+	logger      *slog.Logger
+	sequence    uint64
+	history     []domain.PvzEvent
+	historySize int
+	bufferSize  int
+	subscribers map[*subscriber]struct{}
+}
+
+// NewBroker создает брокер, который хранит в памяти последние historySize событий
+// для возобновления подписки. bufferSize - размер очереди одного подписчика: если
+// подписчик не успевает читать, его канал закрывается и он должен переподключиться.
+func NewBroker(historySize int, bufferSize int, logger *slog.Logger) Broker {
+	return &broker{
+		logger:      logger,
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		subscribers: make(map[*subscriber]struct{}),
+	}
+}
+
+func (b *broker) Publish(event domain.PvzEvent) domain.PvzEvent {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.sequence++
+	event.Sequence = b.sequence
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.history = b.history[len(b.history)-b.historySize:]
+	}
+
+	for sub := range b.subscribers {
+		if !sub.matches(event) {
+			continue
+		}
+		select {
+		case sub.ch <- event:
+		default:
+			b.logger.Warn("Subscriber is too slow, dropping it", slog.Uint64("sequence", event.Sequence))
+			b.unsubscribe(sub)
+		}
+	}
+
+	return event
+}
+
+func (b *broker) Subscribe(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// номер больше текущего означает, что брокер перезапускался и история потеряна
+	if fromSequence > b.sequence {
+		return nil, ErrSequenceExpired
+	}
+
+	var replay []domain.PvzEvent
+	if fromSequence > 0 && fromSequence < b.sequence {
+		if len(b.history) == 0 || b.history[0].Sequence > fromSequence+1 {
+			return nil, ErrSequenceExpired
+		}
+		for _, event := range b.history {
+			if event.Sequence > fromSequence {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	sub := &subscriber{
+		ch:     make(chan domain.PvzEvent, b.bufferSize+len(replay)),
+		pvzIDs: pvzIDs,
+	}
+
+	for _, event := range replay {
+		if sub.matches(event) {
+			sub.ch <- event
+		}
+	}
+
+	b.subscribers[sub] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(sub)
+	}()
+
+	return sub.ch, nil
+}
+
+// unsubscribe вызывается под b.mu.
+func (b *broker) unsubscribe(sub *subscriber) {
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	delete(b.subscribers, sub)
+	close(sub.ch)
+}
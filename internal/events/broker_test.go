@@ -0,0 +1,94 @@
+//go:build unit
+
+package events
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBroker_PublishSubscribe(t *testing.T) {
+	broker := NewBroker(10, 10, slog.Default())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := broker.Subscribe(ctx, []string{"1"}, 0)
+	require.NoError(t, err)
+
+	broker.Publish(domain.PvzEvent{Type: domain.EventReceptionStarted, PvzID: "2"})
+	published := broker.Publish(domain.PvzEvent{Type: domain.EventProductAdded, PvzID: "1", ProductID: "5"})
+
+	event := <-ch
+	require.Equal(t, published, event)
+	require.Equal(t, uint64(2), event.Sequence)
+	require.Equal(t, "5", event.ProductID)
+}
+
+func TestBroker_Resume(t *testing.T) {
+	broker := NewBroker(10, 10, slog.Default())
+
+	for i := 0; i < 5; i++ {
+		broker.Publish(domain.PvzEvent{Type: domain.EventProductAdded, PvzID: "1"})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := broker.Subscribe(ctx, nil, 3)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(4), (<-ch).Sequence)
+	require.Equal(t, uint64(5), (<-ch).Sequence)
+
+	broker.Publish(domain.PvzEvent{Type: domain.EventProductDeleted, PvzID: "1"})
+	require.Equal(t, uint64(6), (<-ch).Sequence)
+}
+
+func TestBroker_SequenceExpired(t *testing.T) {
+	broker := NewBroker(2, 10, slog.Default())
+
+	for i := 0; i < 5; i++ {
+		broker.Publish(domain.PvzEvent{Type: domain.EventProductAdded, PvzID: "1"})
+	}
+
+	_, err := broker.Subscribe(context.Background(), nil, 1)
+	require.ErrorIs(t, err, ErrSequenceExpired)
+
+	_, err = broker.Subscribe(context.Background(), nil, 100)
+	require.ErrorIs(t, err, ErrSequenceExpired)
+
+	_, err = broker.Subscribe(context.Background(), nil, 3)
+	require.NoError(t, err)
+}
+
+func TestBroker_SlowSubscriberIsDropped(t *testing.T) {
+	broker := NewBroker(10, 1, slog.Default())
+
+	ch, err := broker.Subscribe(context.Background(), nil, 0)
+	require.NoError(t, err)
+
+	broker.Publish(domain.PvzEvent{PvzID: "1"})
+	broker.Publish(domain.PvzEvent{PvzID: "1"})
+
+	<-ch
+	_, ok := <-ch
+	require.False(t, ok)
+}
+
+func TestBroker_UnsubscribeOnCancel(t *testing.T) {
+	broker := NewBroker(10, 10, slog.Default())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := broker.Subscribe(ctx, nil, 0)
+	require.NoError(t, err)
+
+	cancel()
+
+	_, ok := <-ch
+	require.False(t, ok)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/anton/avito-tech-spring/internal/events/broker.go
+//
+// Generated by this command:
+//
+//	mockgen --source=/home/anton/avito-tech-spring/internal/events/broker.go --destination=/home/anton/avito-tech-spring/internal/events/mock/broker.go --package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBroker is a mock of Broker interface.
+type MockBroker struct {
+	ctrl     *gomock.Controller
+	recorder *MockBrokerMockRecorder
+	isgomock struct{}
+}
+
+// MockBrokerMockRecorder is the mock recorder for MockBroker.
+type MockBrokerMockRecorder struct {
+	mock *MockBroker
+}
+
+// NewMockBroker creates a new mock instance.
+func NewMockBroker(ctrl *gomock.Controller) *MockBroker {
+	mock := &MockBroker{ctrl: ctrl}
+	mock.recorder = &MockBrokerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBroker) EXPECT() *MockBrokerMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockBroker) Publish(event domain.PvzEvent) domain.PvzEvent {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", event)
+	ret0, _ := ret[0].(domain.PvzEvent)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockBrokerMockRecorder) Publish(event any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockBroker)(nil).Publish), event)
+}
+
+// Subscribe mocks base method.
+func (m *MockBroker) Subscribe(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, pvzIDs, fromSequence)
+	ret0, _ := ret[0].(<-chan domain.PvzEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockBrokerMockRecorder) Subscribe(ctx, pvzIDs, fromSequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockBroker)(nil).Subscribe), ctx, pvzIDs, fromSequence)
+}
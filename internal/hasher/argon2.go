@@ -0,0 +1,125 @@
+package hasher
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Параметры по умолчанию - второй рекомендованный вариант из RFC 9106.
+const (
+	DefaultArgon2Time       = 3
+	DefaultArgon2Memory     = 64 * 1024 // в КиБ
+	DefaultArgon2Threads    = 4
+	DefaultArgon2KeyLength  = 32
+	DefaultArgon2SaltLength = 16
+)
+
+var errInvalidArgon2Hash = errors.New("invalid argon2id hash")
+
+type Argon2Params struct {
+	Time       uint32
+	Memory     uint32 // в КиБ
+	Threads    uint8
+	KeyLength  uint32
+	SaltLength uint32
+}
+
+type argon2Hasher struct {
+	params Argon2Params
+}
+
+func newArgon2Hasher(params Argon2Params) argon2Hasher {
+	if params.Time == 0 {
+		params.Time = DefaultArgon2Time
+	}
+	if params.Memory == 0 {
+		params.Memory = DefaultArgon2Memory
+	}
+	if params.Threads == 0 {
+		params.Threads = DefaultArgon2Threads
+	}
+	if params.KeyLength == 0 {
+		params.KeyLength = DefaultArgon2KeyLength
+	}
+	if params.SaltLength == 0 {
+		params.SaltLength = DefaultArgon2SaltLength
+	}
+	return argon2Hasher{params: params}
+}
+
+// hash возвращает хеш в формате PHC: $argon2id$v=19$m=65536,t=3,p=4$<соль>$<ключ>.
+func (a argon2Hasher) hash(password string) (string, error) {
+	salt := make([]byte, a.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, a.params.Time, a.params.Memory, a.params.Threads, a.params.KeyLength)
+
+	return fmt.Sprintf("$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		AlgorithmArgon2id, argon2.Version,
+		a.params.Memory, a.params.Time, a.params.Threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (a argon2Hasher) equal(hashedPassword string, password string) bool {
+	params, salt, key, err := decodeArgon2Hash(hashedPassword)
+	if err != nil {
+		return false
+	}
+
+	other := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, params.KeyLength)
+	return subtle.ConstantTimeCompare(key, other) == 1
+}
+
+func (a argon2Hasher) outdated(hashedPassword string) bool {
+	params, _, _, err := decodeArgon2Hash(hashedPassword)
+	if err != nil {
+		return true
+	}
+	return params != a.params
+}
+
+func decodeArgon2Hash(hashedPassword string) (Argon2Params, []byte, []byte, error) {
+	parts := strings.Split(hashedPassword, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return Argon2Params{}, nil, nil, errInvalidArgon2Hash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Argon2Params{}, nil, nil, errInvalidArgon2Hash
+	}
+
+	var params Argon2Params
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads); err != nil {
+		return Argon2Params{}, nil, nil, errInvalidArgon2Hash
+	}
+	// argon2.IDKey паникует при нулевом времени или числе потоков
+	if params.Time == 0 || params.Threads == 0 {
+		return Argon2Params{}, nil, nil, errInvalidArgon2Hash
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil || len(salt) == 0 {
+		return Argon2Params{}, nil, nil, errInvalidArgon2Hash
+	}
+
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Argon2Params{}, nil, nil, errInvalidArgon2Hash
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
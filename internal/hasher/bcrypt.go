@@ -0,0 +1,44 @@
+package hasher
+
+import (
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type bcryptHasher struct {
+	cost int
+}
+
+func newBcryptHasher(cost int) (bcryptHasher, error) {
+	if cost == 0 {
+		cost = bcrypt.DefaultCost
+	}
+
+	if cost < bcrypt.MinCost || cost > bcrypt.MaxCost {
+		return bcryptHasher{}, fmt.Errorf("bcrypt cost must be between %d and %d, got %d", bcrypt.MinCost, bcrypt.MaxCost, cost)
+	}
+
+	return bcryptHasher{cost: cost}, nil
+}
+
+func (b bcryptHasher) hash(password string) (string, error) {
+	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), b.cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashedBytes), nil
+}
+
+func (b bcryptHasher) equal(hashedPassword string, password string) bool {
+	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
+	return err == nil
+}
+
+func (b bcryptHasher) outdated(hashedPassword string) bool {
+	cost, err := bcrypt.Cost([]byte(hashedPassword))
+	if err != nil {
+		return true
+	}
+	return cost != b.cost
+}
@@ -1,27 +1,79 @@
 package hasher
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
 
 type Hasher interface {
 	Hash(password string) (string, error)
 	Equal(hashedPassword string, password string) bool
+	// NeedsRehash сообщает, что хеш посчитан другим алгоритмом или с устаревшими параметрами.
+	NeedsRehash(hashedPassword string) bool
 }
 
-type hasher struct {}
+// Config задает алгоритм для новых хешей и его параметры.
+// Проверять Equal умеет хеши любого поддерживаемого алгоритма, алгоритм определяется по префиксу хеша.
+type Config struct {
+	Algorithm  string // bcrypt, argon2id; по умолчанию bcrypt
+	BcryptCost int
+	Argon2     Argon2Params
+}
 
-func NewHasher() Hasher {
-	return &hasher{}
+type hasher struct {
+	algorithm string
+	bcrypt    bcryptHasher
+	argon2    argon2Hasher
 }
 
-func (h *hasher) Hash(password string) (string, error) {
-	hashedBytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+func NewHasher(cfg Config) (Hasher, error) {
+	algorithm := cfg.Algorithm
+	if algorithm == "" {
+		algorithm = AlgorithmBcrypt
+	}
+
+	if algorithm != AlgorithmBcrypt && algorithm != AlgorithmArgon2id {
+		return nil, fmt.Errorf("unknown password hashing algorithm %q", cfg.Algorithm)
+	}
+
+	bcryptHasher, err := newBcryptHasher(cfg.BcryptCost)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	return &hasher{
+		algorithm: algorithm,
+		bcrypt:    bcryptHasher,
+		argon2:    newArgon2Hasher(cfg.Argon2),
+	}, nil
+}
+
+func (h *hasher) Hash(password string) (string, error) {
+	if h.algorithm == AlgorithmArgon2id {
+		return h.argon2.hash(password)
 	}
-	return string(hashedBytes), nil
+	return h.bcrypt.hash(password)
 }
 
 func (h *hasher) Equal(hashedPassword string, password string) bool {
-	err := bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password))
-	return err == nil
+	if isArgon2Hash(hashedPassword) {
+		return h.argon2.equal(hashedPassword, password)
+	}
+	return h.bcrypt.equal(hashedPassword, password)
+}
+
+func (h *hasher) NeedsRehash(hashedPassword string) bool {
+	if isArgon2Hash(hashedPassword) {
+		return h.algorithm != AlgorithmArgon2id || h.argon2.outdated(hashedPassword)
+	}
+	return h.algorithm != AlgorithmBcrypt || h.bcrypt.outdated(hashedPassword)
+}
+
+func isArgon2Hash(hashedPassword string) bool {
+	return strings.HasPrefix(hashedPassword, "$"+AlgorithmArgon2id+"$")
 }
@@ -4,13 +4,14 @@ package hasher
 
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
 func TestHasher_HashAndEqual_Success(t *testing.T) {
-	h := NewHasher()
+	h := newTestHasher(t, Config{})
 	password := "myStrongPassword123"
 
 	hashed, err := h.Hash(password)
@@ -22,7 +23,7 @@ func TestHasher_HashAndEqual_Success(t *testing.T) {
 }
 
 func TestHasher_Equal_InvalidPassword(t *testing.T) {
-	h := NewHasher()
+	h := newTestHasher(t, Config{})
 
 	password := "correctPassword"
 	wrongPassword := "wrongPassword"
@@ -35,7 +36,7 @@ func TestHasher_Equal_InvalidPassword(t *testing.T) {
 }
 
 func TestHasher_Equal_InvalidHashFormat(t *testing.T) {
-	h := NewHasher()
+	h := newTestHasher(t, Config{})
 
 	invalidHash := "this_is_not_a_valid_bcrypt_hash"
 	password := "anyPassword"
@@ -45,7 +46,7 @@ func TestHasher_Equal_InvalidHashFormat(t *testing.T) {
 }
 
 func TestHasher_Hash_ErrorHandling(t *testing.T) {
-	h := NewHasher()
+	h := newTestHasher(t, Config{})
 
 	hashed, err := h.Hash("")
 	assert.NoError(t, err)
@@ -55,4 +56,98 @@ func TestHasher_Hash_ErrorHandling(t *testing.T) {
 	assert.True(t, match)
 }
 
+func newTestHasher(t *testing.T, cfg Config) Hasher {
+	t.Helper()
+	h, err := NewHasher(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h
+}
+
+// быстрые параметры, чтобы тесты не тратили 64 МиБ на каждый хеш
+var testArgon2Params = Argon2Params{Time: 1, Memory: 1024, Threads: 1}
+
+func TestHasher_Argon2id_HashAndEqual(t *testing.T) {
+	h := newTestHasher(t, Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params})
+
+	hashed, err := h.Hash("myStrongPassword123")
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hashed, "$argon2id$v=19$m=1024,t=1,p=1$"))
+
+	assert.True(t, h.Equal(hashed, "myStrongPassword123"))
+	assert.False(t, h.Equal(hashed, "wrongPassword"))
+	assert.False(t, h.NeedsRehash(hashed))
+
+	other, err := h.Hash("myStrongPassword123")
+	assert.NoError(t, err)
+	assert.NotEqual(t, hashed, other, "salt must be random")
+}
+
+func TestHasher_Equal_AcceptsBothAlgorithms(t *testing.T) {
+	bcryptHasher := newTestHasher(t, Config{Algorithm: AlgorithmBcrypt, BcryptCost: 4})
+	argon2Hasher := newTestHasher(t, Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params})
+
+	bcryptHash, err := bcryptHasher.Hash("password")
+	assert.NoError(t, err)
+	argon2Hash, err := argon2Hasher.Hash("password")
+	assert.NoError(t, err)
 
+	assert.True(t, argon2Hasher.Equal(bcryptHash, "password"))
+	assert.True(t, bcryptHasher.Equal(argon2Hash, "password"))
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	oldBcrypt := newTestHasher(t, Config{Algorithm: AlgorithmBcrypt, BcryptCost: 4})
+	bcryptHash, err := oldBcrypt.Hash("password")
+	assert.NoError(t, err)
+
+	oldArgon2 := newTestHasher(t, Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params})
+	argon2Hash, err := oldArgon2.Hash("password")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		cfg    Config
+		hash   string
+		rehash bool
+	}{
+		{"bcrypt same cost", Config{BcryptCost: 4}, bcryptHash, false},
+		{"bcrypt higher cost", Config{BcryptCost: 5}, bcryptHash, true},
+		{"bcrypt to argon2id", Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params}, bcryptHash, true},
+		{"argon2id same params", Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params}, argon2Hash, false},
+		{"argon2id more memory", Config{Algorithm: AlgorithmArgon2id, Argon2: Argon2Params{Time: 1, Memory: 2048, Threads: 1}}, argon2Hash, true},
+		{"argon2id longer key", Config{Algorithm: AlgorithmArgon2id, Argon2: Argon2Params{Time: 1, Memory: 1024, Threads: 1, KeyLength: 64}}, argon2Hash, true},
+		{"argon2id to bcrypt", Config{Algorithm: AlgorithmBcrypt, BcryptCost: 4}, argon2Hash, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := newTestHasher(t, tt.cfg)
+			assert.Equal(t, tt.rehash, h.NeedsRehash(tt.hash))
+		})
+	}
+}
+
+func TestHasher_Argon2id_InvalidHash(t *testing.T) {
+	h := newTestHasher(t, Config{Algorithm: AlgorithmArgon2id, Argon2: testArgon2Params})
+
+	for _, hash := range []string{
+		"$argon2id$",
+		"$argon2id$v=19$m=1024,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=0$c2FsdA$a2V5",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$!!!$a2V5",
+	} {
+		assert.False(t, h.Equal(hash, "password"), hash)
+		assert.True(t, h.NeedsRehash(hash), hash)
+	}
+}
+
+func TestNewHasher_InvalidConfig(t *testing.T) {
+	_, err := NewHasher(Config{Algorithm: "md5"})
+	assert.Error(t, err)
+
+	_, err = NewHasher(Config{BcryptCost: 100})
+	assert.Error(t, err)
+}
@@ -0,0 +1,150 @@
+package limiter
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/metrics"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+)
+
+// LoginLimiter защищает вход от перебора паролей. Неудачные попытки считаются отдельно
+// по email и по IP, после MaxAttempts неудач ключ блокируется, и каждая следующая неудача
+// удваивает блокировку.
+type LoginLimiter interface {
+	// Check возвращает, сколько осталось ждать до следующей попытки. 0 - вход разрешен.
+	Check(ctx context.Context, email string, ip string) (time.Duration, error)
+	// Failure учитывает неудачную попытку и возвращает блокировку, если попытка к ней привела.
+	Failure(ctx context.Context, email string, ip string) (time.Duration, error)
+	// Success сбрасывает счетчик email. Счетчик IP не сбрасывается: иначе, зная пароль
+	// от своего аккаунта, можно было бы перебирать чужие с одного адреса.
+	Success(ctx context.Context, email string) error
+}
+
+type Config struct {
+	MaxEmailAttempts int           // неудач подряд на один email до блокировки
+	MaxIPAttempts    int           // неудач с одного IP до блокировки, 0 - без ограничения по IP
+	Window           time.Duration // через сколько после последней неудачи и конца блокировки счетчик обнуляется
+	BaseLockout      time.Duration // первая блокировка
+	MaxLockout       time.Duration // потолок для удвоения
+}
+
+const (
+	DefaultMaxEmailAttempts = 5
+	DefaultWindow           = 15 * time.Minute
+	DefaultBaseLockout      = time.Minute
+	DefaultMaxLockout       = time.Hour
+)
+
+type loginLimiter struct {
+	store  repository.LoginAttemptRepository
+	cfg    Config
+	now    func() time.Time
+	logger *slog.Logger
+}
+
+func NewLoginLimiter(store repository.LoginAttemptRepository, cfg Config, logger *slog.Logger) LoginLimiter {
+	if cfg.MaxEmailAttempts <= 0 {
+		cfg.MaxEmailAttempts = DefaultMaxEmailAttempts
+	}
+	if cfg.Window <= 0 {
+		cfg.Window = DefaultWindow
+	}
+	if cfg.BaseLockout <= 0 {
+		cfg.BaseLockout = DefaultBaseLockout
+	}
+	if cfg.MaxLockout < cfg.BaseLockout {
+		cfg.MaxLockout = max(DefaultMaxLockout, cfg.BaseLockout)
+	}
+
+	return &loginLimiter{
+		store:  store,
+		cfg:    cfg,
+		now:    time.Now,
+		logger: logger,
+	}
+}
+
+func (l *loginLimiter) Check(ctx context.Context, email string, ip string) (time.Duration, error) {
+	now := l.now()
+
+	var wait time.Duration
+	for _, key := range l.keys(email, ip) {
+		lockedUntil, err := l.store.LockedUntil(ctx, key.name)
+		if err != nil {
+			return 0, err
+		}
+		wait = max(wait, lockedUntil.Sub(now))
+	}
+
+	return wait, nil
+}
+
+func (l *loginLimiter) Failure(ctx context.Context, email string, ip string) (time.Duration, error) {
+	now := l.now()
+
+	var lockout time.Duration
+	for _, key := range l.keys(email, ip) {
+		failures, err := l.store.AddFailure(ctx, key.name, now, l.cfg.Window)
+		if err != nil {
+			return 0, err
+		}
+
+		keyLockout := l.lockout(failures, key.maxAttempts)
+		if keyLockout == 0 {
+			continue
+		}
+
+		if err := l.store.Lock(ctx, key.name, now.Add(keyLockout)); err != nil {
+			return 0, err
+		}
+		metrics.LoginLockoutsTotal.WithLabelValues(key.scope).Inc()
+		l.logger.Warn("Login locked",
+			slog.String("scope", key.scope),
+			slog.Int("failures", failures),
+			slog.Duration("lockout", keyLockout))
+
+		lockout = max(lockout, keyLockout)
+	}
+
+	return lockout, nil
+}
+
+func (l *loginLimiter) Success(ctx context.Context, email string) error {
+	return l.store.Reset(ctx, emailKey(email))
+}
+
+// lockout - блокировка после failures неудач: BaseLockout на пороге, дальше удвоение до MaxLockout.
+func (l *loginLimiter) lockout(failures int, maxAttempts int) time.Duration {
+	if maxAttempts <= 0 || failures < maxAttempts {
+		return 0
+	}
+
+	lockout := l.cfg.BaseLockout
+	for i := maxAttempts; i < failures && lockout < l.cfg.MaxLockout; i++ {
+		lockout *= 2
+	}
+
+	return min(lockout, l.cfg.MaxLockout)
+}
+
+type limitKey struct {
+	name        string
+	scope       string
+	maxAttempts int
+}
+
+func (l *loginLimiter) keys(email string, ip string) []limitKey {
+	keys := []limitKey{{name: emailKey(email), scope: "email", maxAttempts: l.cfg.MaxEmailAttempts}}
+	if ip != "" && l.cfg.MaxIPAttempts > 0 {
+		keys = append(keys, limitKey{name: "ip:" + ip, scope: "ip", maxAttempts: l.cfg.MaxIPAttempts})
+	}
+	return keys
+}
+
+// emailKey не различает регистр, иначе блокировку можно обойти, меняя регистр букв в адресе.
+func emailKey(email string) string {
+	return "email:" + strings.ToLower(strings.TrimSpace(email))
+}
@@ -0,0 +1,132 @@
+//go:build unit
+
+package limiter
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLimiter(cfg Config) (*loginLimiter, *time.Time) {
+	now := time.Date(2025, 5, 5, 9, 0, 0, 0, time.UTC)
+	l := NewLoginLimiter(NewMemoryStore(), cfg, slog.Default()).(*loginLimiter)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLoginLimiter_LocksAfterMaxAttempts(t *testing.T) {
+	l, _ := newTestLimiter(Config{MaxEmailAttempts: 3, BaseLockout: time.Minute, MaxLockout: time.Hour})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		lockout, err := l.Failure(ctx, "user@example.com", "")
+		require.NoError(t, err)
+		assert.Zero(t, lockout)
+	}
+
+	wait, err := l.Check(ctx, "user@example.com", "")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+
+	lockout, err := l.Failure(ctx, "user@example.com", "")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, lockout)
+
+	// регистр email не влияет на блокировку
+	wait, err = l.Check(ctx, "USER@example.com", "")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait)
+}
+
+func TestLoginLimiter_ExponentialBackoff(t *testing.T) {
+	l, now := newTestLimiter(Config{MaxEmailAttempts: 1, BaseLockout: time.Minute, MaxLockout: 5 * time.Minute, Window: time.Hour})
+	ctx := context.Background()
+
+	expected := []time.Duration{time.Minute, 2 * time.Minute, 4 * time.Minute, 5 * time.Minute, 5 * time.Minute}
+	for _, want := range expected {
+		lockout, err := l.Failure(ctx, "user@example.com", "")
+		require.NoError(t, err)
+		assert.Equal(t, want, lockout)
+
+		*now = now.Add(lockout)
+	}
+}
+
+func TestLoginLimiter_WindowResetsCounter(t *testing.T) {
+	l, now := newTestLimiter(Config{MaxEmailAttempts: 2, BaseLockout: time.Minute, MaxLockout: time.Hour, Window: 10 * time.Minute})
+	ctx := context.Background()
+
+	_, err := l.Failure(ctx, "user@example.com", "")
+	require.NoError(t, err)
+
+	*now = now.Add(11 * time.Minute)
+
+	lockout, err := l.Failure(ctx, "user@example.com", "")
+	require.NoError(t, err)
+	assert.Zero(t, lockout)
+}
+
+func TestLoginLimiter_IPLimit(t *testing.T) {
+	l, _ := newTestLimiter(Config{MaxEmailAttempts: 10, MaxIPAttempts: 2, BaseLockout: time.Minute, MaxLockout: time.Hour})
+	ctx := context.Background()
+
+	_, err := l.Failure(ctx, "first@example.com", "10.0.0.1")
+	require.NoError(t, err)
+	lockout, err := l.Failure(ctx, "second@example.com", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, lockout)
+
+	// с заблокированного IP нельзя войти ни в один аккаунт
+	wait, err := l.Check(ctx, "third@example.com", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait)
+
+	wait, err = l.Check(ctx, "third@example.com", "10.0.0.2")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestLoginLimiter_SuccessResetsOnlyEmail(t *testing.T) {
+	l, _ := newTestLimiter(Config{MaxEmailAttempts: 2, MaxIPAttempts: 2, BaseLockout: time.Minute, MaxLockout: time.Hour})
+	ctx := context.Background()
+
+	_, err := l.Failure(ctx, "user@example.com", "10.0.0.1")
+	require.NoError(t, err)
+
+	require.NoError(t, l.Success(ctx, "user@example.com"))
+
+	lockout, err := l.Failure(ctx, "user@example.com", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, lockout)
+
+	wait, err := l.Check(ctx, "other@example.com", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait)
+}
+
+func TestMemoryStore_DeleteStale(t *testing.T) {
+	store := NewMemoryStore()
+	ctx := context.Background()
+	now := time.Now()
+
+	_, err := store.AddFailure(ctx, "email:old@example.com", now.Add(-time.Hour), time.Minute)
+	require.NoError(t, err)
+	_, err = store.AddFailure(ctx, "email:locked@example.com", now.Add(-time.Hour), time.Minute)
+	require.NoError(t, err)
+	require.NoError(t, store.Lock(ctx, "email:locked@example.com", now.Add(time.Hour)))
+	_, err = store.AddFailure(ctx, "email:fresh@example.com", now, time.Minute)
+	require.NoError(t, err)
+
+	deleted, err := store.DeleteStale(ctx, now.Add(-time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+
+	lockedUntil, err := store.LockedUntil(ctx, "email:locked@example.com")
+	require.NoError(t, err)
+	assert.True(t, lockedUntil.After(now))
+}
@@ -0,0 +1,90 @@
+package limiter
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+)
+
+type attempt struct {
+	failures      int
+	lastFailureAt time.Time
+	lockedUntil   time.Time
+}
+
+// memoryStore - хранилище попыток в памяти процесса. Подходит для одного экземпляра
+// сервиса, при нескольких экземплярах счетчики нужно держать в Postgres.
+type memoryStore struct {
+	mu       sync.Mutex
+	attempts map[string]*attempt
+}
+
+func NewMemoryStore() repository.LoginAttemptRepository {
+	return &memoryStore{
+		attempts: make(map[string]*attempt),
+	}
+}
+
+func (m *memoryStore) AddFailure(ctx context.Context, key string, now time.Time, window time.Duration) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	a, ok := m.attempts[key]
+	if !ok || a.stale(now.Add(-window)) {
+		a = &attempt{}
+		m.attempts[key] = a
+	}
+
+	a.failures++
+	a.lastFailureAt = now
+
+	return a.failures, nil
+}
+
+func (m *memoryStore) Lock(ctx context.Context, key string, until time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if a, ok := m.attempts[key]; ok && until.After(a.lockedUntil) {
+		a.lockedUntil = until
+	}
+	return nil
+}
+
+func (m *memoryStore) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if a, ok := m.attempts[key]; ok {
+		return a.lockedUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+func (m *memoryStore) Reset(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.attempts, key)
+	return nil
+}
+
+func (m *memoryStore) DeleteStale(ctx context.Context, before time.Time) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var deleted int64
+	for key, a := range m.attempts {
+		if a.stale(before) {
+			delete(m.attempts, key)
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+func (a *attempt) stale(before time.Time) bool {
+	return a.lastFailureAt.Before(before) && a.lockedUntil.Before(before)
+}
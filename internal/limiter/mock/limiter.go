@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/anton/avito-tech-spring/internal/limiter/limiter.go
+//
+// Generated by this command:
+//
+//	mockgen --source=/home/anton/avito-tech-spring/internal/limiter/limiter.go --destination=/home/anton/avito-tech-spring/internal/limiter/mock/limiter.go --package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLoginLimiter is a mock of LoginLimiter interface.
+type MockLoginLimiter struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginLimiterMockRecorder
+	isgomock struct{}
+}
+
+// MockLoginLimiterMockRecorder is the mock recorder for MockLoginLimiter.
+type MockLoginLimiterMockRecorder struct {
+	mock *MockLoginLimiter
+}
+
+// NewMockLoginLimiter creates a new mock instance.
+func NewMockLoginLimiter(ctrl *gomock.Controller) *MockLoginLimiter {
+	mock := &MockLoginLimiter{ctrl: ctrl}
+	mock.recorder = &MockLoginLimiterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginLimiter) EXPECT() *MockLoginLimiterMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockLoginLimiter) Check(ctx context.Context, email, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx, email, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockLoginLimiterMockRecorder) Check(ctx, email, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockLoginLimiter)(nil).Check), ctx, email, ip)
+}
+
+// Failure mocks base method.
+func (m *MockLoginLimiter) Failure(ctx context.Context, email, ip string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Failure", ctx, email, ip)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Failure indicates an expected call of Failure.
+func (mr *MockLoginLimiterMockRecorder) Failure(ctx, email, ip any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Failure", reflect.TypeOf((*MockLoginLimiter)(nil).Failure), ctx, email, ip)
+}
+
+// Success mocks base method.
+func (m *MockLoginLimiter) Success(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Success", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Success indicates an expected call of Success.
+func (mr *MockLoginLimiterMockRecorder) Success(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Success", reflect.TypeOf((*MockLoginLimiter)(nil).Success), ctx, email)
+}
@@ -4,7 +4,9 @@ import "github.com/prometheus/client_golang/prometheus"
 
 func init() {
 	prometheus.MustRegister(HttpResponseTime, OrderReceptionsCreatedTotal,
-		PvzCreatedTotal, RequestsTotal, ProductsAddedTotal)
+		PvzCreatedTotal, RequestsTotal, ProductsAddedTotal,
+		GRPCRequestDuration, GRPCRequestsTotal,
+		LoginFailuresTotal, LoginLockoutsTotal)
 }
 
 var (
@@ -24,6 +26,39 @@ var (
 		[]string{"method", "path"},
 	)
 
+	GRPCRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "grpc_request_duration_seconds",
+			Help:    "Длительность gRPC запросов по методам",
+			Buckets: []float64{0.005, 0.01, 0.05, 0.1, 0.3, 0.5, 1, 2, 5},
+		},
+		[]string{"method"},
+	)
+
+	GRPCRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "grpc_requests_total",
+			Help: "Кол-во gRPC запросов по методам и статус кодам",
+		},
+		[]string{"method", "code"},
+	)
+
+	LoginFailuresTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_failures_total",
+			Help: "Кол-во неудачных входов: invalid_credentials - неверный email или пароль, locked - попытка во время блокировки",
+		},
+		[]string{"reason"},
+	)
+
+	LoginLockoutsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "auth_login_lockouts_total",
+			Help: "Кол-во блокировок входа по email и по IP",
+		},
+		[]string{"scope"},
+	)
+
 	PvzCreatedTotal = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Name: "business_pvz_created_total",
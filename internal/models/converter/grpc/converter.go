@@ -19,4 +19,203 @@ func FromDomainPvzListToGRPCList(pvzs []domain.Pvz) []*pvz_v1.PVZ {
 		})
 	}
 	return response
-}
\ No newline at end of file
+}
+
+func FromDomainPvzToGRPC(pvz *domain.Pvz) *pvz_v1.PVZ {
+	return &pvz_v1.PVZ{
+		Id: pvz.ID,
+		City: pvz.City,
+		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
+	}
+}
+
+
+func FromDomainReceptionToGRPC(reception *domain.Reception) *pvz_v1.Reception {
+	return &pvz_v1.Reception{
+		Id: reception.ID,
+		DateTime: timestamppb.New(reception.DateTime),
+		PvzId: reception.PvzID,
+		Status: FromDomainReceptionStatusToGRPC(reception.Status),
+	}
+}
+
+
+var receptionStatuses = map[string]pvz_v1.ReceptionStatus{
+	domain.ReceptionInProgress: pvz_v1.ReceptionStatus_RECEPTION_STATUS_IN_PROGRESS,
+	domain.ReceptionPaused:     pvz_v1.ReceptionStatus_RECEPTION_STATUS_PAUSED,
+	domain.ReceptionClosed:     pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED,
+	domain.ReceptionCancelled:  pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED,
+}
+
+
+func FromDomainReceptionStatusToGRPC(status string) pvz_v1.ReceptionStatus {
+	return receptionStatuses[status]
+}
+
+// FromGRPCReceptionStatusesToDomain возвращает nil для пустого списка, чтобы фильтр не применялся.
+func FromGRPCReceptionStatusesToDomain(statuses []pvz_v1.ReceptionStatus) []string {
+	var result []string
+	for _, status := range statuses {
+		switch status {
+		case pvz_v1.ReceptionStatus_RECEPTION_STATUS_PAUSED:
+			result = append(result, domain.ReceptionPaused)
+		case pvz_v1.ReceptionStatus_RECEPTION_STATUS_CLOSED:
+			result = append(result, domain.ReceptionClosed)
+		case pvz_v1.ReceptionStatus_RECEPTION_STATUS_CANCELLED:
+			result = append(result, domain.ReceptionCancelled)
+		default:
+			result = append(result, domain.ReceptionInProgress)
+		}
+	}
+	return result
+}
+
+
+func FromDomainReceptionHistoryToGRPC(history []domain.ReceptionStatusChange) []*pvz_v1.ReceptionStatusChange {
+	response := make([]*pvz_v1.ReceptionStatusChange, 0, len(history))
+	for _, change := range history {
+		response = append(response, &pvz_v1.ReceptionStatusChange{
+			Id:          change.ID,
+			ReceptionId: change.ReceptionID,
+			FromStatus:  change.FromStatus,
+			ToStatus:    change.ToStatus,
+			Action:      change.Action,
+			Reason:      change.Reason,
+			UserId:      change.UserID,
+			Role:        change.Role,
+			ChangedAt:   timestamppb.New(change.ChangedAt),
+		})
+	}
+	return response
+}
+
+
+func FromDomainProductToGRPC(product *domain.Product) *pvz_v1.Product {
+	return &pvz_v1.Product{
+		Id: product.ID,
+		DateTime: timestamppb.New(product.DateTime),
+		Type: product.Type,
+		ReceptionId: product.ReceptionID,
+		Barcode: product.Barcode,
+		OrderId: product.OrderID,
+	}
+}
+
+func FromDomainProductsToGRPC(products []domain.Product) []*pvz_v1.Product {
+	result := make([]*pvz_v1.Product, 0, len(products))
+	for i := range products {
+		result = append(result, FromDomainProductToGRPC(&products[i]))
+	}
+	return result
+}
+
+
+func FromDomainPvzInfoListToGRPCList(pvzsInfo []domain.PvzInfo) []*pvz_v1.PVZInfo {
+
+	var response []*pvz_v1.PVZInfo
+
+	for _, pvzInfo := range pvzsInfo {
+		var receptions []*pvz_v1.ReceptionInfo
+		for _, receptionInfo := range pvzInfo.Receptions {
+			var products []*pvz_v1.Product
+			for _, product := range receptionInfo.Products {
+				products = append(products, FromDomainProductToGRPC(&product))
+			}
+			receptions = append(receptions, &pvz_v1.ReceptionInfo{
+				Reception: FromDomainReceptionToGRPC(&receptionInfo.Reception),
+				Products: products,
+			})
+		}
+		response = append(response, &pvz_v1.PVZInfo{
+			Pvz: FromDomainPvzToGRPC(&pvzInfo.Pvz),
+			Receptions: receptions,
+		})
+	}
+	return response
+}
+
+
+var eventTypes = map[string]pvz_v1.PVZEventType{
+	domain.EventReceptionStarted:   pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_STARTED,
+	domain.EventReceptionClosed:    pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CLOSED,
+	domain.EventReceptionPaused:    pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_PAUSED,
+	domain.EventReceptionResumed:   pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_RESUMED,
+	domain.EventReceptionCancelled: pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_CANCELLED,
+	domain.EventReceptionReopened:  pvz_v1.PVZEventType_PVZ_EVENT_TYPE_RECEPTION_REOPENED,
+	domain.EventProductAdded:       pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_ADDED,
+	domain.EventProductDeleted:     pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_DELETED,
+	domain.EventProductRestored:    pvz_v1.PVZEventType_PVZ_EVENT_TYPE_PRODUCT_RESTORED,
+}
+
+
+func FromDomainPvzEventToGRPC(event domain.PvzEvent) *pvz_v1.PVZEvent {
+	return &pvz_v1.PVZEvent{
+		Sequence: event.Sequence,
+		Type: eventTypes[event.Type],
+		PvzId: event.PvzID,
+		ReceptionId: event.ReceptionID,
+		ProductId: event.ProductID,
+		OccurredAt: timestamppb.New(event.OccurredAt),
+	}
+}
+
+
+func FromDomainAuditEntriesToGRPC(entries []domain.AuditEntry) []*pvz_v1.AuditEntry {
+	response := make([]*pvz_v1.AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		response = append(response, &pvz_v1.AuditEntry{
+			Id:           entry.ID,
+			UserId:       entry.UserID,
+			Role:         entry.Role,
+			Dummy:        entry.Dummy,
+			Action:       entry.Action,
+			PvzId:        entry.PvzID,
+			ReceptionId:  entry.ReceptionID,
+			ProductId:    entry.ProductID,
+			TargetUserId: entry.TargetUserID,
+			CreatedAt:    timestamppb.New(entry.CreatedAt),
+		})
+	}
+	return response
+}
+
+
+func FromDomainUserToGRPC(user *domain.User) *pvz_v1.User {
+	return &pvz_v1.User{
+		Id:        user.ID,
+		Email:     user.Email,
+		Role:      user.Role,
+		Disabled:  user.Disabled,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+	}
+}
+
+
+func FromDomainUsersToGRPC(users []domain.User) []*pvz_v1.User {
+	response := make([]*pvz_v1.User, 0, len(users))
+	for i := range users {
+		response = append(response, FromDomainUserToGRPC(&users[i]))
+	}
+	return response
+}
+
+
+func FromDomainProductTypeToGRPC(productType *domain.ProductType) *pvz_v1.ProductType {
+	return &pvz_v1.ProductType{
+		Name:           productType.Name,
+		Fragile:        productType.Fragile,
+		MaxWeightGrams: int32(productType.MaxWeightGrams),
+		CreatedAt:      timestamppb.New(productType.CreatedAt),
+		UpdatedAt:      timestamppb.New(productType.UpdatedAt),
+	}
+}
+
+
+func FromDomainProductTypesToGRPC(productTypes []domain.ProductType) []*pvz_v1.ProductType {
+	response := make([]*pvz_v1.ProductType, 0, len(productTypes))
+	for i := range productTypes {
+		response = append(response, FromDomainProductTypeToGRPC(&productTypes[i]))
+	}
+	return response
+}
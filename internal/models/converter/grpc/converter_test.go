@@ -27,4 +27,33 @@ func TestConvert(t *testing.T) {
 		require.Equal(t, exampleDomainPvz[i].City, pvz.City)
 		require.Equal(t, exampleDomainPvz[i].RegistrationDate, pvz.RegistrationDate.AsTime().Local())
 	}
-}
\ No newline at end of file
+}
+
+func TestConvertPvzInfo(t *testing.T) {
+	exampleDomainPvzInfo := []domain.PvzInfo{
+		{
+			Pvz: domain.Pvz{ID: "1", City: "Moscow"},
+			Receptions: []domain.ReceptionInfo{
+				{
+					Reception: domain.Reception{ID: "2", PvzID: "1", Status: "in_progress"},
+					Products: []domain.Product{
+						{ID: "3", ReceptionID: "2", Type: "одежда"},
+						{ID: "4", ReceptionID: "2", Type: "обувь", Barcode: "4601234567890", OrderID: "ORD-1"},
+					},
+				},
+			},
+		},
+	}
+
+	pvzsInfo := FromDomainPvzInfoListToGRPCList(exampleDomainPvzInfo)
+
+	require.Len(t, pvzsInfo, 1)
+	require.Equal(t, "1", pvzsInfo[0].Pvz.Id)
+	require.Len(t, pvzsInfo[0].Receptions, 1)
+	require.Equal(t, "2", pvzsInfo[0].Receptions[0].Reception.Id)
+	require.Len(t, pvzsInfo[0].Receptions[0].Products, 2)
+	require.Equal(t, "4", pvzsInfo[0].Receptions[0].Products[1].Id)
+	require.Equal(t, "обувь", pvzsInfo[0].Receptions[0].Products[1].Type)
+	require.Equal(t, "4601234567890", pvzsInfo[0].Receptions[0].Products[1].Barcode)
+	require.Equal(t, "ORD-1", pvzsInfo[0].Receptions[0].Products[1].OrderId)
+}
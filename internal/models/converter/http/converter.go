@@ -1,6 +1,8 @@
 package http
 
 import (
+	"time"
+
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
 	"github.com/Ranik23/avito-tech-spring/internal/models/dto"
 )
@@ -14,7 +16,41 @@ func FromDomainProductToDtoPostProductResp(product *domain.Product) *dto.PostPro
 		Id: product.ID,
 		ReceptionID: product.ReceptionID,
 		Type: product.Type,
+		Barcode: product.Barcode,
+		OrderID: product.OrderID,
+	}
+}
+
+func FromDomainProductsToDtoPostProductsBatchResp(products []domain.Product) *dto.PostProductsBatchResp {
+	resp := &dto.PostProductsBatchResp{
+		Products: make([]dto.PostProductResp, 0, len(products)),
 	}
+	for i := range products {
+		resp.Products = append(resp.Products, *FromDomainProductToDtoPostProductResp(&products[i]))
+	}
+	return resp
+}
+
+func FromDomainProductToDtoProduct(product *domain.Product) dto.Product {
+	return dto.Product{
+		DateTime:    product.DateTime.Format(time.RFC3339),
+		Id:          product.ID,
+		ReceptionID: product.ReceptionID,
+		Type:        product.Type,
+		Barcode:     product.Barcode,
+		OrderID:     product.OrderID,
+	}
+}
+
+// FromDomainProductsToDtoFindProductsResp отдает пустой массив вместо null, если ничего не нашлось.
+func FromDomainProductsToDtoFindProductsResp(products []domain.Product) *dto.FindProductsResp {
+	resp := &dto.FindProductsResp{
+		Items: make([]dto.Product, 0, len(products)),
+	}
+	for i := range products {
+		resp.Items = append(resp.Items, FromDomainProductToDtoProduct(&products[i]))
+	}
+	return resp
 }
 
 func FromDomainReceptionToCreateReceptionResp(reception *domain.Reception) *dto.CreateReceptionResp {
@@ -45,3 +81,163 @@ func FromDomainReceptionToCloseReseptionResp(reception *domain.Reception) *dto.C
 	}
 }
 
+
+// FromDomainPvzInfoPageToDtoGetPvzInfoListResp отдает пустые массивы вместо null,
+// а nextPage и nextCursor = null на последней странице.
+func FromDomainPvzInfoPageToDtoGetPvzInfoListResp(page *domain.PvzInfoPage) *dto.GetPvzInfoListResp {
+	resp := &dto.GetPvzInfoListResp{
+		Items: make([]dto.GetPvzInfoResp, 0, len(page.Items)),
+		Total: page.Total,
+		Page:  page.Page,
+		Limit: page.Limit,
+	}
+	if page.NextPage != 0 {
+		nextPage := page.NextPage
+		resp.NextPage = &nextPage
+	}
+	if page.NextCursor != "" {
+		nextCursor := page.NextCursor
+		resp.NextCursor = &nextCursor
+	}
+
+	for _, info := range page.Items {
+		item := dto.GetPvzInfoResp{
+			Pvz: dto.Pvz{
+				Id:               info.Pvz.ID,
+				City:             info.Pvz.City,
+				RegistrationDate: info.Pvz.RegistrationDate.Format(time.RFC3339),
+			},
+			Receptions: make([]dto.ReceptionInfo, 0, len(info.Receptions)),
+		}
+
+		for _, receptionInfo := range info.Receptions {
+			reception := dto.ReceptionInfo{
+				Reception: dto.Reception{
+					DateTime: receptionInfo.Reception.DateTime.Format(time.RFC3339),
+					Id:       receptionInfo.Reception.ID,
+					PvzId:    receptionInfo.Reception.PvzID,
+					Status:   receptionInfo.Reception.Status,
+				},
+				Products: make([]dto.Product, 0, len(receptionInfo.Products)),
+			}
+
+			for i := range receptionInfo.Products {
+				reception.Products = append(reception.Products, FromDomainProductToDtoProduct(&receptionInfo.Products[i]))
+			}
+
+			item.Receptions = append(item.Receptions, reception)
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp
+}
+
+
+func FromDomainReceptionToDtoReception(reception *domain.Reception) *dto.Reception {
+	return &dto.Reception{
+		DateTime: reception.DateTime.Format(time.RFC3339),
+		Id:       reception.ID,
+		PvzId:    reception.PvzID,
+		Status:   reception.Status,
+	}
+}
+
+// FromDomainReceptionHistoryToDtoGetReceptionHistoryResp отдает пустой массив вместо null.
+func FromDomainReceptionHistoryToDtoGetReceptionHistoryResp(history []domain.ReceptionStatusChange) *dto.GetReceptionHistoryResp {
+	resp := &dto.GetReceptionHistoryResp{
+		Items: make([]dto.ReceptionStatusChange, 0, len(history)),
+	}
+	for _, change := range history {
+		resp.Items = append(resp.Items, dto.ReceptionStatusChange{
+			Id:          change.ID,
+			ReceptionId: change.ReceptionID,
+			FromStatus:  change.FromStatus,
+			ToStatus:    change.ToStatus,
+			Action:      change.Action,
+			Reason:      change.Reason,
+			UserId:      change.UserID,
+			Role:        change.Role,
+			ChangedAt:   change.ChangedAt.Format(time.RFC3339),
+		})
+	}
+	return resp
+}
+
+// FromDomainAuditPageToDtoGetAuditLogResp отдает пустой массив вместо null и nextCursor = null на последней странице.
+func FromDomainAuditPageToDtoGetAuditLogResp(page *domain.AuditPage) *dto.GetAuditLogResp {
+	resp := &dto.GetAuditLogResp{
+		Items: make([]dto.AuditEntry, 0, len(page.Items)),
+	}
+	if page.NextCursor != "" {
+		nextCursor := page.NextCursor
+		resp.NextCursor = &nextCursor
+	}
+
+	for _, entry := range page.Items {
+		resp.Items = append(resp.Items, dto.AuditEntry{
+			Id:           entry.ID,
+			UserId:       entry.UserID,
+			Role:         entry.Role,
+			Dummy:        entry.Dummy,
+			Action:       entry.Action,
+			PvzId:        entry.PvzID,
+			ReceptionId:  entry.ReceptionID,
+			ProductId:    entry.ProductID,
+			TargetUserId: entry.TargetUserID,
+			CreatedAt:    entry.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return resp
+}
+
+func FromDomainUserToDtoUser(user *domain.User) *dto.User {
+	return &dto.User{
+		Id:        user.ID,
+		Email:     user.Email,
+		Role:      user.Role,
+		Disabled:  user.Disabled,
+		CreatedAt: user.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromDomainUserPageToDtoListUsersResp(page *domain.UserPage) *dto.ListUsersResp {
+	resp := &dto.ListUsersResp{
+		Items: make([]dto.User, 0, len(page.Items)),
+	}
+	if page.NextCursor != "" {
+		nextCursor := page.NextCursor
+		resp.NextCursor = &nextCursor
+	}
+
+	for i := range page.Items {
+		resp.Items = append(resp.Items, *FromDomainUserToDtoUser(&page.Items[i]))
+	}
+
+	return resp
+}
+
+func FromDomainProductTypeToDtoProductType(productType *domain.ProductType) *dto.ProductType {
+	return &dto.ProductType{
+		Name:           productType.Name,
+		Fragile:        productType.Fragile,
+		MaxWeightGrams: productType.MaxWeightGrams,
+		CreatedAt:      productType.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      productType.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func FromDomainProductTypesToDtoListProductTypesResp(productTypes []domain.ProductType) *dto.ListProductTypesResp {
+	resp := &dto.ListProductTypesResp{
+		Items: make([]dto.ProductType, 0, len(productTypes)),
+	}
+
+	for i := range productTypes {
+		resp.Items = append(resp.Items, *FromDomainProductTypeToDtoProductType(&productTypes[i]))
+	}
+
+	return resp
+}
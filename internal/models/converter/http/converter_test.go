@@ -16,6 +16,8 @@ func TestFromDomainProductToDtoPostProductResp(t *testing.T) {
 		ReceptionID: "receptionID123",
 		Type:       "TypeA",
 		DateTime:   time.Date(2023, time.March, 5, 14, 30, 0, 0, time.UTC),
+		Barcode:    "4601234567890",
+		OrderID:    "ORD-1",
 	}
 
 	result := FromDomainProductToDtoPostProductResp(product)
@@ -24,6 +26,21 @@ func TestFromDomainProductToDtoPostProductResp(t *testing.T) {
 	require.Equal(t, product.ReceptionID, result.ReceptionID)
 	require.Equal(t, product.Type, result.Type)
 	require.Equal(t, product.DateTime.String(), result.DateTime)
+	require.Equal(t, product.Barcode, result.Barcode)
+	require.Equal(t, product.OrderID, result.OrderID)
+}
+
+func TestFromDomainProductsToDtoFindProductsResp(t *testing.T) {
+	products := []domain.Product{{ID: "1", ReceptionID: "2", Type: "обувь", Barcode: "4601234567890"}}
+
+	result := FromDomainProductsToDtoFindProductsResp(products)
+	require.Len(t, result.Items, 1)
+	require.Equal(t, "4601234567890", result.Items[0].Barcode)
+	require.Empty(t, result.Items[0].OrderID)
+
+	empty := FromDomainProductsToDtoFindProductsResp(nil)
+	require.NotNil(t, empty.Items)
+	require.Empty(t, empty.Items)
 }
 
 
@@ -73,3 +90,120 @@ func TestFromDomainReceptionToCloseReseptionResp(t *testing.T) {
 	require.Equal(t, reception.DateTime.String(), result.DateTime)
 }
 
+
+
+func TestFromDomainPvzInfoPageToDtoGetPvzInfoListResp(t *testing.T) {
+	date := time.Date(2023, time.April, 15, 12, 0, 0, 0, time.UTC)
+
+	page := &domain.PvzInfoPage{
+		Items: []domain.PvzInfo{
+			{
+				Pvz: domain.Pvz{ID: "1", City: "Moscow", RegistrationDate: date},
+				Receptions: []domain.ReceptionInfo{{
+					Reception: domain.Reception{ID: "2", PvzID: "1", Status: "close", DateTime: date},
+					Products:  []domain.Product{{ID: "3", ReceptionID: "2", Type: "обувь", DateTime: date}},
+				}},
+			},
+			{
+				Pvz: domain.Pvz{ID: "4", City: "Kazan", RegistrationDate: date},
+			},
+		},
+		Total:    5,
+		Page:     1,
+		Limit:    2,
+		NextPage: 2,
+	}
+
+	result := FromDomainPvzInfoPageToDtoGetPvzInfoListResp(page)
+
+	require.Len(t, result.Items, 2)
+	require.Equal(t, 5, result.Total)
+	require.NotNil(t, result.NextPage)
+	require.Equal(t, 2, *result.NextPage)
+	require.Equal(t, "2023-04-15T12:00:00Z", result.Items[0].Pvz.RegistrationDate)
+	require.Equal(t, "2", result.Items[0].Receptions[0].Reception.Id)
+	require.Equal(t, "3", result.Items[0].Receptions[0].Products[0].Id)
+	require.NotNil(t, result.Items[1].Receptions)
+	require.Empty(t, result.Items[1].Receptions)
+
+	page.NextPage = 0
+	require.Nil(t, FromDomainPvzInfoPageToDtoGetPvzInfoListResp(page).NextPage)
+}
+
+func TestFromDomainAuditPageToDtoGetAuditLogResp(t *testing.T) {
+	page := &domain.AuditPage{
+		Items: []domain.AuditEntry{{
+			ID:          "2",
+			UserID:      "7",
+			Role:        "employee",
+			Action:      domain.AuditProductAdded,
+			PvzID:       "1",
+			ReceptionID: "3",
+			ProductID:   "4",
+			CreatedAt:   time.Date(2025, time.May, 4, 9, 0, 0, 0, time.UTC),
+		}},
+		NextCursor: "next",
+	}
+
+	result := FromDomainAuditPageToDtoGetAuditLogResp(page)
+
+	require.Len(t, result.Items, 1)
+	require.Equal(t, "7", result.Items[0].UserId)
+	require.Equal(t, domain.AuditProductAdded, result.Items[0].Action)
+	require.Equal(t, "2025-05-04T09:00:00Z", result.Items[0].CreatedAt)
+	require.NotNil(t, result.NextCursor)
+	require.Equal(t, "next", *result.NextCursor)
+
+	empty := FromDomainAuditPageToDtoGetAuditLogResp(&domain.AuditPage{})
+	require.NotNil(t, empty.Items)
+	require.Nil(t, empty.NextCursor)
+}
+
+func TestFromDomainUserPageToDtoListUsersResp(t *testing.T) {
+	page := &domain.UserPage{
+		Items: []domain.User{{
+			ID:           "7",
+			Email:        "user@example.com",
+			PasswordHash: "hash",
+			Role:         "employee",
+			Disabled:     true,
+			CreatedAt:    time.Date(2025, time.May, 4, 9, 0, 0, 0, time.UTC),
+			UpdatedAt:    time.Date(2025, time.May, 7, 9, 0, 0, 0, time.UTC),
+		}},
+		NextCursor: "next",
+	}
+
+	result := FromDomainUserPageToDtoListUsersResp(page)
+
+	require.Len(t, result.Items, 1)
+	require.Equal(t, "7", result.Items[0].Id)
+	require.True(t, result.Items[0].Disabled)
+	require.Equal(t, "2025-05-07T09:00:00Z", result.Items[0].UpdatedAt)
+	require.NotNil(t, result.NextCursor)
+	require.Equal(t, "next", *result.NextCursor)
+
+	empty := FromDomainUserPageToDtoListUsersResp(&domain.UserPage{})
+	require.NotNil(t, empty.Items)
+	require.Nil(t, empty.NextCursor)
+}
+
+func TestFromDomainProductTypesToDtoListProductTypesResp(t *testing.T) {
+	productTypes := []domain.ProductType{{
+		Name:           "электроника",
+		Fragile:        true,
+		MaxWeightGrams: 5000,
+		CreatedAt:      time.Date(2025, time.May, 8, 9, 0, 0, 0, time.UTC),
+		UpdatedAt:      time.Date(2025, time.May, 8, 10, 0, 0, 0, time.UTC),
+	}}
+
+	result := FromDomainProductTypesToDtoListProductTypesResp(productTypes)
+
+	require.Len(t, result.Items, 1)
+	require.Equal(t, "электроника", result.Items[0].Name)
+	require.True(t, result.Items[0].Fragile)
+	require.Equal(t, 5000, result.Items[0].MaxWeightGrams)
+	require.Equal(t, "2025-05-08T10:00:00Z", result.Items[0].UpdatedAt)
+
+	empty := FromDomainProductTypesToDtoListProductTypesResp(nil)
+	require.NotNil(t, empty.Items)
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// Действия журнала аудита. Для приемок и товаров совпадают с типами событий PvzEvent.
+const (
+	AuditPvzCreated         = EventPvzCreated
+	AuditReceptionStarted   = EventReceptionStarted
+	AuditReceptionClosed    = EventReceptionClosed
+	AuditReceptionPaused    = EventReceptionPaused
+	AuditReceptionResumed   = EventReceptionResumed
+	AuditReceptionCancelled = EventReceptionCancelled
+	AuditReceptionReopened  = EventReceptionReopened
+	AuditProductAdded       = EventProductAdded
+	AuditProductDeleted     = EventProductDeleted
+	AuditProductRestored    = EventProductRestored
+	AuditEmployeeAssigned   = "employee_assigned"
+	AuditEmployeeUnassigned = "employee_unassigned"
+)
+
+// AuditEntry - запись журнала аудита. UserID и Role пустые, если действие выполнено не от имени
+// пользователя. TargetUserID заполняется для назначения сотрудников.
+type AuditEntry struct {
+	ID           string
+	UserID       string
+	Role         string
+	Dummy        bool
+	Action       string
+	PvzID        string
+	ReceptionID  string
+	ProductID    string
+	TargetUserID string
+	CreatedAt    time.Time
+}
+
+// AuditQuery - запрос страницы журнала, записи идут от новых к старым. Пустой Cursor - первая страница.
+type AuditQuery struct {
+	UserID string
+	PvzIDs []string
+	From   *time.Time
+	To     *time.Time
+	Limit  int
+	Cursor string
+}
+
+// AuditFilter - параметры выборки журнала на уровне репозитория. BeforeID задает keyset пагинацию (id < BeforeID).
+// Пустой UserID или PvzIDs означает отсутствие ограничения.
+type AuditFilter struct {
+	UserID   string
+	PvzIDs   []string
+	From     *time.Time
+	To       *time.Time
+	BeforeID string
+	Limit    int
+}
+
+type AuditPage struct {
+	Items      []AuditEntry
+	NextCursor string // пустой, если это последняя страница
+}
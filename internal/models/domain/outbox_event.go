@@ -0,0 +1,16 @@
+package domain
+
+import "time"
+
+const (
+	EventPvzCreated = "pvz_created"
+)
+
+type OutboxEvent struct {
+	ID        string
+	EventType string
+	PvzID     string
+	Payload   []byte
+	Attempts  int
+	CreatedAt time.Time
+}
@@ -7,4 +7,16 @@ type Product struct {
 	DateTime    time.Time
 	Type        string
 	ReceptionID string
+	// Barcode и OrderID необязательны: пустая строка хранится в БД как NULL.
+	Barcode string
+	OrderID string
+	// DeletedAt заполнен у удаленного товара: удаление мягкое, товар можно восстановить.
+	DeletedAt *time.Time
+}
+
+// ProductItem описывает товар, который принимают в ПВЗ.
+type ProductItem struct {
+	Type    string
+	Barcode string
+	OrderID string
 }
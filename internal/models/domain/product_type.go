@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// ProductType - тип товара из справочника. Товар можно принять только с типом из справочника.
+type ProductType struct {
+	Name    string
+	Fragile bool
+	// MaxWeightGrams - максимальный вес товара этого типа в граммах, 0 - без ограничения.
+	MaxWeightGrams int
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -7,3 +7,25 @@ type Pvz struct {
 	RegistrationDate time.Time
 	City             string
 }
+
+type PvzPage struct {
+	Items      []Pvz
+	NextCursor string // пустой, если это последняя страница
+}
+
+// PvzFilter - параметры выборки ПВЗ на уровне репозитория.
+// Если задан AfterID, используется keyset пагинация (id > AfterID) и Offset не учитывается.
+// Cities и PvzIDs ограничивают сами ПВЗ. StartDate, EndDate, ReceptionStatuses и ProductTypes
+// ограничивают только приемки: ProductTypes оставляет приемки, в которых есть товар одного из типов.
+// Пустой список означает отсутствие ограничения.
+type PvzFilter struct {
+	StartDate         *time.Time
+	EndDate           *time.Time
+	Cities            []string
+	PvzIDs            []string
+	ReceptionStatuses []string
+	ProductTypes      []string
+	AfterID           string
+	Offset            int
+	Limit             int
+}
@@ -0,0 +1,24 @@
+package domain
+
+import "time"
+
+const (
+	EventReceptionStarted   = "reception_started"
+	EventReceptionClosed    = "reception_closed"
+	EventReceptionPaused    = "reception_paused"
+	EventReceptionResumed   = "reception_resumed"
+	EventReceptionCancelled = "reception_cancelled"
+	EventReceptionReopened  = "reception_reopened"
+	EventProductAdded       = "product_added"
+	EventProductDeleted     = "product_deleted"
+	EventProductRestored    = "product_restored"
+)
+
+type PvzEvent struct {
+	Sequence    uint64
+	Type        string
+	PvzID       string
+	ReceptionID string
+	ProductID   string
+	OccurredAt  time.Time
+}
@@ -1,6 +1,31 @@
 package domain
 
+import "time"
+
 type PvzInfo struct {
 	Pvz        Pvz
 	Receptions []ReceptionInfo
-}
\ No newline at end of file
+}
+
+// PvzInfoQuery - запрос страницы ПВЗ. Если задан Cursor, страница берется по курсору и Page игнорируется.
+// Фильтры имеют тот же смысл, что и в PvzFilter.
+type PvzInfoQuery struct {
+	StartDate         *time.Time
+	EndDate           *time.Time
+	Cities            []string
+	PvzIDs            []string
+	ReceptionStatuses []string
+	ProductTypes      []string
+	Page              int
+	Limit             int
+	Cursor            string
+}
+
+type PvzInfoPage struct {
+	Items      []PvzInfo
+	Total      int
+	Page       int
+	Limit      int
+	NextPage   int    // 0, если это последняя страница или запрос был по курсору
+	NextCursor string // пустой, если это последняя страница
+}
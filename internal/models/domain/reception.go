@@ -2,9 +2,81 @@ package domain
 
 import "time"
 
+// Статусы приемки в том виде, в котором они хранятся в БД и отдаются клиентам.
+const (
+	ReceptionInProgress = "in_progress"
+	ReceptionPaused     = "paused"
+	ReceptionClosed     = "closed"
+	ReceptionCancelled  = "cancelled"
+)
+
+// Действия над приемкой, которые переводят ее из статуса в статус.
+const (
+	ReceptionActionStart  = "start"
+	ReceptionActionPause  = "pause"
+	ReceptionActionResume = "resume"
+	ReceptionActionClose  = "close"
+	ReceptionActionCancel = "cancel"
+	// ReceptionActionReopen - модератор возвращает в работу закрытую приемку.
+	ReceptionActionReopen = "reopen"
+)
+
+// receptionTransitions - допустимые переходы: действие -> статус до -> статус после.
+// Отмененная приемка конечная, закрытую может вернуть в работу только модератор.
+var receptionTransitions = map[string]map[string]string{
+	ReceptionActionPause: {
+		ReceptionInProgress: ReceptionPaused,
+	},
+	ReceptionActionResume: {
+		ReceptionPaused: ReceptionInProgress,
+	},
+	ReceptionActionClose: {
+		ReceptionInProgress: ReceptionClosed,
+		ReceptionPaused:     ReceptionClosed,
+	},
+	ReceptionActionCancel: {
+		ReceptionInProgress: ReceptionCancelled,
+		ReceptionPaused:     ReceptionCancelled,
+	},
+	ReceptionActionReopen: {
+		ReceptionClosed: ReceptionInProgress,
+	},
+}
+
+// ReceptionStatuses возвращает все статусы приемки.
+func ReceptionStatuses() []string {
+	return []string{ReceptionInProgress, ReceptionPaused, ReceptionClosed, ReceptionCancelled}
+}
+
+// ActiveReceptionStatuses - статусы незавершенной приемки. Такая приемка в ПВЗ может быть только одна.
+func ActiveReceptionStatuses() []string {
+	return []string{ReceptionInProgress, ReceptionPaused}
+}
+
+// NextReceptionStatus возвращает статус приемки после действия action. ok=false, если из статуса from
+// такого перехода нет. Открытие приемки (ReceptionActionStart) создает ее и сюда не относится.
+func NextReceptionStatus(from string, action string) (to string, ok bool) {
+	to, ok = receptionTransitions[action][from]
+	return to, ok
+}
+
 type Reception struct {
 	ID       string
 	DateTime time.Time
 	PvzID    string
 	Status   string
 }
+
+// ReceptionStatusChange - запись истории статусов приемки. FromStatus пустой при открытии приемки,
+// UserID и Role пустые, если переход выполнен не от имени пользователя.
+type ReceptionStatusChange struct {
+	ID          string
+	ReceptionID string
+	FromStatus  string
+	ToStatus    string
+	Action      string
+	Reason      string
+	UserID      string
+	Role        string
+	ChangedAt   time.Time
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// RefreshToken - запись о выданном refresh токене. Сам токен не хранится, только его хеш.
+type RefreshToken struct {
+	ID        string
+	UserID    string
+	TokenHash string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+type TokenPair struct {
+	AccessToken  string
+	RefreshToken string
+}
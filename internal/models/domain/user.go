@@ -7,5 +7,19 @@ type User struct {
 	Email        string
 	PasswordHash string
 	Role         string
-	CreatedAt    time.Time
+	// Disabled - учетная запись отключена модератором: вход и запросы с ее токенами отклоняются.
+	Disabled  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type UserPage struct {
+	Items      []User
+	NextCursor string // пустой, если это последняя страница
+}
+
+// UserFilter - параметры выборки пользователей, keyset пагинация по id.
+type UserFilter struct {
+	AfterID string
+	Limit   int
 }
@@ -0,0 +1,5 @@
+package dto
+
+type AssignEmployeeReq struct {
+	UserID string `json:"userId"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+type AuditEntry struct {
+	Id           string `json:"id"`
+	UserId       string `json:"userId,omitempty"`
+	Role         string `json:"role,omitempty"`
+	Dummy        bool   `json:"dummy"`
+	Action       string `json:"action"`
+	PvzId        string `json:"pvzId"`
+	ReceptionId  string `json:"receptionId,omitempty"`
+	ProductId    string `json:"productId,omitempty"`
+	TargetUserId string `json:"targetUserId,omitempty"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type GetAuditLogResp struct {
+	Items      []AuditEntry `json:"items"`
+	NextCursor *string      `json:"nextCursor"`
+}
@@ -8,6 +8,7 @@ type LoginReq struct {
 }
 
 type LoginResp struct {
-	Token string		`yaml:"token"`
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
 }
 
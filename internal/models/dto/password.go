@@ -0,0 +1,15 @@
+package dto
+
+type ChangePasswordReq struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+}
+
+type RequestPasswordResetReq struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordReq struct {
+	Token       string `json:"token"`
+	NewPassword string `json:"newPassword"`
+}
@@ -0,0 +1,24 @@
+package dto
+
+type ProductType struct {
+	Name           string `json:"name"`
+	Fragile        bool   `json:"fragile"`
+	MaxWeightGrams int    `json:"maxWeightGrams"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+type ListProductTypesResp struct {
+	Items []ProductType `json:"items"`
+}
+
+type CreateProductTypeReq struct {
+	Name           string `json:"name"`
+	Fragile        bool   `json:"fragile"`
+	MaxWeightGrams int    `json:"maxWeightGrams"`
+}
+
+type UpdateProductTypeReq struct {
+	Fragile        bool `json:"fragile"`
+	MaxWeightGrams int  `json:"maxWeightGrams"`
+}
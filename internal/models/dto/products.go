@@ -7,12 +7,16 @@ type Product struct {
 	Id          string `json:"id,omitempty"`     
 	ReceptionID string `json:"receptionId"`
 	Type        string `json:"type"`
+	Barcode     string `json:"barcode,omitempty"`
+	OrderID     string `json:"orderId,omitempty"`
 }
 
 
 type PostProductReq struct {
 	PvzID 	string		`json:"pvzId"`
 	Type 	string		`json:"type"`	
+	Barcode string		`json:"barcode,omitempty"`
+	OrderID string		`json:"orderId,omitempty"`
 }
 
 type PostProductResp struct {
@@ -20,4 +24,25 @@ type PostProductResp struct {
 	Id          string `json:"id,omitempty"`     
 	ReceptionID string `json:"receptionId"`
 	Type        string `json:"type"`
+	Barcode     string `json:"barcode,omitempty"`
+	OrderID     string `json:"orderId,omitempty"`
+}
+
+type ProductItem struct {
+	Type 	string		`json:"type"`
+	Barcode string		`json:"barcode,omitempty"`
+	OrderID string		`json:"orderId,omitempty"`
+}
+
+type PostProductsBatchReq struct {
+	PvzID 		string			`json:"pvzId"`
+	Products 	[]ProductItem	`json:"products"`
+}
+
+type PostProductsBatchResp struct {
+	Products 	[]PostProductResp	`json:"products"`
+}
+
+type FindProductsResp struct {
+	Items 		[]Product		`json:"items"`
 }
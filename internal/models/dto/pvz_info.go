@@ -4,13 +4,20 @@ package dto
 
 
 type GetPvzInfoListResp struct {
-	Items []GetPvzInfoResp
+	Items 		[]GetPvzInfoResp	`json:"items"`
+	Total 		int					`json:"total"`
+	Page 		int					`json:"page"`
+	Limit 		int					`json:"limit"`
+	NextPage 	*int				`json:"nextPage"`
+	NextCursor 	*string				`json:"nextCursor"`
 }
 
 type GetPvzInfoResp struct {
-	Pvz  Pvz
-	Receptions []struct{
-		Reception Reception
-		Products []Product
-	}
-}
\ No newline at end of file
+	Pvz  		Pvz					`json:"pvz"`
+	Receptions 	[]ReceptionInfo		`json:"receptions"`
+}
+
+type ReceptionInfo struct {
+	Reception 	Reception			`json:"reception"`
+	Products 	[]Product			`json:"products"`
+}
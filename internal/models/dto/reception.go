@@ -26,4 +26,25 @@ type CreateReceptionResp struct {
 	Id			string		`json:"id,omitempty"`
 	PvzId		string		`json:"pvzId"`
 	Status		string		`json:"status"`
-}
\ No newline at end of file
+}
+
+// ReceptionReasonReq - необязательное тело отмены и возврата приемки в работу.
+type ReceptionReasonReq struct {
+	Reason string `json:"reason"`
+}
+
+type ReceptionStatusChange struct {
+	Id          string `json:"id"`
+	ReceptionId string `json:"receptionId"`
+	FromStatus  string `json:"fromStatus,omitempty"`
+	ToStatus    string `json:"toStatus"`
+	Action      string `json:"action"`
+	Reason      string `json:"reason,omitempty"`
+	UserId      string `json:"userId,omitempty"`
+	Role        string `json:"role,omitempty"`
+	ChangedAt   string `json:"changedAt"`
+}
+
+type GetReceptionHistoryResp struct {
+	Items []ReceptionStatusChange `json:"items"`
+}
@@ -0,0 +1,15 @@
+package dto
+
+type RefreshReq struct {
+	RefreshToken string `json:"refreshToken"`
+}
+
+type RefreshResp struct {
+	Token        string `json:"token"`
+	RefreshToken string `json:"refreshToken"`
+}
+
+// LogoutReq - refresh токен необязателен, без него отзывается только текущий access токен.
+type LogoutReq struct {
+	RefreshToken string `json:"refreshToken"`
+}
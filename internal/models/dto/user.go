@@ -0,0 +1,19 @@
+package dto
+
+type User struct {
+	Id        string `json:"id"`
+	Email     string `json:"email"`
+	Role      string `json:"role"`
+	Disabled  bool   `json:"disabled"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+type ListUsersResp struct {
+	Items      []User  `json:"items"`
+	NextCursor *string `json:"nextCursor"`
+}
+
+type UpdateUserRoleReq struct {
+	Role string `json:"role"`
+}
@@ -0,0 +1,77 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// Notifier доставляет пользователю сообщения, которые нельзя вернуть в ответе API.
+// Для боевого окружения нужна реализация поверх почтового сервиса, log и file годятся для локальной разработки.
+type Notifier interface {
+	SendPasswordReset(ctx context.Context, email string, resetToken string, expiresAt time.Time) error
+}
+
+// Message - формат, в котором уведомления пишутся в файл.
+type Message struct {
+	Type      string    `json:"type"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+const TypePasswordReset = "password_reset"
+
+type logNotifier struct {
+	logger *slog.Logger
+}
+
+// NewLogNotifier пишет уведомления в лог вместе с токеном, поэтому включать его можно только локально.
+func NewLogNotifier(logger *slog.Logger) Notifier {
+	return &logNotifier{
+		logger: logger,
+	}
+}
+
+func (l *logNotifier) SendPasswordReset(ctx context.Context, email string, resetToken string, expiresAt time.Time) error {
+	l.logger.Info("Password reset requested",
+		slog.String("email", email),
+		slog.String("token", resetToken),
+		slog.Time("expiresAt", expiresAt))
+	return nil
+}
+
+type writerNotifier struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterNotifier пишет уведомления в writer по одному JSON объекту на строку.
+func NewWriterNotifier(writer io.Writer) Notifier {
+	return &writerNotifier{
+		writer: writer,
+	}
+}
+
+func (w *writerNotifier) SendPasswordReset(ctx context.Context, email string, resetToken string, expiresAt time.Time) error {
+	data, err := json.Marshal(Message{
+		Type:      TypePasswordReset,
+		Email:     email,
+		Token:     resetToken,
+		ExpiresAt: expiresAt,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err = w.writer.Write(append(data, '\n'))
+	return err
+}
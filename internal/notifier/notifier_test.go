@@ -0,0 +1,43 @@
+//go:build unit
+
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterNotifier_WritesJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	n := NewWriterNotifier(&buf)
+	expiresAt := time.Date(2025, 5, 6, 9, 0, 0, 0, time.UTC)
+
+	require.NoError(t, n.SendPasswordReset(context.Background(), "user@example.com", "token1", expiresAt))
+	require.NoError(t, n.SendPasswordReset(context.Background(), "user@example.com", "token2", expiresAt))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var msg Message
+	require.NoError(t, json.Unmarshal(lines[1], &msg))
+	assert.Equal(t, TypePasswordReset, msg.Type)
+	assert.Equal(t, "user@example.com", msg.Email)
+	assert.Equal(t, "token2", msg.Token)
+	assert.True(t, expiresAt.Equal(msg.ExpiresAt))
+}
+
+func TestLogNotifier_LogsToken(t *testing.T) {
+	var buf bytes.Buffer
+	n := NewLogNotifier(slog.New(slog.NewTextHandler(&buf, nil)))
+
+	require.NoError(t, n.SendPasswordReset(context.Background(), "user@example.com", "token1", time.Now()))
+	assert.Contains(t, buf.String(), "token=token1")
+	assert.Contains(t, buf.String(), "email=user@example.com")
+}
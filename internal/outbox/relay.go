@@ -0,0 +1,138 @@
+package outbox
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+)
+
+const maxRetryBackoff = 30 * time.Minute
+
+type Config struct {
+	PollInterval time.Duration
+	BatchSize    int
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	SendTimeout  time.Duration
+}
+
+// Relay периодически забирает неотправленные события из outbox и передает их в Sink.
+// Событие помечается отправленным только после успешной доставки, при ошибке
+// повторная попытка откладывается экспоненциально, пока не исчерпан MaxAttempts.
+type Relay struct {
+	repo      repository.OutboxRepository
+	txManager repository.TxManager
+	sink      Sink
+	cfg       Config
+	logger    *slog.Logger
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewRelay(repo repository.OutboxRepository, txManager repository.TxManager, sink Sink,
+	cfg Config, logger *slog.Logger) *Relay {
+	return &Relay{
+		repo:      repo,
+		txManager: txManager,
+		sink:      sink,
+		cfg:       cfg,
+		logger:    logger,
+	}
+}
+
+func (r *Relay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.done = make(chan struct{})
+
+	go func() {
+		defer close(r.done)
+
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+
+		r.logger.Info("Outbox relay started")
+
+		for {
+			select {
+			case <-ctx.Done():
+				r.logger.Info("Outbox relay stopped")
+				return
+			case <-ticker.C:
+				if _, err := r.ProcessBatch(ctx); err != nil && ctx.Err() == nil {
+					r.logger.Error("Failed to process outbox batch", slog.String("error", err.Error()))
+				}
+			}
+		}
+	}()
+}
+
+// Stop подходит для closure.Closer: останавливает цикл и ждет завершения текущей пачки.
+func (r *Relay) Stop(ctx context.Context) error {
+	if r.cancel == nil {
+		return nil
+	}
+	r.cancel()
+
+	select {
+	case <-r.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ProcessBatch отправляет одну пачку событий и возвращает количество доставленных.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	sent := 0
+
+	err := r.txManager.Do(ctx, func(txCtx context.Context) error {
+		sent = 0
+
+		events, err := r.repo.FetchPending(txCtx, r.cfg.BatchSize, r.cfg.MaxAttempts)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			sendCtx, cancel := context.WithTimeout(ctx, r.cfg.SendTimeout)
+			sendErr := r.sink.Send(sendCtx, event)
+			cancel()
+
+			if sendErr != nil {
+				nextAttemptAt := time.Now().Add(r.backoff(event.Attempts))
+				if err := r.repo.MarkFailed(txCtx, event.ID, sendErr.Error(), nextAttemptAt); err != nil {
+					return err
+				}
+				if event.Attempts+1 >= r.cfg.MaxAttempts {
+					r.logger.Error("Outbox event exhausted delivery attempts",
+						slog.String("eventID", event.ID),
+						slog.String("eventType", event.EventType))
+				}
+				continue
+			}
+
+			if err := r.repo.MarkProcessed(txCtx, event.ID); err != nil {
+				return err
+			}
+			sent++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return sent, nil
+}
+
+func (r *Relay) backoff(attempts int) time.Duration {
+	backoff := r.cfg.RetryBackoff
+	for i := 0; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxRetryBackoff)
+}
@@ -0,0 +1,130 @@
+//go:build unit
+
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	repomock "github.com/Ranik23/avito-tech-spring/internal/repository/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type stubSink struct {
+	failIDs map[string]bool
+	sent    []string
+}
+
+func (s *stubSink) Send(ctx context.Context, event domain.OutboxEvent) error {
+	if s.failIDs[event.ID] {
+		return errors.New("sink error")
+	}
+	s.sent = append(s.sent, event.ID)
+	return nil
+}
+
+func testConfig() Config {
+	return Config{
+		PollInterval: 10 * time.Millisecond,
+		BatchSize:    10,
+		MaxAttempts:  5,
+		RetryBackoff: time.Second,
+		SendTimeout:  time.Second,
+	}
+}
+
+func passthroughTx(txManager *repomock.MockTxManager) {
+	txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+}
+
+func TestRelay_ProcessBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	passthroughTx(mockTxManager)
+
+	events := []domain.OutboxEvent{{ID: "1"}, {ID: "2", Attempts: 2}}
+	mockRepo.EXPECT().FetchPending(gomock.Any(), 10, 5).Return(events, nil)
+	mockRepo.EXPECT().MarkProcessed(gomock.Any(), "1").Return(nil)
+	mockRepo.EXPECT().MarkFailed(gomock.Any(), "2", "sink error", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, id, reason string, nextAttemptAt time.Time) error {
+			// 1s * 2^2
+			assert.WithinDuration(t, time.Now().Add(4*time.Second), nextAttemptAt, time.Second)
+			return nil
+		})
+
+	sink := &stubSink{failIDs: map[string]bool{"2": true}}
+	relay := NewRelay(mockRepo, mockTxManager, sink, testConfig(), slog.Default())
+
+	sent, err := relay.ProcessBatch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+	assert.Equal(t, []string{"1"}, sink.sent)
+}
+
+func TestRelay_ProcessBatch_MarkProcessedError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	passthroughTx(mockTxManager)
+
+	mockRepo.EXPECT().FetchPending(gomock.Any(), 10, 5).Return([]domain.OutboxEvent{{ID: "1"}}, nil)
+	mockRepo.EXPECT().MarkProcessed(gomock.Any(), "1").Return(errors.New("db error"))
+
+	relay := NewRelay(mockRepo, mockTxManager, &stubSink{}, testConfig(), slog.Default())
+
+	sent, err := relay.ProcessBatch(context.Background())
+	assert.Error(t, err)
+	assert.Zero(t, sent)
+}
+
+func TestRelay_Backoff(t *testing.T) {
+	relay := NewRelay(nil, nil, nil, testConfig(), slog.Default())
+
+	assert.Equal(t, time.Second, relay.backoff(0))
+	assert.Equal(t, 8*time.Second, relay.backoff(3))
+	assert.Equal(t, maxRetryBackoff, relay.backoff(50))
+}
+
+func TestRelay_StartStop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	passthroughTx(mockTxManager)
+
+	fetched := make(chan struct{}, 1)
+	mockRepo.EXPECT().FetchPending(gomock.Any(), 10, 5).DoAndReturn(func(ctx context.Context, limit, maxAttempts int) ([]domain.OutboxEvent, error) {
+		select {
+		case fetched <- struct{}{}:
+		default:
+		}
+		return nil, nil
+	}).MinTimes(1)
+
+	relay := NewRelay(mockRepo, mockTxManager, &stubSink{}, testConfig(), slog.Default())
+	relay.Start(context.Background())
+
+	select {
+	case <-fetched:
+	case <-time.After(time.Second):
+		t.Fatal("relay did not poll outbox")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	require.NoError(t, relay.Stop(ctx))
+}
@@ -0,0 +1,133 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+)
+
+// Sink доставляет событие из outbox во внешнюю систему. Доставка как минимум один раз:
+// одно и то же событие может прийти повторно, получатели должны дедуплицировать его по ID.
+type Sink interface {
+	Send(ctx context.Context, event domain.OutboxEvent) error
+}
+
+// Publisher - адаптер для брокеров сообщений (Kafka, NATS, RabbitMQ).
+type Publisher interface {
+	Publish(ctx context.Context, topic string, key string, value []byte) error
+}
+
+// Message - формат, в котором события уходят наружу.
+type Message struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	PvzID     string          `json:"pvzId"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"createdAt"`
+}
+
+func newMessage(event domain.OutboxEvent) Message {
+	return Message{
+		ID:        event.ID,
+		Type:      event.EventType,
+		PvzID:     event.PvzID,
+		Payload:   json.RawMessage(event.Payload),
+		CreatedAt: event.CreatedAt,
+	}
+}
+
+type writerSink struct {
+	mu     sync.Mutex
+	writer io.Writer
+}
+
+// NewWriterSink пишет события в writer по одному JSON объекту на строку (stdout, файл).
+func NewWriterSink(writer io.Writer) Sink {
+	return &writerSink{
+		writer: writer,
+	}
+}
+
+func (w *writerSink) Send(ctx context.Context, event domain.OutboxEvent) error {
+	data, err := json.Marshal(newMessage(event))
+	if err != nil {
+		return err
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	_, err = w.writer.Write(append(data, '\n'))
+	return err
+}
+
+type webhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// NewWebhookSink отправляет события POST запросом на url. Любой ответ кроме 2xx считается ошибкой.
+func NewWebhookSink(url string, timeout time.Duration) Sink {
+	return &webhookSink{
+		url: url,
+		client: &http.Client{
+			Timeout: timeout,
+		},
+	}
+}
+
+func (w *webhookSink) Send(ctx context.Context, event domain.OutboxEvent) error {
+	data, err := json.Marshal(newMessage(event))
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-Id", event.ID)
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+type publisherSink struct {
+	publisher   Publisher
+	topicPrefix string
+}
+
+// NewPublisherSink публикует события в топик topicPrefix + тип события с ключом pvz_id,
+// чтобы события одного ПВЗ попадали в одну партицию.
+func NewPublisherSink(publisher Publisher, topicPrefix string) Sink {
+	return &publisherSink{
+		publisher:   publisher,
+		topicPrefix: topicPrefix,
+	}
+}
+
+func (p *publisherSink) Send(ctx context.Context, event domain.OutboxEvent) error {
+	data, err := json.Marshal(newMessage(event))
+	if err != nil {
+		return err
+	}
+
+	return p.publisher.Publish(ctx, p.topicPrefix+event.EventType, event.PvzID, data)
+}
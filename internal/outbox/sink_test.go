@@ -0,0 +1,97 @@
+//go:build unit
+
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEvent = domain.OutboxEvent{
+	ID:        "42",
+	EventType: domain.EventPvzCreated,
+	PvzID:     "pvz1",
+	Payload:   []byte(`{"pvzId":"pvz1","city":"Moscow"}`),
+	CreatedAt: time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC),
+}
+
+func TestWriterSink_WritesJSONLines(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewWriterSink(&buf)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent))
+	require.NoError(t, sink.Send(context.Background(), testEvent))
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 2)
+
+	var msg Message
+	require.NoError(t, json.Unmarshal(lines[0], &msg))
+	assert.Equal(t, "42", msg.ID)
+	assert.Equal(t, domain.EventPvzCreated, msg.Type)
+	assert.Equal(t, "pvz1", msg.PvzID)
+	assert.JSONEq(t, string(testEvent.Payload), string(msg.Payload))
+}
+
+func TestWebhookSink_Success(t *testing.T) {
+	var body []byte
+	var eventID string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		eventID = r.Header.Get("X-Event-Id")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, time.Second)
+
+	require.NoError(t, sink.Send(context.Background(), testEvent))
+	assert.Equal(t, "42", eventID)
+
+	var msg Message
+	require.NoError(t, json.Unmarshal(body, &msg))
+	assert.Equal(t, "pvz1", msg.PvzID)
+}
+
+func TestWebhookSink_Non2xx(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, time.Second)
+
+	assert.Error(t, sink.Send(context.Background(), testEvent))
+}
+
+type fakePublisher struct {
+	topic string
+	key   string
+	value []byte
+}
+
+func (f *fakePublisher) Publish(ctx context.Context, topic string, key string, value []byte) error {
+	f.topic, f.key, f.value = topic, key, value
+	return nil
+}
+
+func TestPublisherSink(t *testing.T) {
+	publisher := &fakePublisher{}
+	sink := NewPublisherSink(publisher, "pvz.")
+
+	require.NoError(t, sink.Send(context.Background(), testEvent))
+	assert.Equal(t, "pvz."+domain.EventPvzCreated, publisher.topic)
+	assert.Equal(t, "pvz1", publisher.key)
+	assert.NotEmpty(t, publisher.value)
+}
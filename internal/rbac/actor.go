@@ -0,0 +1,23 @@
+package rbac
+
+import "context"
+
+// Actor - пользователь, от имени которого выполняется запрос. Кладется в контекст транспортным слоем после проверки токена.
+// Dummy - токен выдан DummyLogin и не привязан к учетной записи.
+type Actor struct {
+	UserID string
+	Role   string
+	Dummy  bool
+}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext возвращает false для внутренних вызовов, которые идут не от имени пользователя.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
@@ -0,0 +1,163 @@
+package rbac
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Permission - право на действие вида "ресурс:действие". Ручки и gRPC методы проверяют права, а не роли.
+type Permission string
+
+const (
+	PVZCreate      Permission = "pvz:create"
+	PVZRead        Permission = "pvz:read"
+	ReceptionOpen  Permission = "reception:open"
+	ReceptionClose Permission = "reception:close"
+	ProductAdd     Permission = "product:add"
+	ProductDelete  Permission = "product:delete"
+	// PVZAssign - назначение сотрудников на ПВЗ.
+	PVZAssign Permission = "pvz:assign"
+	// PVZAny снимает привязку к назначенным ПВЗ: без него пользователь видит и меняет только свои ПВЗ.
+	PVZAny Permission = "pvz:any"
+	// AuditRead - чтение журнала действий пользователей.
+	AuditRead Permission = "audit:read"
+	// UserManage - просмотр пользователей, смена роли, отключение и удаление учетных записей.
+	UserManage Permission = "user:manage"
+	// ProductTypeManage - изменение справочника типов товаров.
+	ProductTypeManage Permission = "product_type:manage"
+	// ReceptionReopen - возврат в работу закрытой приемки.
+	ReceptionReopen Permission = "reception:reopen"
+
+	// Wildcard в конфиге выдает роли все права.
+	Wildcard = "*"
+)
+
+var ErrUnknownPermission = errors.New("unknown permission")
+
+// Permissions - все известные права. Права из конфига сверяются с этим списком, чтобы опечатка не прошла молча.
+func Permissions() []Permission {
+	return []Permission{PVZCreate, PVZRead, ReceptionOpen, ReceptionClose, ProductAdd, ProductDelete, PVZAssign, PVZAny, AuditRead, UserManage, ProductTypeManage, ReceptionReopen}
+}
+
+// DefaultRoles повторяет прежние проверки: сотрудник ведет приемки, модератор заводит ПВЗ.
+func DefaultRoles() map[string][]string {
+	return map[string][]string{
+		"employee":  {string(PVZRead), string(ReceptionOpen), string(ReceptionClose), string(ProductAdd), string(ProductDelete)},
+		"moderator": {string(PVZRead), string(PVZCreate), string(PVZAssign), string(PVZAny), string(AuditRead), string(UserManage), string(ProductTypeManage), string(ReceptionReopen)},
+	}
+}
+
+// DefaultSelfRegisterRoles - роли, которые можно выбрать при регистрации и в DummyLogin.
+func DefaultSelfRegisterRoles() []string {
+	return []string{"employee", "moderator"}
+}
+
+// Policy сопоставляет роли и права. Роли сравниваются без учета регистра.
+type Policy struct {
+	roles        map[string]map[Permission]struct{}
+	selfRegister []string
+}
+
+// NewPolicy собирает политику из конфига. selfRegister должны быть среди описанных ролей,
+// иначе через /register можно было бы получить роль без прав или, наоборот, привилегированную.
+func NewPolicy(roles map[string][]string, selfRegister []string) (*Policy, error) {
+	known := make(map[Permission]struct{})
+	for _, permission := range Permissions() {
+		known[permission] = struct{}{}
+	}
+
+	policy := &Policy{roles: make(map[string]map[Permission]struct{}, len(roles))}
+	for role, permissions := range roles {
+		role = strings.ToLower(role)
+		granted := make(map[Permission]struct{}, len(permissions))
+
+		for _, permission := range permissions {
+			if permission == Wildcard {
+				for p := range known {
+					granted[p] = struct{}{}
+				}
+				continue
+			}
+			if _, ok := known[Permission(permission)]; !ok {
+				return nil, fmt.Errorf("role %q: %w %q", role, ErrUnknownPermission, permission)
+			}
+			granted[Permission(permission)] = struct{}{}
+		}
+
+		policy.roles[role] = granted
+	}
+
+	for _, role := range selfRegister {
+		role = strings.ToLower(role)
+		if _, ok := policy.roles[role]; !ok {
+			return nil, fmt.Errorf("self register role %q is not defined", role)
+		}
+		policy.selfRegister = append(policy.selfRegister, role)
+	}
+
+	return policy, nil
+}
+
+func DefaultPolicy() *Policy {
+	policy, err := NewPolicy(DefaultRoles(), DefaultSelfRegisterRoles())
+	if err != nil {
+		panic(err)
+	}
+	return policy
+}
+
+func (p *Policy) HasRole(role string) bool {
+	_, ok := p.roles[strings.ToLower(role)]
+	return ok
+}
+
+// CanSelfRegister сообщает, можно ли получить роль без участия администратора.
+func (p *Policy) CanSelfRegister(role string) bool {
+	return slices.Contains(p.selfRegister, strings.ToLower(role))
+}
+
+func (p *Policy) Allowed(role string, permission Permission) bool {
+	granted, ok := p.roles[strings.ToLower(role)]
+	if !ok {
+		return false
+	}
+	_, ok = granted[permission]
+	return ok
+}
+
+// Covers сообщает, что у роли role есть все права роли other. Неизвестную роль не покрывает ни одна роль,
+// неизвестная роль не покрывает ничего. Так пользователь не может выдать или отобрать права, которых у него нет.
+func (p *Policy) Covers(role string, other string) bool {
+	granted, ok := p.roles[strings.ToLower(role)]
+	if !ok {
+		return false
+	}
+	required, ok := p.roles[strings.ToLower(other)]
+	if !ok {
+		return false
+	}
+	for permission := range required {
+		if _, ok := granted[permission]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// CanManage сообщает, может ли пользователь с ролью actorRole выдать роль role или управлять учетной записью
+// с этой ролью: все ее права должны быть у него самого, либо роль и так доступна любому через /register.
+func (p *Policy) CanManage(actorRole string, role string) bool {
+	return p.CanSelfRegister(role) || p.Covers(actorRole, role)
+}
+
+// Roles возвращает все роли политики в алфавитном порядке.
+func (p *Policy) Roles() []string {
+	roles := make([]string, 0, len(p.roles))
+	for role := range p.roles {
+		roles = append(roles, role)
+	}
+	slices.Sort(roles)
+	return roles
+}
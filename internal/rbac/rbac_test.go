@@ -0,0 +1,93 @@
+//go:build unit
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultPolicy(t *testing.T) {
+	policy := DefaultPolicy()
+
+	tests := []struct {
+		role       string
+		permission Permission
+		allowed    bool
+	}{
+		{role: "employee", permission: ProductAdd, allowed: true},
+		{role: "Employee", permission: ReceptionOpen, allowed: true},
+		{role: "employee", permission: PVZCreate, allowed: false},
+		{role: "moderator", permission: PVZCreate, allowed: true},
+		{role: "moderator", permission: PVZRead, allowed: true},
+		{role: "moderator", permission: ProductAdd, allowed: false},
+		{role: "moderator", permission: UserManage, allowed: true},
+		{role: "employee", permission: UserManage, allowed: false},
+		{role: "moderator", permission: ProductTypeManage, allowed: true},
+		{role: "employee", permission: ProductTypeManage, allowed: false},
+		{role: "moderator", permission: ReceptionReopen, allowed: true},
+		{role: "employee", permission: ReceptionReopen, allowed: false},
+		{role: "client", permission: PVZRead, allowed: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.role+" "+string(tt.permission), func(t *testing.T) {
+			assert.Equal(t, tt.allowed, policy.Allowed(tt.role, tt.permission))
+		})
+	}
+
+	assert.Equal(t, []string{"employee", "moderator"}, policy.Roles())
+}
+
+func TestNewPolicy_CustomRoles(t *testing.T) {
+	policy, err := NewPolicy(map[string][]string{
+		"employee": {"product:add"},
+		"Admin":    {"*"},
+		"auditor":  {"pvz:read"},
+	}, []string{"employee"})
+	assert.NoError(t, err)
+
+	for _, permission := range Permissions() {
+		assert.True(t, policy.Allowed("admin", permission), permission)
+	}
+
+	assert.True(t, policy.Allowed("auditor", PVZRead))
+	assert.False(t, policy.Allowed("auditor", ProductAdd))
+
+	assert.True(t, policy.HasRole("ADMIN"))
+	assert.True(t, policy.CanSelfRegister("Employee"))
+	assert.False(t, policy.CanSelfRegister("admin"))
+	assert.False(t, policy.CanSelfRegister("ghost"))
+}
+
+func TestNewPolicy_Errors(t *testing.T) {
+	_, err := NewPolicy(map[string][]string{"employee": {"product:ad"}}, nil)
+	assert.ErrorIs(t, err, ErrUnknownPermission)
+
+	_, err = NewPolicy(map[string][]string{"employee": {"product:add"}}, []string{"admin"})
+	assert.Error(t, err)
+}
+
+func TestPolicy_CanManage(t *testing.T) {
+	policy, err := NewPolicy(map[string][]string{
+		"employee":  {"pvz:read", "product:add"},
+		"moderator": {"pvz:read", "pvz:any", "user:manage"},
+		"auditor":   {"pvz:read", "pvz:any"},
+		"admin":     {"*"},
+	}, []string{"employee", "moderator"})
+	assert.NoError(t, err)
+
+	assert.True(t, policy.Covers("admin", "moderator"))
+	assert.True(t, policy.Covers("Moderator", "auditor"))
+	assert.False(t, policy.Covers("moderator", "employee"))
+	assert.False(t, policy.Covers("moderator", "admin"))
+	assert.False(t, policy.Covers("moderator", "ghost"))
+	assert.False(t, policy.Covers("ghost", "auditor"))
+
+	// employee доступна через регистрацию, поэтому модератор управляет ею, хотя прав product:add у него нет
+	assert.True(t, policy.CanManage("moderator", "employee"))
+	assert.True(t, policy.CanManage("moderator", "auditor"))
+	assert.False(t, policy.CanManage("moderator", "admin"))
+	assert.True(t, policy.CanManage("admin", "admin"))
+}
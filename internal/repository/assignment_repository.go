@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+)
+
+// AssignmentRepository хранит назначения сотрудников на ПВЗ.
+type AssignmentRepository interface {
+	// AssignUser идемпотентен: повторное назначение не считается ошибкой.
+	AssignUser(ctx context.Context, userID string, pvzID string) error
+	// UnassignUser возвращает false, если назначения не было.
+	UnassignUser(ctx context.Context, userID string, pvzID string) (bool, error)
+	IsAssigned(ctx context.Context, userID string, pvzID string) (bool, error)
+	GetAssignedPVZIDs(ctx context.Context, userID string) ([]string, error)
+}
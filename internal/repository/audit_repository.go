@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+)
+
+// AuditRepository - журнал только на добавление: изменять и удалять записи нельзя.
+type AuditRepository interface {
+	AddEntry(ctx context.Context, entry domain.AuditEntry) error
+	GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+}
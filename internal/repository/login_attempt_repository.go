@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// LoginAttemptRepository хранит счетчики неудачных попыток входа по ключам вида "email:..." и "ip:...".
+type LoginAttemptRepository interface {
+	// AddFailure атомарно увеличивает счетчик ключа и возвращает новое значение. Если с последней
+	// неудачи и с конца блокировки прошло больше window, счет начинается заново.
+	AddFailure(ctx context.Context, key string, now time.Time, window time.Duration) (int, error)
+	// Lock блокирует ключ до until. Уже действующая более длинная блокировка не сокращается.
+	Lock(ctx context.Context, key string, until time.Time) error
+	// LockedUntil возвращает нулевое время, если ключ никогда не блокировался.
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	Reset(ctx context.Context, key string) error
+	// DeleteStale удаляет записи, у которых и последняя неудача, и блокировка раньше before.
+	DeleteStale(ctx context.Context, before time.Time) (int64, error)
+}
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/anton/avito-tech-spring/internal/repository/assignment_repository.go
+//
+// Generated by this command:
+//
+//	mockgen --source=/home/anton/avito-tech-spring/internal/repository/assignment_repository.go --destination=/home/anton/avito-tech-spring/internal/repository/mock/assignment_repository.go --package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssignmentRepository is a mock of AssignmentRepository interface.
+type MockAssignmentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAssignmentRepositoryMockRecorder is the mock recorder for MockAssignmentRepository.
+type MockAssignmentRepositoryMockRecorder struct {
+	mock *MockAssignmentRepository
+}
+
+// NewMockAssignmentRepository creates a new mock instance.
+func NewMockAssignmentRepository(ctrl *gomock.Controller) *MockAssignmentRepository {
+	mock := &MockAssignmentRepository{ctrl: ctrl}
+	mock.recorder = &MockAssignmentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentRepository) EXPECT() *MockAssignmentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AssignUser mocks base method.
+func (m *MockAssignmentRepository) AssignUser(ctx context.Context, userID, pvzID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignUser", ctx, userID, pvzID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignUser indicates an expected call of AssignUser.
+func (mr *MockAssignmentRepositoryMockRecorder) AssignUser(ctx, userID, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignUser", reflect.TypeOf((*MockAssignmentRepository)(nil).AssignUser), ctx, userID, pvzID)
+}
+
+// GetAssignedPVZIDs mocks base method.
+func (m *MockAssignmentRepository) GetAssignedPVZIDs(ctx context.Context, userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedPVZIDs", ctx, userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedPVZIDs indicates an expected call of GetAssignedPVZIDs.
+func (mr *MockAssignmentRepositoryMockRecorder) GetAssignedPVZIDs(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedPVZIDs", reflect.TypeOf((*MockAssignmentRepository)(nil).GetAssignedPVZIDs), ctx, userID)
+}
+
+// IsAssigned mocks base method.
+func (m *MockAssignmentRepository) IsAssigned(ctx context.Context, userID, pvzID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAssigned", ctx, userID, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAssigned indicates an expected call of IsAssigned.
+func (mr *MockAssignmentRepositoryMockRecorder) IsAssigned(ctx, userID, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAssigned", reflect.TypeOf((*MockAssignmentRepository)(nil).IsAssigned), ctx, userID, pvzID)
+}
+
+// UnassignUser mocks base method.
+func (m *MockAssignmentRepository) UnassignUser(ctx context.Context, userID, pvzID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignUser", ctx, userID, pvzID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignUser indicates an expected call of UnassignUser.
+func (mr *MockAssignmentRepositoryMockRecorder) UnassignUser(ctx, userID, pvzID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignUser", reflect.TypeOf((*MockAssignmentRepository)(nil).UnassignUser), ctx, userID, pvzID)
+}
@@ -42,26 +42,42 @@ func (m *MockProductRepository) EXPECT() *MockProductRepositoryMockRecorder {
 }
 
 // CreateProduct mocks base method.
-func (m *MockProductRepository) CreateProduct(ctx context.Context, productType, receptionID string) (*domain.Product, error) {
+func (m *MockProductRepository) CreateProduct(ctx context.Context, item domain.ProductItem, receptionID string) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateProduct", ctx, productType, receptionID)
+	ret := m.ctrl.Call(m, "CreateProduct", ctx, item, receptionID)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateProduct indicates an expected call of CreateProduct.
-func (mr *MockProductRepositoryMockRecorder) CreateProduct(ctx, productType, receptionID any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) CreateProduct(ctx, item, receptionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductRepository)(nil).CreateProduct), ctx, productType, receptionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProduct", reflect.TypeOf((*MockProductRepository)(nil).CreateProduct), ctx, item, receptionID)
+}
+
+// CreateProducts mocks base method.
+func (m *MockProductRepository) CreateProducts(ctx context.Context, items []domain.ProductItem, receptionID string) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProducts", ctx, items, receptionID)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProducts indicates an expected call of CreateProducts.
+func (mr *MockProductRepositoryMockRecorder) CreateProducts(ctx, items, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProducts", reflect.TypeOf((*MockProductRepository)(nil).CreateProducts), ctx, items, receptionID)
 }
 
 // DeleteProduct mocks base method.
-func (m *MockProductRepository) DeleteProduct(ctx context.Context, productID string) error {
+func (m *MockProductRepository) DeleteProduct(ctx context.Context, productID string) (*domain.Product, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteProduct", ctx, productID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteProduct indicates an expected call of DeleteProduct.
@@ -70,19 +86,49 @@ func (mr *MockProductRepositoryMockRecorder) DeleteProduct(ctx, productID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockProductRepository)(nil).DeleteProduct), ctx, productID)
 }
 
+// FindProductsByBarcode mocks base method.
+func (m *MockProductRepository) FindProductsByBarcode(ctx context.Context, barcode string, pvzIDs []string) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcode", ctx, barcode, pvzIDs)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcode indicates an expected call of FindProductsByBarcode.
+func (mr *MockProductRepositoryMockRecorder) FindProductsByBarcode(ctx, barcode, pvzIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcode", reflect.TypeOf((*MockProductRepository)(nil).FindProductsByBarcode), ctx, barcode, pvzIDs)
+}
+
 // FindTheLastProduct mocks base method.
-func (m *MockProductRepository) FindTheLastProduct(ctx context.Context, pvzID string) (*domain.Product, error) {
+func (m *MockProductRepository) FindTheLastProduct(ctx context.Context, receptionID string) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindTheLastProduct", ctx, pvzID)
+	ret := m.ctrl.Call(m, "FindTheLastProduct", ctx, receptionID)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FindTheLastProduct indicates an expected call of FindTheLastProduct.
-func (mr *MockProductRepositoryMockRecorder) FindTheLastProduct(ctx, pvzID any) *gomock.Call {
+func (mr *MockProductRepositoryMockRecorder) FindTheLastProduct(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTheLastProduct", reflect.TypeOf((*MockProductRepository)(nil).FindTheLastProduct), ctx, receptionID)
+}
+
+// GetProduct mocks base method.
+func (m *MockProductRepository) GetProduct(ctx context.Context, productID string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", ctx, productID)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockProductRepositoryMockRecorder) GetProduct(ctx, productID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTheLastProduct", reflect.TypeOf((*MockProductRepository)(nil).FindTheLastProduct), ctx, pvzID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockProductRepository)(nil).GetProduct), ctx, productID)
 }
 
 // GetProducts mocks base method.
@@ -99,3 +145,18 @@ func (mr *MockProductRepositoryMockRecorder) GetProducts(ctx, receptionID any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockProductRepository)(nil).GetProducts), ctx, receptionID)
 }
+
+// RestoreProduct mocks base method.
+func (m *MockProductRepository) RestoreProduct(ctx context.Context, productID string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, productID)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockProductRepositoryMockRecorder) RestoreProduct(ctx, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockProductRepository)(nil).RestoreProduct), ctx, productID)
+}
@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: /home/anton/avito-tech-spring/internal/repository/product_type_repository.go
+//
+// Generated by this command:
+//
+//	mockgen --source=/home/anton/avito-tech-spring/internal/repository/product_type_repository.go --destination=/home/anton/avito-tech-spring/internal/repository/mock/product_type_repository.go --package=mock
+//
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProductTypeRepository is a mock of ProductTypeRepository interface.
+type MockProductTypeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProductTypeRepositoryMockRecorder is the mock recorder for MockProductTypeRepository.
+type MockProductTypeRepositoryMockRecorder struct {
+	mock *MockProductTypeRepository
+}
+
+// NewMockProductTypeRepository creates a new mock instance.
+func NewMockProductTypeRepository(ctrl *gomock.Controller) *MockProductTypeRepository {
+	mock := &MockProductTypeRepository{ctrl: ctrl}
+	mock.recorder = &MockProductTypeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeRepository) EXPECT() *MockProductTypeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeRepository) CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, productType)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeRepositoryMockRecorder) CreateProductType(ctx, productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeRepository)(nil).CreateProductType), ctx, productType)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockProductTypeRepository) DeleteProductType(ctx context.Context, name string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, name)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockProductTypeRepositoryMockRecorder) DeleteProductType(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockProductTypeRepository)(nil).DeleteProductType), ctx, name)
+}
+
+// GetProductType mocks base method.
+func (m *MockProductTypeRepository) GetProductType(ctx context.Context, name string) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductType", ctx, name)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductType indicates an expected call of GetProductType.
+func (mr *MockProductTypeRepositoryMockRecorder) GetProductType(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductType", reflect.TypeOf((*MockProductTypeRepository)(nil).GetProductType), ctx, name)
+}
+
+// GetProductTypes mocks base method.
+func (m *MockProductTypeRepository) GetProductTypes(ctx context.Context, names []string) ([]domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductTypes", ctx, names)
+	ret0, _ := ret[0].([]domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductTypes indicates an expected call of GetProductTypes.
+func (mr *MockProductTypeRepositoryMockRecorder) GetProductTypes(ctx, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductTypes", reflect.TypeOf((*MockProductTypeRepository)(nil).GetProductTypes), ctx, names)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockProductTypeRepository) ListProductTypes(ctx context.Context) ([]domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockProductTypeRepositoryMockRecorder) ListProductTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockProductTypeRepository)(nil).ListProductTypes), ctx)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockProductTypeRepository) UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, productType)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockProductTypeRepositoryMockRecorder) UpdateProductType(ctx, productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockProductTypeRepository)(nil).UpdateProductType), ctx, productType)
+}
@@ -41,34 +41,34 @@ func (m *MockPvzRepository) EXPECT() *MockPvzRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreatePVZ mocks base method.
-func (m *MockPvzRepository) CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error) {
+// CountPVZS mocks base method.
+func (m *MockPvzRepository) CountPVZS(ctx context.Context, filter domain.PvzFilter) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePVZ", ctx, city)
-	ret0, _ := ret[0].(*domain.Pvz)
+	ret := m.ctrl.Call(m, "CountPVZS", ctx, filter)
+	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// CreatePVZ indicates an expected call of CreatePVZ.
-func (mr *MockPvzRepositoryMockRecorder) CreatePVZ(ctx, city any) *gomock.Call {
+// CountPVZS indicates an expected call of CountPVZS.
+func (mr *MockPvzRepositoryMockRecorder) CountPVZS(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockPvzRepository)(nil).CreatePVZ), ctx, city)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPVZS", reflect.TypeOf((*MockPvzRepository)(nil).CountPVZS), ctx, filter)
 }
 
-// GetListOfPVZS mocks base method.
-func (m *MockPvzRepository) GetListOfPVZS(ctx context.Context) ([]domain.Pvz, error) {
+// CreatePVZ mocks base method.
+func (m *MockPvzRepository) CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetListOfPVZS", ctx)
-	ret0, _ := ret[0].([]domain.Pvz)
+	ret := m.ctrl.Call(m, "CreatePVZ", ctx, city)
+	ret0, _ := ret[0].(*domain.Pvz)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetListOfPVZS indicates an expected call of GetListOfPVZS.
-func (mr *MockPvzRepositoryMockRecorder) GetListOfPVZS(ctx any) *gomock.Call {
+// CreatePVZ indicates an expected call of CreatePVZ.
+func (mr *MockPvzRepositoryMockRecorder) CreatePVZ(ctx, city any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListOfPVZS", reflect.TypeOf((*MockPvzRepository)(nil).GetListOfPVZS), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockPvzRepository)(nil).CreatePVZ), ctx, city)
 }
 
 // GetPVZ mocks base method.
@@ -87,16 +87,31 @@ func (mr *MockPvzRepositoryMockRecorder) GetPVZ(ctx, id any) *gomock.Call {
 }
 
 // GetPVZS mocks base method.
-func (m *MockPvzRepository) GetPVZS(ctx context.Context, offset, limit int) ([]domain.Pvz, error) {
+func (m *MockPvzRepository) GetPVZS(ctx context.Context, filter domain.PvzFilter) ([]domain.Pvz, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZS", ctx, offset, limit)
+	ret := m.ctrl.Call(m, "GetPVZS", ctx, filter)
 	ret0, _ := ret[0].([]domain.Pvz)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPVZS indicates an expected call of GetPVZS.
-func (mr *MockPvzRepositoryMockRecorder) GetPVZS(ctx, offset, limit any) *gomock.Call {
+func (mr *MockPvzRepositoryMockRecorder) GetPVZS(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZS", reflect.TypeOf((*MockPvzRepository)(nil).GetPVZS), ctx, filter)
+}
+
+// GetPVZSInfo mocks base method.
+func (m *MockPvzRepository) GetPVZSInfo(ctx context.Context, filter domain.PvzFilter) ([]domain.PvzInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZSInfo", ctx, filter)
+	ret0, _ := ret[0].([]domain.PvzInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZSInfo indicates an expected call of GetPVZSInfo.
+func (mr *MockPvzRepositoryMockRecorder) GetPVZSInfo(ctx, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZS", reflect.TypeOf((*MockPvzRepository)(nil).GetPVZS), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfo", reflect.TypeOf((*MockPvzRepository)(nil).GetPVZSInfo), ctx, filter)
 }
@@ -42,6 +42,21 @@ func (m *MockReceptionRepository) EXPECT() *MockReceptionRepositoryMockRecorder
 	return m.recorder
 }
 
+// AddStatusChange mocks base method.
+func (m *MockReceptionRepository) AddStatusChange(ctx context.Context, change domain.ReceptionStatusChange) (*domain.ReceptionStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStatusChange", ctx, change)
+	ret0, _ := ret[0].(*domain.ReceptionStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddStatusChange indicates an expected call of AddStatusChange.
+func (mr *MockReceptionRepositoryMockRecorder) AddStatusChange(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStatusChange", reflect.TypeOf((*MockReceptionRepository)(nil).AddStatusChange), ctx, change)
+}
+
 // CreateReception mocks base method.
 func (m *MockReceptionRepository) CreateReception(ctx context.Context, pvzID string) (*domain.Reception, error) {
 	m.ctrl.T.Helper()
@@ -72,8 +87,23 @@ func (mr *MockReceptionRepositoryMockRecorder) FindOpen(ctx, pvzID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpen", reflect.TypeOf((*MockReceptionRepository)(nil).FindOpen), ctx, pvzID)
 }
 
+// GetReception mocks base method.
+func (m *MockReceptionRepository) GetReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReception", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReception indicates an expected call of GetReception.
+func (mr *MockReceptionRepositoryMockRecorder) GetReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReception", reflect.TypeOf((*MockReceptionRepository)(nil).GetReception), ctx, receptionID)
+}
+
 // GetReceptionsFiltered mocks base method.
-func (m *MockReceptionRepository) GetReceptionsFiltered(ctx context.Context, pvzID string, startTime, endTime time.Time) ([]*domain.Reception, error) {
+func (m *MockReceptionRepository) GetReceptionsFiltered(ctx context.Context, pvzID string, startTime, endTime *time.Time) ([]*domain.Reception, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetReceptionsFiltered", ctx, pvzID, startTime, endTime)
 	ret0, _ := ret[0].([]*domain.Reception)
@@ -87,16 +117,32 @@ func (mr *MockReceptionRepositoryMockRecorder) GetReceptionsFiltered(ctx, pvzID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionsFiltered", reflect.TypeOf((*MockReceptionRepository)(nil).GetReceptionsFiltered), ctx, pvzID, startTime, endTime)
 }
 
+// GetStatusHistory mocks base method.
+func (m *MockReceptionRepository) GetStatusHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatusHistory", ctx, receptionID)
+	ret0, _ := ret[0].([]domain.ReceptionStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatusHistory indicates an expected call of GetStatusHistory.
+func (mr *MockReceptionRepositoryMockRecorder) GetStatusHistory(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatusHistory", reflect.TypeOf((*MockReceptionRepository)(nil).GetStatusHistory), ctx, receptionID)
+}
+
 // UpdateReceptionStatus mocks base method.
-func (m *MockReceptionRepository) UpdateReceptionStatus(ctx context.Context, receptionID, newStatus string) error {
+func (m *MockReceptionRepository) UpdateReceptionStatus(ctx context.Context, receptionID, fromStatus, toStatus string) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateReceptionStatus", ctx, receptionID, newStatus)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "UpdateReceptionStatus", ctx, receptionID, fromStatus, toStatus)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // UpdateReceptionStatus indicates an expected call of UpdateReceptionStatus.
-func (mr *MockReceptionRepositoryMockRecorder) UpdateReceptionStatus(ctx, receptionID, newStatus any) *gomock.Call {
+func (mr *MockReceptionRepositoryMockRecorder) UpdateReceptionStatus(ctx, receptionID, fromStatus, toStatus any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceptionStatus", reflect.TypeOf((*MockReceptionRepository)(nil).UpdateReceptionStatus), ctx, receptionID, newStatus)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceptionStatus", reflect.TypeOf((*MockReceptionRepository)(nil).UpdateReceptionStatus), ctx, receptionID, fromStatus, toStatus)
 }
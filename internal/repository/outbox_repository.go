@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+)
+
+type OutboxRepository interface {
+	AddEvent(ctx context.Context, eventType string, pvzID string, payload []byte) error
+	FetchPending(ctx context.Context, limit int, maxAttempts int) ([]domain.OutboxEvent, error)
+	MarkProcessed(ctx context.Context, eventID string) error
+	MarkFailed(ctx context.Context, eventID string, reason string, nextAttemptAt time.Time) error
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+type PasswordResetRepository interface {
+	CreateResetToken(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) error
+	// ConsumeResetToken помечает токен использованным и возвращает его владельца.
+	// Пустая строка, если токен не найден, истек или уже использован.
+	ConsumeResetToken(ctx context.Context, tokenHash string) (userID string, err error)
+	// InvalidateUserResetTokens гасит все неиспользованные токены пользователя.
+	InvalidateUserResetTokens(ctx context.Context, userID string) error
+}
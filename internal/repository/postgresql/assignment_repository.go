@@ -0,0 +1,173 @@
+package postgresql
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresAssignmentRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresAssignmentRepository(manager repository.CtxManager, logger *slog.Logger) repository.AssignmentRepository {
+	return &postgresAssignmentRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+func (p *postgresAssignmentRepository) AssignUser(ctx context.Context, userID string, pvzID string) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("pvz_assignment").
+		Columns("user_id", "pvz_id").
+		Values(userID, pvzID).
+		Suffix("ON CONFLICT (user_id, pvz_id) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for AssignUser",
+			slog.String("userID", userID),
+			slog.String("pvzID", pvzID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for AssignUser",
+			slog.String("userID", userID),
+			slog.String("pvzID", pvzID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Successfully assigned user to PVZ", slog.String("userID", userID), slog.String("pvzID", pvzID))
+
+	return nil
+}
+
+func (p *postgresAssignmentRepository) UnassignUser(ctx context.Context, userID string, pvzID string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Delete("pvz_assignment").
+		Where(squirrel.Eq{"user_id": userID, "pvz_id": pvzID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for UnassignUser",
+			slog.String("userID", userID),
+			slog.String("pvzID", pvzID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for UnassignUser",
+			slog.String("userID", userID),
+			slog.String("pvzID", pvzID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (p *postgresAssignmentRepository) IsAssigned(ctx context.Context, userID string, pvzID string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("1").
+		From("pvz_assignment").
+		Where(squirrel.Eq{"user_id": userID, "pvz_id": pvzID}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for IsAssigned",
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	var assigned bool
+	err = exec.QueryRow(ctx, query, args...).Scan(&assigned)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for IsAssigned",
+			slog.String("userID", userID),
+			slog.String("pvzID", pvzID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return assigned, nil
+}
+
+func (p *postgresAssignmentRepository) GetAssignedPVZIDs(ctx context.Context, userID string) ([]string, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("pvz_id").
+		From("pvz_assignment").
+		Where(squirrel.Eq{"user_id": userID}).
+		OrderBy("pvz_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetAssignedPVZIDs",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for GetAssignedPVZIDs",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var pvzIDs []string
+	for rows.Next() {
+		var pvzID string
+		err = rows.Scan(&pvzID)
+		if err != nil {
+			p.logger.Error("Failed to scan row in GetAssignedPVZIDs",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		pvzIDs = append(pvzIDs, pvzID)
+	}
+
+	return pvzIDs, nil
+}
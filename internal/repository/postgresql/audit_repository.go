@@ -0,0 +1,155 @@
+package postgresql
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresAuditRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresAuditRepository(manager repository.CtxManager, logger *slog.Logger) repository.AuditRepository {
+	return &postgresAuditRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+func (p *postgresAuditRepository) AddEntry(ctx context.Context, entry domain.AuditEntry) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("audit_log").
+		Columns("user_id", "role", "dummy", "action", "pvz_id", "reception_id", "product_id", "target_user_id").
+		Values(nullIfEmpty(entry.UserID), nullIfEmpty(entry.Role), entry.Dummy, entry.Action, entry.PvzID,
+			nullIfEmpty(entry.ReceptionID), nullIfEmpty(entry.ProductID), nullIfEmpty(entry.TargetUserID)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for AddEntry",
+			slog.String("action", entry.Action),
+			slog.String("pvzID", entry.PvzID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for AddEntry",
+			slog.String("action", entry.Action),
+			slog.String("pvzID", entry.PvzID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresAuditRepository) GetEntries(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	builder := squirrel.
+		Select("id", "user_id", "role", "dummy", "action", "pvz_id", "reception_id", "product_id",
+			"target_user_id", "created_at").
+		From("audit_log").
+		OrderBy("id DESC").
+		Limit(uint64(filter.Limit))
+
+	if filter.UserID != "" {
+		builder = builder.Where(squirrel.Eq{"user_id": filter.UserID})
+	}
+	if len(filter.PvzIDs) > 0 {
+		builder = builder.Where(squirrel.Eq{"pvz_id": filter.PvzIDs})
+	}
+	if filter.From != nil {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": *filter.From})
+	}
+	if filter.To != nil {
+		builder = builder.Where(squirrel.LtOrEq{"created_at": *filter.To})
+	}
+	if filter.BeforeID != "" {
+		builder = builder.Where(squirrel.Lt{"id": filter.BeforeID})
+	}
+
+	query, args, err := builder.PlaceholderFormat(squirrel.Dollar).ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetEntries",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for GetEntries",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var entries []domain.AuditEntry
+	for rows.Next() {
+		var (
+			entry        domain.AuditEntry
+			userID       *string
+			role         *string
+			receptionID  *string
+			productID    *string
+			targetUserID *string
+		)
+		err = rows.Scan(&entry.ID, &userID, &role, &entry.Dummy, &entry.Action, &entry.PvzID, &receptionID,
+			&productID, &targetUserID, &entry.CreatedAt)
+		if err != nil {
+			p.logger.Error("Failed to scan row in GetEntries",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		entry.UserID = derefString(userID)
+		entry.Role = derefString(role)
+		entry.ReceptionID = derefString(receptionID)
+		entry.ProductID = derefString(productID)
+		entry.TargetUserID = derefString(targetUserID)
+		entries = append(entries, entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Error("Failed to iterate rows in GetEntries",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return entries, nil
+}
+
+// nullIfEmpty пишет NULL вместо пустой строки в необязательные колонки.
+func nullIfEmpty(value string) any {
+	if value == "" {
+		return nil
+	}
+	return value
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
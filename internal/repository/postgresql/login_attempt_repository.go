@@ -0,0 +1,205 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresLoginAttemptRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresLoginAttemptRepository(manager repository.CtxManager, logger *slog.Logger) repository.LoginAttemptRepository {
+	return &postgresLoginAttemptRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+// AddFailure считает попытки одним upsert, поэтому параллельные неудачные входы не теряют инкременты.
+func (p *postgresLoginAttemptRepository) AddFailure(ctx context.Context, key string, now time.Time, window time.Duration) (int, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("login_attempt").
+		Columns("key", "failures", "last_failure_at").
+		Values(key, 1, now).
+		Suffix(`ON CONFLICT (key) DO UPDATE SET
+			failures = CASE
+				WHEN GREATEST(login_attempt.last_failure_at, COALESCE(login_attempt.locked_until, login_attempt.last_failure_at)) < ?
+				THEN 1
+				ELSE login_attempt.failures + 1
+			END,
+			last_failure_at = EXCLUDED.last_failure_at
+			RETURNING failures`, now.Add(-window)).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for AddFailure",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	var failures int
+	if err := exec.QueryRow(ctx, query, args...).Scan(&failures); err != nil {
+		p.logger.Error("Failed to execute SQL query for AddFailure",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return failures, nil
+}
+
+func (p *postgresLoginAttemptRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("login_attempt").
+		Set("locked_until", squirrel.Expr("GREATEST(COALESCE(locked_until, ?), ?)", until, until)).
+		Where(squirrel.Eq{"key": key}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for Lock",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for Lock",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Warn("Login key locked",
+		slog.String("key", key),
+		slog.Time("until", until))
+
+	return nil
+}
+
+func (p *postgresLoginAttemptRepository) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("locked_until").
+		From("login_attempt").
+		Where(squirrel.Eq{"key": key}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for LockedUntil",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return time.Time{}, err
+	}
+
+	var lockedUntil *time.Time
+	err = exec.QueryRow(ctx, query, args...).Scan(&lockedUntil)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, nil
+		}
+		p.logger.Error("Failed to execute SQL query for LockedUntil",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return time.Time{}, err
+	}
+
+	if lockedUntil == nil {
+		return time.Time{}, nil
+	}
+
+	return *lockedUntil, nil
+}
+
+func (p *postgresLoginAttemptRepository) Reset(ctx context.Context, key string) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Delete("login_attempt").
+		Where(squirrel.Eq{"key": key}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for Reset",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for Reset",
+			slog.String("key", key),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresLoginAttemptRepository) DeleteStale(ctx context.Context, before time.Time) (int64, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Delete("login_attempt").
+		Where(squirrel.Lt{"last_failure_at": before}).
+		Where(squirrel.Or{
+			squirrel.Eq{"locked_until": nil},
+			squirrel.Lt{"locked_until": before},
+		}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for DeleteStale",
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for DeleteStale",
+			slog.String("error", err.Error()))
+		return 0, err
+	}
+
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,184 @@
+package postgresql
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresOutboxRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresOutboxRepository(manager repository.CtxManager, logger *slog.Logger) repository.OutboxRepository {
+	return &postgresOutboxRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+func (p *postgresOutboxRepository) AddEvent(ctx context.Context, eventType string, pvzID string, payload []byte) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("outbox").
+		Columns("event_type", "pvz_id", "payload").
+		Values(eventType, pvzID, payload).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for AddEvent",
+			slog.String("event_type", eventType),
+			slog.String("pvz_id", pvzID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for AddEvent",
+			slog.String("event_type", eventType),
+			slog.String("pvz_id", pvzID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Successfully added outbox event",
+		slog.String("event_type", eventType),
+		slog.String("pvz_id", pvzID))
+
+	return nil
+}
+
+// FetchPending блокирует выбранные строки до конца транзакции (SKIP LOCKED),
+// поэтому несколько экземпляров релея не отправят одно событие параллельно.
+func (p *postgresOutboxRepository) FetchPending(ctx context.Context, limit int, maxAttempts int) ([]domain.OutboxEvent, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("id", "event_type", "pvz_id", "payload", "attempts", "created_at").
+		From("outbox").
+		Where(squirrel.Eq{"processed_at": nil}).
+		Where(squirrel.Lt{"attempts": maxAttempts}).
+		Where(squirrel.Expr("next_attempt_at <= NOW()")).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE SKIP LOCKED").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for FetchPending",
+			slog.Int("limit", limit),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for FetchPending",
+			slog.Int("limit", limit),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []domain.OutboxEvent
+	for rows.Next() {
+		var event domain.OutboxEvent
+		if err := rows.Scan(&event.ID, &event.EventType, &event.PvzID, &event.Payload, &event.Attempts, &event.CreatedAt); err != nil {
+			p.logger.Error("Failed to scan row in FetchPending",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (p *postgresOutboxRepository) MarkProcessed(ctx context.Context, eventID string) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("outbox").
+		Set("processed_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": eventID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for MarkProcessed",
+			slog.String("event_id", eventID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for MarkProcessed",
+			slog.String("event_id", eventID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+func (p *postgresOutboxRepository) MarkFailed(ctx context.Context, eventID string, reason string, nextAttemptAt time.Time) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("outbox").
+		Set("attempts", squirrel.Expr("attempts + 1")).
+		Set("last_error", reason).
+		Set("next_attempt_at", nextAttemptAt).
+		Where(squirrel.Eq{"id": eventID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for MarkFailed",
+			slog.String("event_id", eventID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for MarkFailed",
+			slog.String("event_id", eventID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Warn("Outbox event delivery failed",
+		slog.String("event_id", eventID),
+		slog.String("reason", reason),
+		slog.Time("next_attempt_at", nextAttemptAt))
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresPasswordResetRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresPasswordResetRepository(manager repository.CtxManager, logger *slog.Logger) repository.PasswordResetRepository {
+	return &postgresPasswordResetRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+func (p *postgresPasswordResetRepository) CreateResetToken(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("password_reset_token").
+		Columns("user_id", "token_hash", "expires_at").
+		Values(userID, tokenHash, expiresAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for CreateResetToken",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for CreateResetToken",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Successfully created password reset token", slog.String("userID", userID))
+
+	return nil
+}
+
+// ConsumeResetToken проверяет и гасит токен одним UPDATE, поэтому один токен нельзя использовать дважды
+// даже при параллельных запросах.
+func (p *postgresPasswordResetRepository) ConsumeResetToken(ctx context.Context, tokenHash string) (string, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("password_reset_token").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"token_hash": tokenHash, "used_at": nil}).
+		Where(squirrel.Expr("expires_at > NOW()")).
+		Suffix("RETURNING user_id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for ConsumeResetToken",
+			slog.String("error", err.Error()))
+		return "", err
+	}
+
+	var userID string
+	err = exec.QueryRow(ctx, query, args...).Scan(&userID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		p.logger.Error("Failed to execute SQL query for ConsumeResetToken",
+			slog.String("error", err.Error()))
+		return "", err
+	}
+
+	return userID, nil
+}
+
+func (p *postgresPasswordResetRepository) InvalidateUserResetTokens(ctx context.Context, userID string) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("password_reset_token").
+		Set("used_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"user_id": userID, "used_at": nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for InvalidateUserResetTokens",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for InvalidateUserResetTokens",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
@@ -9,8 +9,16 @@ import (
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
 	"github.com/Ranik23/avito-tech-spring/internal/repository"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// productColumns выбирает необязательные штрихкод и номер заказа как пустые строки вместо NULL.
+const productColumns = "product.id, product.date_time, product.type, product.reception_id, " +
+	"COALESCE(product.barcode, ''), COALESCE(product.order_id, ''), product.deleted_at"
+
+// uniqueViolationCode - SQLSTATE нарушения уникального индекса.
+const uniqueViolationCode = "23505"
+
 type postgresProductRepository struct {
 	ctxManager repository.CtxManager
 	logger     *slog.Logger
@@ -23,7 +31,7 @@ func NewPostgresProductRepository(manager repository.CtxManager, logger *slog.Lo
 	}
 }
 
-func (p *postgresProductRepository) CreateProduct(ctx context.Context, productType string, receptionID string) (*domain.Product, error) {
+func (p *postgresProductRepository) CreateProduct(ctx context.Context, item domain.ProductItem, receptionID string) (*domain.Product, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
@@ -31,42 +39,95 @@ func (p *postgresProductRepository) CreateProduct(ctx context.Context, productTy
 
 	exec := tr.Begin().(pgx.Tx)
 
-	query, args, err := squirrel.
-		Insert("product").
-		Columns("type", "reception_id").
-		Values(productType, receptionID).
-		Suffix("RETURNING id, type, reception_id, date_time").
-		PlaceholderFormat(squirrel.Dollar).
-		ToSql()
-
+	query, args, err := insertProductQuery(item, receptionID)
 	if err != nil {
 		p.logger.Error("Failed to build SQL query for CreateProduct",
-			slog.String("product_type", productType),
+			slog.String("product_type", item.Type),
 			slog.String("reception_id", receptionID),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	var product domain.Product
-	err = exec.QueryRow(ctx, query, args...).Scan(&product.ID, &product.Type, &product.ReceptionID, &product.DateTime)
+	product, err := scanProduct(exec.QueryRow(ctx, query, args...))
 	if err != nil {
 		p.logger.Error("Failed to execute SQL query for CreateProduct",
-			slog.String("product_type", productType),
+			slog.String("product_type", item.Type),
+			slog.String("barcode", item.Barcode),
 			slog.String("reception_id", receptionID),
 			slog.String("error", err.Error()))
+		if isUniqueViolation(err) {
+			return nil, repository.ErrAlreadyExists
+		}
 		return nil, err
 	}
 
 	p.logger.Info("Successfully created product",
 		slog.String("id", product.ID),
 		slog.String("type", product.Type),
+		slog.String("barcode", product.Barcode),
 		slog.String("reception_id", product.ReceptionID),
 		slog.Time("date_time", product.DateTime))
 
-	return &product, nil
+	return product, nil
+}
+
+// CreateProducts вставляет товары одним pgx.Batch (один round-trip) и возвращает их в порядке items.
+func (p *postgresProductRepository) CreateProducts(ctx context.Context, items []domain.ProductItem, receptionID string) ([]domain.Product, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	batch := &pgx.Batch{}
+	for _, item := range items {
+		query, args, err := insertProductQuery(item, receptionID)
+		if err != nil {
+			p.logger.Error("Failed to build SQL query for CreateProducts",
+				slog.String("product_type", item.Type),
+				slog.String("reception_id", receptionID),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		batch.Queue(query, args...)
+	}
+
+	results := exec.SendBatch(ctx, batch)
+	defer results.Close()
+
+	products := make([]domain.Product, 0, len(items))
+	for range items {
+		product, err := scanProduct(results.QueryRow())
+		if err != nil {
+			p.logger.Error("Failed to execute SQL query for CreateProducts",
+				slog.String("reception_id", receptionID),
+				slog.Int("created", len(products)),
+				slog.String("error", err.Error()))
+			if isUniqueViolation(err) {
+				return nil, repository.ErrAlreadyExists
+			}
+			return nil, err
+		}
+		products = append(products, *product)
+	}
+
+	if err := results.Close(); err != nil {
+		p.logger.Error("Failed to close batch for CreateProducts",
+			slog.String("reception_id", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully created products",
+		slog.Int("count", len(products)),
+		slog.String("reception_id", receptionID))
+
+	return products, nil
 }
 
-func (p *postgresProductRepository) DeleteProduct(ctx context.Context, productID string) error {
+// DeleteProduct мягко удаляет товар: проставляет deleted_at. Возвращает nil, если товара нет или он уже удален.
+func (p *postgresProductRepository) DeleteProduct(ctx context.Context, productID string) (*domain.Product, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
@@ -75,60 +136,146 @@ func (p *postgresProductRepository) DeleteProduct(ctx context.Context, productID
 	exec := tr.Begin().(pgx.Tx)
 
 	query, args, err := squirrel.
-		Delete("product").
-		Where(squirrel.Eq{"id": productID}).
+		Update("product").
+		Set("deleted_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": productID, "deleted_at": nil}).
+		Suffix("RETURNING " + productColumns).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		p.logger.Error("Failed to build SQL query for DeleteProduct",
 			slog.String("product_id", productID),
 			slog.String("error", err.Error()))
-		return err
+		return nil, err
 	}
 
-	_, err = exec.Exec(ctx, query, args...)
+	product, err := scanProduct(exec.QueryRow(ctx, query, args...))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
 		p.logger.Error("Failed to execute SQL query for DeleteProduct",
 			slog.String("product_id", productID),
 			slog.String("error", err.Error()))
-		return err
+		return nil, err
 	}
 
 	p.logger.Info("Successfully deleted product",
 		slog.String("product_id", productID))
 
-	return nil
+	return product, nil
 }
 
-func (p *postgresProductRepository) FindTheLastProduct(ctx context.Context, pvzID string) (*domain.Product, error) {
+// RestoreProduct снимает отметку об удалении. Возвращает nil, если товара нет или он не удален,
+// и repository.ErrAlreadyExists, если его штрихкод уже занят в приемке другим товаром.
+func (p *postgresProductRepository) RestoreProduct(ctx context.Context, productID string) (*domain.Product, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
 	}
+
 	exec := tr.Begin().(pgx.Tx)
 
 	query, args, err := squirrel.
-		Select("product.id", "product.date_time", "type", "reception_id").
+		Update("product").
+		Set("deleted_at", nil).
+		Where(squirrel.Eq{"id": productID}).
+		Where(squirrel.NotEq{"deleted_at": nil}).
+		Suffix("RETURNING " + productColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for RestoreProduct",
+			slog.String("product_id", productID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	product, err := scanProduct(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for RestoreProduct",
+			slog.String("product_id", productID),
+			slog.String("error", err.Error()))
+		if isUniqueViolation(err) {
+			return nil, repository.ErrAlreadyExists
+		}
+		return nil, err
+	}
+
+	p.logger.Info("Successfully restored product",
+		slog.String("product_id", productID))
+
+	return product, nil
+}
+
+// GetProduct возвращает товар по id, в том числе удаленный. nil, если товара нет.
+func (p *postgresProductRepository) GetProduct(ctx context.Context, productID string) (*domain.Product, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select(productColumns).
 		From("product").
-		Join("reception on product.reception_id = reception.id").
-		Where(squirrel.Eq{"reception.pvz_id": pvzID}).
-		OrderBy("date_time DESC").
+		Where(squirrel.Eq{"id": productID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetProduct",
+			slog.String("product_id", productID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	product, err := scanProduct(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for GetProduct",
+			slog.String("product_id", productID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return product, nil
+}
+
+// FindTheLastProduct возвращает последний неудаленный товар приемки. Порядок по id, а не по date_time:
+// id выдается последовательно, а время приема у товаров одной пачки совпадает.
+func (p *postgresProductRepository) FindTheLastProduct(ctx context.Context, receptionID string) (*domain.Product, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select(productColumns).
+		From("product").
+		Where(squirrel.Eq{"reception_id": receptionID, "deleted_at": nil}).
+		OrderBy("id DESC").
 		Limit(1).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 
 	if err != nil {
 		p.logger.Error("Failed to build SQL query for FindTheLastProduct",
-			slog.String("pvz_id", pvzID),
+			slog.String("reception_id", receptionID),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	var prod domain.Product
-	err = exec.QueryRow(ctx, query, args...).Scan(&prod.ID, &prod.DateTime, &prod.Type, &prod.ReceptionID)
+	prod, err := scanProduct(exec.QueryRow(ctx, query, args...))
 	if err != nil {
 		p.logger.Error("Failed to execute SQL query for FindTheLastProduct",
-			slog.String("pvz_id", pvzID),
+			slog.String("reception_id", receptionID),
 			slog.String("error", err.Error()))
 
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -143,7 +290,7 @@ func (p *postgresProductRepository) FindTheLastProduct(ctx context.Context, pvzI
 		slog.String("reception_id", prod.ReceptionID),
 		slog.Time("date_time", prod.DateTime))
 
-	return &prod, nil
+	return prod, nil
 }
 
 func (p *postgresProductRepository) GetProducts(ctx context.Context, receptionID string) ([]domain.Product, error) {
@@ -154,9 +301,10 @@ func (p *postgresProductRepository) GetProducts(ctx context.Context, receptionID
 	exec := tr.Begin().(pgx.Tx)
 
 	query, args, err := squirrel.
-		Select("id", "date_time", "type", "reception_id").
+		Select(productColumns).
 		From("product").
-		Where(squirrel.Eq{"reception_id": receptionID}).
+		Where(squirrel.Eq{"reception_id": receptionID, "deleted_at": nil}).
+		OrderBy("id").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 
@@ -178,14 +326,14 @@ func (p *postgresProductRepository) GetProducts(ctx context.Context, receptionID
 
 	var products []domain.Product
 	for rows.Next() {
-		var prod domain.Product
-		if err := rows.Scan(&prod.ID, &prod.DateTime, &prod.Type, &prod.ReceptionID); err != nil {
+		prod, err := scanProduct(rows)
+		if err != nil {
 			p.logger.Error("Failed to scan row in GetProducts",
 				slog.String("reception_id", receptionID),
 				slog.String("error", err.Error()))
 			return nil, err
 		}
-		products = append(products, prod)
+		products = append(products, *prod)
 	}
 
 	p.logger.Info("Successfully retrieved list of products",
@@ -194,3 +342,93 @@ func (p *postgresProductRepository) GetProducts(ctx context.Context, receptionID
 
 	return products, nil
 }
+
+// FindProductsByBarcode ищет неудаленные товары по штрихкоду во всех приемках. Пустой pvzIDs означает поиск по всем ПВЗ.
+func (p *postgresProductRepository) FindProductsByBarcode(ctx context.Context, barcode string, pvzIDs []string) ([]domain.Product, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+	exec := tr.Begin().(pgx.Tx)
+
+	builder := squirrel.
+		Select(productColumns).
+		From("product").
+		Join("reception on product.reception_id = reception.id").
+		Where(squirrel.Eq{"product.barcode": barcode, "product.deleted_at": nil})
+
+	if pvzIDs != nil {
+		builder = builder.Where(squirrel.Eq{"reception.pvz_id": pvzIDs})
+	}
+
+	query, args, err := builder.
+		OrderBy("product.date_time DESC", "product.id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for FindProductsByBarcode",
+			slog.String("barcode", barcode),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for FindProductsByBarcode",
+			slog.String("barcode", barcode),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := make([]domain.Product, 0)
+	for rows.Next() {
+		prod, err := scanProduct(rows)
+		if err != nil {
+			p.logger.Error("Failed to scan row in FindProductsByBarcode",
+				slog.String("barcode", barcode),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		products = append(products, *prod)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Error("Failed to iterate rows in FindProductsByBarcode",
+			slog.String("barcode", barcode),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully found products by barcode",
+		slog.String("barcode", barcode),
+		slog.Int("count", len(products)))
+
+	return products, nil
+}
+
+func insertProductQuery(item domain.ProductItem, receptionID string) (string, []interface{}, error) {
+	return squirrel.
+		Insert("product").
+		Columns("type", "reception_id", "barcode", "order_id").
+		Values(item.Type, receptionID, nullIfEmpty(item.Barcode), nullIfEmpty(item.OrderID)).
+		Suffix("RETURNING " + productColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+}
+
+func scanProduct(row pgx.Row) (*domain.Product, error) {
+	var product domain.Product
+	err := row.Scan(&product.ID, &product.DateTime, &product.Type, &product.ReceptionID,
+		&product.Barcode, &product.OrderID, &product.DeletedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// isUniqueViolation сообщает, что вставка упала на уникальном индексе (например, повторный скан штрихкода).
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode
+}
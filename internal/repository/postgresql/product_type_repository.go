@@ -0,0 +1,241 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+const productTypeColumns = "name, fragile, max_weight_grams, created_at, updated_at"
+
+type postgresProductTypeRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresProductTypeRepository(manager repository.CtxManager, logger *slog.Logger) repository.ProductTypeRepository {
+	return &postgresProductTypeRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+func (p *postgresProductTypeRepository) ListProductTypes(ctx context.Context) ([]domain.ProductType, error) {
+	query, args, err := squirrel.Select(productTypeColumns).
+		From("product_type").
+		OrderBy("name").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for ListProductTypes", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return p.queryProductTypes(ctx, "ListProductTypes", query, args)
+}
+
+func (p *postgresProductTypeRepository) GetProductType(ctx context.Context, name string) (*domain.ProductType, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Select(productTypeColumns).
+		From("product_type").
+		Where(squirrel.Eq{"name": name}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetProductType",
+			slog.String("name", name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	productType, err := scanProductType(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for GetProductType",
+			slog.String("name", name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return productType, nil
+}
+
+func (p *postgresProductTypeRepository) GetProductTypes(ctx context.Context, names []string) ([]domain.ProductType, error) {
+	query, args, err := squirrel.Select(productTypeColumns).
+		From("product_type").
+		Where(squirrel.Eq{"name": names}).
+		OrderBy("name").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetProductTypes",
+			slog.Any("names", names),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return p.queryProductTypes(ctx, "GetProductTypes", query, args)
+}
+
+func (p *postgresProductTypeRepository) CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Insert("product_type").
+		Columns("name", "fragile", "max_weight_grams").
+		Values(productType.Name, productType.Fragile, productType.MaxWeightGrams).
+		Suffix("RETURNING " + productTypeColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for CreateProductType",
+			slog.String("name", productType.Name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	created, err := scanProductType(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for CreateProductType",
+			slog.String("name", productType.Name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully created product type", slog.String("name", created.Name))
+
+	return created, nil
+}
+
+func (p *postgresProductTypeRepository) UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Update("product_type").
+		Set("fragile", productType.Fragile).
+		Set("max_weight_grams", productType.MaxWeightGrams).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"name": productType.Name}).
+		Suffix("RETURNING " + productTypeColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for UpdateProductType",
+			slog.String("name", productType.Name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	updated, err := scanProductType(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for UpdateProductType",
+			slog.String("name", productType.Name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully updated product type", slog.String("name", updated.Name))
+
+	return updated, nil
+}
+
+func (p *postgresProductTypeRepository) DeleteProductType(ctx context.Context, name string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Delete("product_type").
+		Where(squirrel.Eq{"name": name}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for DeleteProductType",
+			slog.String("name", name),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for DeleteProductType",
+			slog.String("name", name),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	p.logger.Info("Deleted product type",
+		slog.String("name", name),
+		slog.Int64("rows", tag.RowsAffected()))
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (p *postgresProductTypeRepository) queryProductTypes(ctx context.Context, op string, query string, args []interface{}) ([]domain.ProductType, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for "+op, slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	productTypes := make([]domain.ProductType, 0)
+	for rows.Next() {
+		productType, err := scanProductType(rows)
+		if err != nil {
+			p.logger.Error("Failed to scan product type row", slog.String("error", err.Error()))
+			return nil, err
+		}
+		productTypes = append(productTypes, *productType)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Error("Failed to iterate product type rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return productTypes, nil
+}
+
+func scanProductType(row pgx.Row) (*domain.ProductType, error) {
+	var productType domain.ProductType
+	err := row.Scan(&productType.Name, &productType.Fragile, &productType.MaxWeightGrams,
+		&productType.CreatedAt, &productType.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &productType, nil
+}
@@ -2,7 +2,9 @@ package postgresql
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
@@ -22,28 +24,86 @@ func NewPostgresPvzRepository(manager repository.CtxManager, logger *slog.Logger
 	}
 }
 
-func (p *postgresPvzRepository) GetListOfPVZS(ctx context.Context) ([]domain.Pvz, error) {
+// GetPVZ возвращает nil, nil, если ПВЗ нет.
+func (p *postgresPvzRepository) GetPVZ(ctx context.Context, id string) (*domain.Pvz, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
 	}
-
 	exec := tr.Begin().(pgx.Tx)
 
 	query, args, err := squirrel.
-		Select("*").
+		Select("id", "registration_date", "city").
 		From("pvz").
+		Where(squirrel.Eq{"id": id}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
-		p.logger.Error("Failed to build SQL query for GetListOfPVZS",
+		p.logger.Error("Failed to build SQL query for GetPVZ",
+			slog.String("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var pvz domain.Pvz
+	err = exec.QueryRow(ctx, query, args...).Scan(&pvz.ID, &pvz.RegistrationDate, &pvz.City)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for GetPVZ",
+			slog.String("id", id),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully retrieved PVZ",
+		slog.String("id", pvz.ID),
+		slog.String("city", pvz.City))
+
+	return &pvz, nil
+}
+
+// GetPVZS отдает ПВЗ по возрастанию id. С filter.AfterID работает как keyset пагинация
+// (WHERE id > AfterID), иначе через OFFSET.
+func (p *postgresPvzRepository) GetPVZS(ctx context.Context, filter domain.PvzFilter) ([]domain.Pvz, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+	exec := tr.Begin().(pgx.Tx)
+
+	builder := squirrel.
+		Select("id", "registration_date", "city").
+		From("pvz").
+		Where(pvzPredicates(filter)).
+		OrderBy("id").
+		Limit(uint64(filter.Limit))
+
+	if filter.AfterID != "" {
+		builder = builder.Where(squirrel.Gt{"id": filter.AfterID})
+	} else {
+		builder = builder.Offset(uint64(filter.Offset))
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetPVZS",
+			slog.String("afterID", filter.AfterID),
+			slog.Int("offset", filter.Offset),
+			slog.Int("limit", filter.Limit),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
 
 	rows, err := exec.Query(ctx, query, args...)
 	if err != nil {
-		p.logger.Error("Failed to execute SQL query for GetListOfPVZS",
+		p.logger.Error("Failed to execute SQL query for GetPVZS",
+			slog.String("afterID", filter.AfterID),
+			slog.Int("offset", filter.Offset),
+			slog.Int("limit", filter.Limit),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
@@ -54,7 +114,7 @@ func (p *postgresPvzRepository) GetListOfPVZS(ctx context.Context) ([]domain.Pvz
 		var pvz domain.Pvz
 		err = rows.Scan(&pvz.ID, &pvz.RegistrationDate, &pvz.City)
 		if err != nil {
-			p.logger.Error("Failed to scan row in GetListOfPVZS",
+			p.logger.Error("Failed to scan row in GetPVZS",
 				slog.String("error", err.Error()))
 			return nil, err
 		}
@@ -62,48 +122,146 @@ func (p *postgresPvzRepository) GetListOfPVZS(ctx context.Context) ([]domain.Pvz
 	}
 
 	p.logger.Info("Successfully retrieved list of PVZ",
-		slog.Int("count", len(result)))
+		slog.Int("count", len(result)),
+		slog.String("afterID", filter.AfterID),
+		slog.Int("offset", filter.Offset),
+		slog.Int("limit", filter.Limit))
 
 	return result, nil
 }
 
-func (p *postgresPvzRepository) GetPVZ(ctx context.Context, id string) (*domain.Pvz, error) {
+// GetPVZSInfo собирает страницу ПВЗ с приемками и товарами двумя запросами:
+// страница ПВЗ и одна выборка reception LEFT JOIN product по всем ПВЗ страницы.
+// Приемки фильтруются по filter.StartDate/EndDate, nil снимает ограничение с этой стороны.
+func (p *postgresPvzRepository) GetPVZSInfo(ctx context.Context, filter domain.PvzFilter) ([]domain.PvzInfo, error) {
+	pvzs, err := p.GetPVZS(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]domain.PvzInfo, 0, len(pvzs))
+	if len(pvzs) == 0 {
+		return result, nil
+	}
+
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
 	}
 	exec := tr.Begin().(pgx.Tx)
 
-	query, args, err := squirrel.
-		Select("id", "registration_date", "city").
-		From("pvz").
-		Where(squirrel.Eq{"id": id}).
+	pvzIDs := make([]string, 0, len(pvzs))
+	for _, pvz := range pvzs {
+		pvzIDs = append(pvzIDs, pvz.ID)
+	}
+
+	builder := squirrel.
+		Select("r.id", "r.date_time", "r.pvz_id", "r.status",
+			"pr.id", "pr.date_time", "pr.type", "pr.reception_id", "pr.barcode", "pr.order_id").
+		From("reception r").
+		LeftJoin("product pr ON pr.reception_id = r.id AND pr.deleted_at IS NULL").
+		Where(squirrel.Eq{"r.pvz_id": pvzIDs})
+
+	predicates, err := receptionPredicates(filter)
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetPVZSInfo",
+			slog.Int("pvzCount", len(pvzIDs)),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	query, args, err := builder.
+		Where(predicates).
+		OrderBy("r.date_time DESC", "r.id", "pr.date_time", "pr.id").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
-		p.logger.Error("Failed to build SQL query for GetPVZ",
-			slog.String("id", id),
+		p.logger.Error("Failed to build SQL query for GetPVZSInfo",
+			slog.Int("pvzCount", len(pvzIDs)),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	var pvz domain.Pvz
-	err = exec.QueryRow(ctx, query, args...).Scan(&pvz.ID, &pvz.RegistrationDate, &pvz.City)
+	rows, err := exec.Query(ctx, query, args...)
 	if err != nil {
-		p.logger.Error("Failed to execute SQL query for GetPVZ",
-			slog.String("id", id),
+		p.logger.Error("Failed to execute SQL query for GetPVZSInfo",
+			slog.Int("pvzCount", len(pvzIDs)),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
+	defer rows.Close()
 
-	p.logger.Info("Successfully retrieved PVZ",
-		slog.String("id", pvz.ID),
-		slog.String("city", pvz.City))
+	// receptionsByPvz хранит приемки в порядке выборки, индекс нужен, чтобы дописывать товары.
+	receptionsByPvz := make(map[string][]domain.ReceptionInfo, len(pvzs))
+	receptionIndex := make(map[string]int)
 
-	return &pvz, nil
+	for rows.Next() {
+		var (
+			reception       domain.Reception
+			productID       *string
+			productDateTime *time.Time
+			productType     *string
+			productRecID    *string
+			productBarcode  *string
+			productOrderID  *string
+		)
+
+		err = rows.Scan(&reception.ID, &reception.DateTime, &reception.PvzID, &reception.Status,
+			&productID, &productDateTime, &productType, &productRecID, &productBarcode, &productOrderID)
+		if err != nil {
+			p.logger.Error("Failed to scan row in GetPVZSInfo",
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		idx, ok := receptionIndex[reception.ID]
+		if !ok {
+			idx = len(receptionsByPvz[reception.PvzID])
+			receptionIndex[reception.ID] = idx
+			receptionsByPvz[reception.PvzID] = append(receptionsByPvz[reception.PvzID], domain.ReceptionInfo{
+				Reception: reception,
+			})
+		}
+
+		if productID != nil {
+			product := domain.Product{
+				ID:          *productID,
+				DateTime:    *productDateTime,
+				Type:        *productType,
+				ReceptionID: *productRecID,
+			}
+			if productBarcode != nil {
+				product.Barcode = *productBarcode
+			}
+			if productOrderID != nil {
+				product.OrderID = *productOrderID
+			}
+			info := &receptionsByPvz[reception.PvzID][idx]
+			info.Products = append(info.Products, product)
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Error("Failed to iterate rows in GetPVZSInfo",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, pvz := range pvzs {
+		result = append(result, domain.PvzInfo{
+			Pvz:        pvz,
+			Receptions: receptionsByPvz[pvz.ID],
+		})
+	}
+
+	p.logger.Info("Successfully retrieved PVZ info",
+		slog.Int("count", len(result)))
+
+	return result, nil
 }
 
-func (p *postgresPvzRepository) GetPVZS(ctx context.Context, offset int, limit int) ([]domain.Pvz, error) {
+// CountPVZS считает ПВЗ, подходящие под условия filter. Пагинация (AfterID, Offset, Limit) не учитывается.
+func (p *postgresPvzRepository) CountPVZS(ctx context.Context, filter domain.PvzFilter) (int, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
@@ -111,49 +269,69 @@ func (p *postgresPvzRepository) GetPVZS(ctx context.Context, offset int, limit i
 	exec := tr.Begin().(pgx.Tx)
 
 	query, args, err := squirrel.
-		Select("id", "registration_date", "city").
+		Select("COUNT(*)").
 		From("pvz").
-		OrderBy("id").
-		Limit(uint64(limit)).
-		Offset(uint64(offset)).
+		Where(pvzPredicates(filter)).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
-		p.logger.Error("Failed to build SQL query for GetPVZS",
-			slog.Int("offset", offset),
-			slog.Int("limit", limit),
+		p.logger.Error("Failed to build SQL query for CountPVZS",
 			slog.String("error", err.Error()))
-		return nil, err
+		return 0, err
 	}
 
-	rows, err := exec.Query(ctx, query, args...)
+	var count int
+	err = exec.QueryRow(ctx, query, args...).Scan(&count)
 	if err != nil {
-		p.logger.Error("Failed to execute SQL query for GetPVZS",
-			slog.Int("offset", offset),
-			slog.Int("limit", limit),
+		p.logger.Error("Failed to execute SQL query for CountPVZS",
 			slog.String("error", err.Error()))
-		return nil, err
+		return 0, err
 	}
-	defer rows.Close()
 
-	var result []domain.Pvz
-	for rows.Next() {
-		var pvz domain.Pvz
-		err = rows.Scan(&pvz.ID, &pvz.RegistrationDate, &pvz.City)
+	p.logger.Info("Successfully counted PVZ", slog.Int("count", count))
+
+	return count, nil
+}
+
+// pvzPredicates переводит условия filter на сами ПВЗ (таблица pvz без алиаса) в предикаты squirrel.
+func pvzPredicates(filter domain.PvzFilter) squirrel.And {
+	predicates := squirrel.And{}
+	if len(filter.Cities) > 0 {
+		predicates = append(predicates, squirrel.Eq{"city": filter.Cities})
+	}
+	if len(filter.PvzIDs) > 0 {
+		predicates = append(predicates, squirrel.Eq{"id": filter.PvzIDs})
+	}
+	return predicates
+}
+
+// receptionPredicates переводит условия filter на приемки (алиас r) в предикаты squirrel.
+// Тип товара проверяется через EXISTS, поэтому у подходящей приемки возвращаются все ее товары.
+func receptionPredicates(filter domain.PvzFilter) (squirrel.And, error) {
+	predicates := squirrel.And{}
+	if filter.StartDate != nil {
+		predicates = append(predicates, squirrel.GtOrEq{"r.date_time": *filter.StartDate})
+	}
+	if filter.EndDate != nil {
+		predicates = append(predicates, squirrel.LtOrEq{"r.date_time": *filter.EndDate})
+	}
+	if len(filter.ReceptionStatuses) > 0 {
+		predicates = append(predicates, squirrel.Eq{"r.status": filter.ReceptionStatuses})
+	}
+	if len(filter.ProductTypes) > 0 {
+		subQuery, args, err := squirrel.
+			Select("1").
+			From("product pt").
+			Where("pt.reception_id = r.id").
+			Where("pt.deleted_at IS NULL").
+			Where(squirrel.Eq{"pt.type": filter.ProductTypes}).
+			ToSql()
 		if err != nil {
-			p.logger.Error("Failed to scan row in GetPVZS",
-				slog.String("error", err.Error()))
 			return nil, err
 		}
-		result = append(result, pvz)
+		predicates = append(predicates, squirrel.Expr("EXISTS ("+subQuery+")", args...))
 	}
-
-	p.logger.Info("Successfully retrieved list of PVZ",
-		slog.Int("count", len(result)),
-		slog.Int("offset", offset),
-		slog.Int("limit", limit))
-
-	return result, nil
+	return predicates, nil
 }
 
 func (p *postgresPvzRepository) CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error) {
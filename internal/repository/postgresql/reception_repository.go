@@ -36,7 +36,7 @@ func (p *postgresReceptionRepository) CreateReception(ctx context.Context, pvzID
 	query, args, err := squirrel.
 		Insert("reception").
 		Columns("pvz_id", "status").
-		Values(pvzID, "open").
+		Values(pvzID, domain.ReceptionInProgress).
 		Suffix("RETURNING id, pvz_id, status, date_time").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
@@ -53,6 +53,9 @@ func (p *postgresReceptionRepository) CreateReception(ctx context.Context, pvzID
 		p.logger.Error("Failed to execute SQL query for creating reception",
 			slog.String("pvzID", pvzID),
 			slog.String("error", err.Error()))
+		if isUniqueViolation(err) {
+			return nil, repository.ErrAlreadyExists
+		}
 		return nil, err
 	}
 
@@ -66,6 +69,7 @@ func (p *postgresReceptionRepository) CreateReception(ctx context.Context, pvzID
 }
 
 // FindOpen implements ReceptionRepository.
+// Открытой считается незавершенная приемка: в работе или приостановленная.
 func (p *postgresReceptionRepository) FindOpen(ctx context.Context, pvzID string) (*domain.Reception, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
@@ -76,7 +80,7 @@ func (p *postgresReceptionRepository) FindOpen(ctx context.Context, pvzID string
 	query, args, err := squirrel.
 		Select("id", "date_time", "pvz_id", "status").
 		From("reception").
-		Where(squirrel.Eq{"pvz_id": pvzID, "status": "open"}).
+		Where(squirrel.Eq{"pvz_id": pvzID, "status": domain.ActiveReceptionStatuses()}).
 		OrderBy("date_time DESC").
 		Limit(1).
 		PlaceholderFormat(squirrel.Dollar).
@@ -112,29 +116,35 @@ func (p *postgresReceptionRepository) FindOpen(ctx context.Context, pvzID string
 }
 
 // GetReceptionsFiltered implements ReceptionRepository.
-func (p *postgresReceptionRepository) GetReceptionsFiltered(ctx context.Context, pvzID string, startTime time.Time, endTime time.Time) ([]*domain.Reception, error) {
+// Если startTime или endTime равны nil, диапазон с этой стороны не ограничен.
+func (p *postgresReceptionRepository) GetReceptionsFiltered(ctx context.Context, pvzID string, startTime *time.Time, endTime *time.Time) ([]*domain.Reception, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
 	}
 	exec := tr.Begin().(pgx.Tx)
 
-	query, args, err := squirrel.
+	builder := squirrel.
 		Select("id", "date_time", "pvz_id", "status").
 		From("reception").
-		Where(squirrel.Eq{"pvz_id": pvzID}).
-		Where(squirrel.And{
-			squirrel.GtOrEq{"date_time": startTime},
-			squirrel.LtOrEq{"date_time": endTime},
-		}).
+		Where(squirrel.Eq{"pvz_id": pvzID})
+
+	if startTime != nil {
+		builder = builder.Where(squirrel.GtOrEq{"date_time": *startTime})
+	}
+	if endTime != nil {
+		builder = builder.Where(squirrel.LtOrEq{"date_time": *endTime})
+	}
+
+	query, args, err := builder.
 		OrderBy("date_time DESC").
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		p.logger.Error("Failed to build SQL query for getting filtered receptions",
 			slog.String("pvzID", pvzID),
-			slog.Time("startTime", startTime),
-			slog.Time("endTime", endTime),
+			slog.Any("startTime", startTime),
+			slog.Any("endTime", endTime),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
@@ -143,8 +153,8 @@ func (p *postgresReceptionRepository) GetReceptionsFiltered(ctx context.Context,
 	if err != nil {
 		p.logger.Error("Failed to execute SQL query for getting filtered receptions",
 			slog.String("pvzID", pvzID),
-			slog.Time("startTime", startTime),
-			slog.Time("endTime", endTime),
+			slog.Any("startTime", startTime),
+			slog.Any("endTime", endTime),
 			slog.String("error", err.Error()))
 		return nil, err
 	}
@@ -170,8 +180,46 @@ func (p *postgresReceptionRepository) GetReceptionsFiltered(ctx context.Context,
 	return result, nil
 }
 
+// GetReception implements ReceptionRepository.
+func (p *postgresReceptionRepository) GetReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("id", "date_time", "pvz_id", "status").
+		From("reception").
+		Where(squirrel.Eq{"id": receptionID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for getting reception",
+			slog.String("receptionID", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var r domain.Reception
+	err = exec.QueryRow(ctx, query, args...).Scan(&r.ID, &r.DateTime, &r.PvzID, &r.Status)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for getting reception",
+			slog.String("receptionID", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &r, nil
+}
+
 // UpdateReceptionStatus implements ReceptionRepository.
-func (p *postgresReceptionRepository) UpdateReceptionStatus(ctx context.Context, receptionID string, newStatus string) error {
+// Статус меняется, только если приемка все еще в статусе fromStatus: false значит,
+// что ее успели перевести параллельно.
+func (p *postgresReceptionRepository) UpdateReceptionStatus(ctx context.Context, receptionID string, fromStatus string, toStatus string) (bool, error) {
 	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
 	if tr == nil {
 		tr = p.ctxManager.Default(ctx)
@@ -183,35 +231,155 @@ func (p *postgresReceptionRepository) UpdateReceptionStatus(ctx context.Context,
 		p.logger.Error("Failed to convert receptionID to int",
 			slog.String("receptionID", receptionID),
 			slog.String("error", err.Error()))
-		return err
+		return false, err
 	}
 
 	query, args, err := squirrel.
 		Update("reception").
-		Set("status", newStatus).
-		Where(squirrel.Eq{"id": id}).
+		Set("status", toStatus).
+		Where(squirrel.Eq{"id": id, "status": fromStatus}).
 		PlaceholderFormat(squirrel.Dollar).
 		ToSql()
 	if err != nil {
 		p.logger.Error("Failed to build SQL query for updating reception status",
 			slog.String("receptionID", receptionID),
-			slog.String("newStatus", newStatus),
+			slog.String("fromStatus", fromStatus),
+			slog.String("toStatus", toStatus),
 			slog.String("error", err.Error()))
-		return err
+		return false, err
 	}
 
-	_, err = exec.Exec(ctx, query, args...)
+	tag, err := exec.Exec(ctx, query, args...)
 	if err != nil {
 		p.logger.Error("Failed to execute SQL query for updating reception status",
 			slog.String("receptionID", receptionID),
-			slog.String("newStatus", newStatus),
+			slog.String("fromStatus", fromStatus),
+			slog.String("toStatus", toStatus),
 			slog.String("error", err.Error()))
-		return err
+		if isUniqueViolation(err) {
+			return false, repository.ErrAlreadyExists
+		}
+		return false, err
 	}
 
-	p.logger.Info("Successfully updated reception status",
+	p.logger.Info("Updated reception status",
 		slog.String("receptionID", receptionID),
-		slog.String("newStatus", newStatus))
+		slog.String("fromStatus", fromStatus),
+		slog.String("toStatus", toStatus),
+		slog.Int64("rows", tag.RowsAffected()))
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// AddStatusChange implements ReceptionRepository.
+func (p *postgresReceptionRepository) AddStatusChange(ctx context.Context, change domain.ReceptionStatusChange) (*domain.ReceptionStatusChange, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("reception_status_history").
+		Columns("reception_id", "from_status", "to_status", "action", "reason", "user_id", "role").
+		Values(change.ReceptionID, nullIfEmpty(change.FromStatus), change.ToStatus, change.Action,
+			nullIfEmpty(change.Reason), nullIfEmpty(change.UserID), nullIfEmpty(change.Role)).
+		Suffix("RETURNING " + receptionStatusChangeColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for AddStatusChange",
+			slog.String("receptionID", change.ReceptionID),
+			slog.String("action", change.Action),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	added, err := scanReceptionStatusChange(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for AddStatusChange",
+			slog.String("receptionID", change.ReceptionID),
+			slog.String("action", change.Action),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return added, nil
+}
+
+// GetStatusHistory implements ReceptionRepository.
+// Записи идут в порядке переходов, от открытия приемки к последнему.
+func (p *postgresReceptionRepository) GetStatusHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select(receptionStatusChangeColumns).
+		From("reception_status_history").
+		Where(squirrel.Eq{"reception_id": receptionID}).
+		OrderBy("id").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetStatusHistory",
+			slog.String("receptionID", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for GetStatusHistory",
+			slog.String("receptionID", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]domain.ReceptionStatusChange, 0)
+	for rows.Next() {
+		change, err := scanReceptionStatusChange(rows)
+		if err != nil {
+			p.logger.Error("Failed to scan row in GetStatusHistory",
+				slog.String("receptionID", receptionID),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+		history = append(history, *change)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Error("Failed to iterate rows in GetStatusHistory",
+			slog.String("receptionID", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return history, nil
+}
+
+const receptionStatusChangeColumns = "id, reception_id, from_status, to_status, action, reason, user_id, role, changed_at"
+
+func scanReceptionStatusChange(row pgx.Row) (*domain.ReceptionStatusChange, error) {
+	var (
+		change     domain.ReceptionStatusChange
+		fromStatus *string
+		reason     *string
+		userID     *string
+		role       *string
+	)
+	err := row.Scan(&change.ID, &change.ReceptionID, &fromStatus, &change.ToStatus, &change.Action,
+		&reason, &userID, &role, &change.ChangedAt)
+	if err != nil {
+		return nil, err
+	}
 
-	return nil
+	change.FromStatus = derefString(fromStatus)
+	change.Reason = derefString(reason)
+	change.UserID = derefString(userID)
+	change.Role = derefString(role)
+	return &change, nil
 }
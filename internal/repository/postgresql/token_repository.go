@@ -0,0 +1,234 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+type postgresTokenRepository struct {
+	ctxManager repository.CtxManager
+	logger     *slog.Logger
+}
+
+func NewPostgresTokenRepository(manager repository.CtxManager, logger *slog.Logger) repository.TokenRepository {
+	return &postgresTokenRepository{
+		ctxManager: manager,
+		logger:     logger,
+	}
+}
+
+func (p *postgresTokenRepository) CreateRefreshToken(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("refresh_token").
+		Columns("user_id", "token_hash", "expires_at").
+		Values(userID, tokenHash, expiresAt).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for CreateRefreshToken",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for CreateRefreshToken",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Successfully created refresh token", slog.String("userID", userID))
+
+	return nil
+}
+
+func (p *postgresTokenRepository) GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("id", "user_id", "token_hash", "expires_at", "created_at", "revoked_at").
+		From("refresh_token").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for GetRefreshToken",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var refreshToken domain.RefreshToken
+	err = exec.QueryRow(ctx, query, args...).Scan(&refreshToken.ID, &refreshToken.UserID, &refreshToken.TokenHash,
+		&refreshToken.ExpiresAt, &refreshToken.CreatedAt, &refreshToken.RevokedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for GetRefreshToken",
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return &refreshToken, nil
+}
+
+// RevokeRefreshToken отзывает токен, только если он еще не отозван. Это делает ротацию атомарной:
+// из двух параллельных обменов одного refresh токена успешен только один.
+func (p *postgresTokenRepository) RevokeRefreshToken(ctx context.Context, tokenHash string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("refresh_token").
+		Set("revoked_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"token_hash": tokenHash, "revoked_at": nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for RevokeRefreshToken",
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for RevokeRefreshToken",
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (p *postgresTokenRepository) RevokeUserRefreshTokens(ctx context.Context, userID string) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Update("refresh_token").
+		Set("revoked_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"user_id": userID, "revoked_at": nil}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for RevokeUserRefreshTokens",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for RevokeUserRefreshTokens",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Revoked user refresh tokens",
+		slog.String("userID", userID),
+		slog.Int64("count", tag.RowsAffected()))
+
+	return nil
+}
+
+// RevokeAccessToken кладет jti в список отозванных. Запись нужна только до exp токена,
+// после этого токен и так не пройдет проверку.
+func (p *postgresTokenRepository) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Insert("revoked_token").
+		Columns("jti", "expires_at").
+		Values(jti, expiresAt).
+		Suffix("ON CONFLICT (jti) DO NOTHING").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for RevokeAccessToken",
+			slog.String("jti", jti),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for RevokeAccessToken",
+			slog.String("jti", jti),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Revoked access token", slog.String("jti", jti))
+
+	return nil
+}
+
+func (p *postgresTokenRepository) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.
+		Select("1").
+		From("revoked_token").
+		Where(squirrel.Eq{"jti": jti}).
+		Prefix("SELECT EXISTS (").
+		Suffix(")").
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for IsAccessTokenRevoked",
+			slog.String("jti", jti),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	var revoked bool
+	err = exec.QueryRow(ctx, query, args...).Scan(&revoked)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for IsAccessTokenRevoked",
+			slog.String("jti", jti),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return revoked, nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+const userColumns = "id, email, hashed_password, role, disabled, created_at, updated_at"
+
 type postgresUserRepository struct {
 	ctxManager repository.CtxManager
 	logger     *slog.Logger
@@ -73,7 +75,7 @@ func (p *postgresUserRepository) GetUser(ctx context.Context, email string) (*do
 
 	exec := tr.Begin().(pgx.Tx)
 
-	query, args, err := squirrel.Select("id, email, hashed_password, role, created_at").
+	query, args, err := squirrel.Select(userColumns).
 		From("users").
 		Where(squirrel.Eq{"email": email}).
 		PlaceholderFormat(squirrel.Dollar).
@@ -86,8 +88,7 @@ func (p *postgresUserRepository) GetUser(ctx context.Context, email string) (*do
 		return nil, err
 	}
 
-	var user domain.User
-	err = exec.QueryRow(ctx, query, args...).Scan(&user.ID, &user.Email,&user.PasswordHash,  &user.Role, &user.CreatedAt)
+	user, err := scanUser(exec.QueryRow(ctx, query, args...))
 	if err != nil {
 		p.logger.Error("Failed to execute SQL query for getting user",
 			slog.String("email", email),
@@ -102,5 +103,305 @@ func (p *postgresUserRepository) GetUser(ctx context.Context, email string) (*do
 		slog.String("email", email),
 		slog.String("userID", user.ID))
 
+	return user, nil
+}
+
+func (p *postgresUserRepository) GetUserByID(ctx context.Context, userID string) (*domain.User, error) {
+	return p.getUserByID(ctx, userID, false)
+}
+
+func (p *postgresUserRepository) GetUserByIDForUpdate(ctx context.Context, userID string) (*domain.User, error) {
+	return p.getUserByID(ctx, userID, true)
+}
+
+func (p *postgresUserRepository) getUserByID(ctx context.Context, userID string, forUpdate bool) (*domain.User, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	builder := squirrel.Select(userColumns).
+		From("users").
+		Where(squirrel.Eq{"id": userID}).
+		PlaceholderFormat(squirrel.Dollar)
+	if forUpdate {
+		builder = builder.Suffix("FOR UPDATE")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for getting user by id",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	user, err := scanUser(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for getting user by id",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully retrieved user by id",
+		slog.String("userID", user.ID))
+
+	return user, nil
+}
+
+func (p *postgresUserRepository) UpdatePassword(ctx context.Context, userID string, hashedPassword string) error {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Update("users").
+		Set("hashed_password", hashedPassword).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for updating password",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	_, err = exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for updating password",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Successfully updated password", slog.String("userID", userID))
+
+	return nil
+}
+
+func (p *postgresUserRepository) ReplacePasswordHash(ctx context.Context, userID string, oldHash string, newHash string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Update("users").
+		Set("hashed_password", newHash).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": userID, "hashed_password": oldHash}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for replacing password hash",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for replacing password hash",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (p *postgresUserRepository) ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	builder := squirrel.Select(userColumns).
+		From("users").
+		OrderBy("id").
+		Limit(uint64(filter.Limit))
+
+	if filter.AfterID != "" {
+		builder = builder.Where(squirrel.Gt{"id": filter.AfterID})
+	}
+
+	query, args, err := builder.
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for listing users",
+			slog.String("afterID", filter.AfterID),
+			slog.Int("limit", filter.Limit),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	rows, err := exec.Query(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for listing users",
+			slog.String("afterID", filter.AfterID),
+			slog.Int("limit", filter.Limit),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, filter.Limit)
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			p.logger.Error("Failed to scan user row", slog.String("error", err.Error()))
+			return nil, err
+		}
+		users = append(users, *user)
+	}
+
+	if err := rows.Err(); err != nil {
+		p.logger.Error("Failed to iterate user rows", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (p *postgresUserRepository) UpdateRole(ctx context.Context, userID string, role string) (*domain.User, error) {
+	return p.updateUser(ctx, userID, "role", role)
+}
+
+func (p *postgresUserRepository) SetDisabled(ctx context.Context, userID string, disabled bool) (*domain.User, error) {
+	return p.updateUser(ctx, userID, "disabled", disabled)
+}
+
+// updateUser меняет одну колонку, обновляет updated_at и возвращает пользователя после изменения.
+func (p *postgresUserRepository) updateUser(ctx context.Context, userID string, column string, value interface{}) (*domain.User, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Update("users").
+		Set(column, value).
+		Set("updated_at", squirrel.Expr("NOW()")).
+		Where(squirrel.Eq{"id": userID}).
+		Suffix("RETURNING " + userColumns).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for updating user",
+			slog.String("userID", userID),
+			slog.String("column", column),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	user, err := scanUser(exec.QueryRow(ctx, query, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		p.logger.Error("Failed to execute SQL query for updating user",
+			slog.String("userID", userID),
+			slog.String("column", column),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.logger.Info("Successfully updated user",
+		slog.String("userID", userID),
+		slog.String("column", column))
+
+	return user, nil
+}
+
+func (p *postgresUserRepository) DeleteUser(ctx context.Context, userID string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Delete("users").
+		Where(squirrel.Eq{"id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for deleting user",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	tag, err := exec.Exec(ctx, query, args...)
+	if err != nil {
+		p.logger.Error("Failed to execute SQL query for deleting user",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	p.logger.Info("Deleted user",
+		slog.String("userID", userID),
+		slog.Int64("rows", tag.RowsAffected()))
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (p *postgresUserRepository) IsUserDisabled(ctx context.Context, userID string) (bool, error) {
+	tr := p.ctxManager.ByKey(ctx, p.ctxManager.CtxKey())
+	if tr == nil {
+		tr = p.ctxManager.Default(ctx)
+	}
+
+	exec := tr.Begin().(pgx.Tx)
+
+	query, args, err := squirrel.Select("disabled").
+		From("users").
+		Where(squirrel.Eq{"id": userID}).
+		PlaceholderFormat(squirrel.Dollar).
+		ToSql()
+	if err != nil {
+		p.logger.Error("Failed to build SQL query for IsUserDisabled",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	var disabled bool
+	err = exec.QueryRow(ctx, query, args...).Scan(&disabled)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return true, nil
+		}
+		p.logger.Error("Failed to execute SQL query for IsUserDisabled",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return false, err
+	}
+
+	return disabled, nil
+}
+
+func scanUser(row pgx.Row) (*domain.User, error) {
+	var user domain.User
+	err := row.Scan(&user.ID, &user.Email, &user.PasswordHash, &user.Role, &user.Disabled, &user.CreatedAt, &user.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
 	return &user, nil
 }
@@ -8,8 +8,12 @@ import (
 )
 
 type ProductRepository interface {
-	CreateProduct(ctx context.Context, productType string, receptionID string) (*domain.Product, error)
-	DeleteProduct(ctx context.Context, productID string) error
-	FindTheLastProduct(ctx context.Context, pvzID string) (product *domain.Product, err error)
+	CreateProduct(ctx context.Context, item domain.ProductItem, receptionID string) (*domain.Product, error)
+	CreateProducts(ctx context.Context, items []domain.ProductItem, receptionID string) ([]domain.Product, error)
+	DeleteProduct(ctx context.Context, productID string) (*domain.Product, error)
+	RestoreProduct(ctx context.Context, productID string) (*domain.Product, error)
+	GetProduct(ctx context.Context, productID string) (*domain.Product, error)
+	FindTheLastProduct(ctx context.Context, receptionID string) (product *domain.Product, err error)
 	GetProducts(ctx context.Context, receptionID string) ([]domain.Product, error)
+	FindProductsByBarcode(ctx context.Context, barcode string, pvzIDs []string) ([]domain.Product, error)
 }
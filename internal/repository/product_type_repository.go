@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+)
+
+// ProductTypeRepository хранит справочник типов товаров.
+type ProductTypeRepository interface {
+	ListProductTypes(ctx context.Context) ([]domain.ProductType, error)
+	// GetProductType возвращает nil, если типа нет в справочнике.
+	GetProductType(ctx context.Context, name string) (*domain.ProductType, error)
+	// GetProductTypes возвращает только найденные типы, отсутствующие в справочнике пропускаются.
+	GetProductTypes(ctx context.Context, names []string) ([]domain.ProductType, error)
+	CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error)
+	// UpdateProductType меняет атрибуты типа и возвращает nil, если типа нет в справочнике.
+	UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error)
+	// DeleteProductType возвращает false, если типа нет в справочнике.
+	DeleteProductType(ctx context.Context, name string) (bool, error)
+}
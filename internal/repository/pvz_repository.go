@@ -8,7 +8,8 @@ import (
 
 type PvzRepository interface {
 	CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error)
-	GetPVZS(ctx context.Context, offset int, limit int) ([]domain.Pvz, error)
+	GetPVZS(ctx context.Context, filter domain.PvzFilter) ([]domain.Pvz, error)
+	CountPVZS(ctx context.Context, filter domain.PvzFilter) (int, error)
+	GetPVZSInfo(ctx context.Context, filter domain.PvzFilter) ([]domain.PvzInfo, error)
 	GetPVZ(ctx context.Context, id string) (*domain.Pvz, error)
-	GetListOfPVZS(ctx context.Context) ([]domain.Pvz, error)
 }
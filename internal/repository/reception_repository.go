@@ -9,7 +9,10 @@ import (
 
 type ReceptionRepository interface {
 	FindOpen(ctx context.Context, pvzID string) (*domain.Reception, error)
+	GetReception(ctx context.Context, receptionID string) (*domain.Reception, error)
 	CreateReception(ctx context.Context, pvzID string) (*domain.Reception, error)
-	UpdateReceptionStatus(ctx context.Context, receptionID string, newStatus string) error
-	GetReceptionsFiltered(ctx context.Context, pvzID string, startTime time.Time, endTime time.Time) ([]*domain.Reception, error)
+	UpdateReceptionStatus(ctx context.Context, receptionID string, fromStatus string, toStatus string) (bool, error)
+	GetReceptionsFiltered(ctx context.Context, pvzID string, startTime *time.Time, endTime *time.Time) ([]*domain.Reception, error)
+	AddStatusChange(ctx context.Context, change domain.ReceptionStatusChange) (*domain.ReceptionStatusChange, error)
+	GetStatusHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error)
 }
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+)
+
+type TokenRepository interface {
+	CreateRefreshToken(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) error
+	// GetRefreshToken возвращает nil, nil, если токен не найден.
+	GetRefreshToken(ctx context.Context, tokenHash string) (*domain.RefreshToken, error)
+	// RevokeRefreshToken возвращает false, если токен уже был отозван или не существует.
+	RevokeRefreshToken(ctx context.Context, tokenHash string) (bool, error)
+	RevokeUserRefreshTokens(ctx context.Context, userID string) error
+
+	RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
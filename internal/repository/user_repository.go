@@ -9,4 +9,20 @@ import (
 type UserRepository interface {
 	CreateUser(ctx context.Context, email string, hashedPassword string, role string) (userID string, err error)
 	GetUser(ctx context.Context, email string) (*domain.User, error)
+	GetUserByID(ctx context.Context, userID string) (*domain.User, error)
+	// GetUserByIDForUpdate блокирует строку пользователя до конца транзакции, чтобы проверенную роль
+	// нельзя было изменить параллельно до записи.
+	GetUserByIDForUpdate(ctx context.Context, userID string) (*domain.User, error)
+	UpdatePassword(ctx context.Context, userID string, hashedPassword string) error
+	// ReplacePasswordHash меняет хеш, только если в БД все еще лежит oldHash. Возвращает false, если хеш уже изменился.
+	ReplacePasswordHash(ctx context.Context, userID string, oldHash string, newHash string) (bool, error)
+	// ListUsers отдает пользователей по возрастанию id начиная после filter.AfterID.
+	ListUsers(ctx context.Context, filter domain.UserFilter) ([]domain.User, error)
+	// UpdateRole и SetDisabled возвращают nil, если пользователя нет.
+	UpdateRole(ctx context.Context, userID string, role string) (*domain.User, error)
+	SetDisabled(ctx context.Context, userID string, disabled bool) (*domain.User, error)
+	// DeleteUser возвращает false, если пользователя нет. Токены и назначения удаляются каскадно.
+	DeleteUser(ctx context.Context, userID string) (bool, error)
+	// IsUserDisabled отвечает true и для удаленного пользователя, чтобы его токены перестали приниматься.
+	IsUserDisabled(ctx context.Context, userID string) (bool, error)
 }
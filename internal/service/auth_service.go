@@ -2,10 +2,21 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
-	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/Ranik23/avito-tech-spring/internal/credentials"
 	"github.com/Ranik23/avito-tech-spring/internal/hasher"
+	"github.com/Ranik23/avito-tech-spring/internal/limiter"
+	"github.com/Ranik23/avito-tech-spring/internal/metrics"
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/notifier"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/repository"
 	"github.com/Ranik23/avito-tech-spring/internal/token"
 )
@@ -13,38 +24,119 @@ import (
 type AuthService interface {
 	DummyLogin(ctx context.Context, role string) (token string, err error)
 	Register(ctx context.Context, email string, password string, role string) (userID string, err error)
-	Login(ctx context.Context, email string, password string) (token string, err error)
+	// Login не различает неизвестный email и неверный пароль, в обоих случаях ErrInvalidCredentials.
+	// clientIP нужен для ограничения попыток с одного адреса, пустой - ограничение только по email.
+	Login(ctx context.Context, email string, password string, clientIP string) (*domain.TokenPair, error)
+	Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error)
+	Logout(ctx context.Context, userID string, jti string, accessExpiresAt time.Time, refreshToken string) error
+	// ChangePassword меняет пароль по текущему паролю и отзывает все refresh токены пользователя.
+	ChangePassword(ctx context.Context, userID string, oldPassword string, newPassword string) error
+	// RequestPasswordReset отправляет токен сброса через Notifier. Для незарегистрированного email
+	// ничего не делает и тоже возвращает nil, чтобы по ответу нельзя было проверить наличие аккаунта.
+	RequestPasswordReset(ctx context.Context, email string) error
+	// ResetPassword устанавливает новый пароль по одноразовому токену сброса.
+	ResetPassword(ctx context.Context, resetToken string, newPassword string) error
+
+	// Управление пользователями. Пользователь из контекста (rbac.Actor) не может менять роль,
+	// отключать и удалять самого себя, иначе модератор может остаться без доступа.
+	ListUsers(ctx context.Context, cursor string, limit int) (*domain.UserPage, error)
+	GetUser(ctx context.Context, userID string) (*domain.User, error)
+	UpdateUserRole(ctx context.Context, userID string, role string) (*domain.User, error)
+	// DeactivateUser отключает учетную запись и отзывает все ее refresh токены.
+	DeactivateUser(ctx context.Context, userID string) (*domain.User, error)
+	ActivateUser(ctx context.Context, userID string) (*domain.User, error)
+	DeleteUser(ctx context.Context, userID string) error
 }
 
 type authService struct {
 	userRepo        repository.UserRepository
+	tokenRepo       repository.TokenRepository
+	resetRepo       repository.PasswordResetRepository
 	txManager       repository.TxManager
 	token           token.Token
 	hasher          hasher.Hasher
+	loginLimiter    limiter.LoginLimiter
+	notifier        notifier.Notifier
+	policy          *rbac.Policy
+	passwordPolicy  credentials.PasswordPolicy
+	refreshTTL      time.Duration
+	resetTTL        time.Duration
+	dummyLogin      bool
 	logger          *slog.Logger
-	acceptableRoles []string
+
+	dummyHashOnce sync.Once
+	dummyHash     string
 }
 
 func NewAuthService(
 	userRepo repository.UserRepository,
+	tokenRepo repository.TokenRepository,
+	resetRepo repository.PasswordResetRepository,
 	txManager repository.TxManager,
 	token token.Token,
 	hasher hasher.Hasher,
+	loginLimiter limiter.LoginLimiter,
+	notifier notifier.Notifier,
+	policy *rbac.Policy,
+	passwordPolicy credentials.PasswordPolicy,
+	refreshTTL time.Duration,
+	resetTTL time.Duration,
+	dummyLogin bool,
 	logger *slog.Logger,
 ) AuthService {
+	if refreshTTL <= 0 {
+		refreshTTL = DefaultRefreshTTL
+	}
+	if resetTTL <= 0 {
+		resetTTL = DefaultResetTokenTTL
+	}
+
 	return &authService{
 		userRepo:        userRepo,
+		tokenRepo:       tokenRepo,
+		resetRepo:       resetRepo,
 		txManager:       txManager,
 		token:           token,
 		hasher:          hasher,
+		loginLimiter:    loginLimiter,
+		notifier:        notifier,
+		policy:          policy,
+		passwordPolicy:  passwordPolicy,
+		refreshTTL:      refreshTTL,
+		resetTTL:        resetTTL,
+		dummyLogin:      dummyLogin,
 		logger:          logger,
-		acceptableRoles: []string{"employee", "moderator"},
 	}
 }
 
-func (a *authService) Login(ctx context.Context, email string, password string) (token string, err error) {
+func (a *authService) Login(ctx context.Context, email string, password string, clientIP string) (*domain.TokenPair, error) {
+	wait, err := a.loginLimiter.Check(ctx, email, clientIP)
+	if err != nil {
+		a.logger.Error("Failed to check login attempts",
+			slog.String("email", email),
+			slog.String("error", err.Error()),
+		)
+		return nil, err
+	}
+
+	if wait > 0 {
+		metrics.LoginFailuresTotal.WithLabelValues("locked").Inc()
+		a.logger.Warn("Login attempt while locked",
+			slog.String("email", email),
+			slog.String("ip", clientIP),
+			slog.Duration("retryAfter", wait),
+		)
+		return nil, &LoginLockedError{RetryAfter: wait}
+	}
+
+	var (
+		pair *domain.TokenPair
+		user *domain.User
+	)
+
 	err = a.txManager.Do(ctx, func(txCtx context.Context) error {
-		user, err := a.userRepo.GetUser(txCtx, email)
+		var err error
+		user, err = a.userRepo.GetUser(txCtx, email)
 		if err != nil {
 			a.logger.Error("Failed to get the user during login",
 				slog.String("email", email),
@@ -54,9 +146,11 @@ func (a *authService) Login(ctx context.Context, email string, password string)
 		}
 
 		if user == nil {
+			// сравнение с фиктивным хешем выравнивает время ответа, иначе по нему видно, есть ли такой email
+			a.compareDummyHash(password)
 			a.logger.Warn("User not found during login",
 				slog.String("email", email))
-			return ErrUserNotFound
+			return ErrInvalidCredentials
 		}
 
 		a.logger.Info("User Found", slog.String("email", email))
@@ -68,7 +162,15 @@ func (a *authService) Login(ctx context.Context, email string, password string)
 			return ErrInvalidCredentials
 		}
 
-		token, err = a.token.GenerateToken(user.ID, user.Role)
+		// признак отключения отдается только после верного пароля, иначе по нему можно проверять email
+		if user.Disabled {
+			a.logger.Warn("Disabled user tried to log in",
+				slog.String("email", email),
+			)
+			return ErrUserDisabled
+		}
+
+		pair, err = a.issueTokens(txCtx, user)
 		if err != nil {
 			a.logger.Error("Failed to generate token during login",
 				slog.String("email", email),
@@ -80,21 +182,431 @@ func (a *authService) Login(ctx context.Context, email string, password string)
 		return nil
 	})
 
+	if errors.Is(err, ErrInvalidCredentials) {
+		metrics.LoginFailuresTotal.WithLabelValues("invalid_credentials").Inc()
+		// попытка учитывается вне транзакции входа, которая откатывается вместе с ошибкой
+		if _, limitErr := a.loginLimiter.Failure(ctx, email, clientIP); limitErr != nil {
+			a.logger.Error("Failed to record failed login attempt",
+				slog.String("email", email),
+				slog.String("error", limitErr.Error()),
+			)
+		}
+	}
+
 	if err != nil {
 		a.logger.Error("Login failed",
 			slog.String("email", email),
 			slog.String("error", err.Error()),
 		)
-		return "", err
+		return nil, err
+	}
+
+	if err := a.loginLimiter.Success(ctx, email); err != nil {
+		a.logger.Error("Failed to reset login attempts",
+			slog.String("email", email),
+			slog.String("error", err.Error()),
+		)
+	}
+
+	if a.hasher.NeedsRehash(user.PasswordHash) {
+		a.rehashPassword(ctx, user, password)
 	}
 
 	a.logger.Info("Login successful",
 		slog.String("email", email),
 	)
-	return token, nil
+	return pair, nil
+}
+
+// rehashPassword пересчитывает хеш, посчитанный устаревшим алгоритмом или параметрами, пока известен пароль.
+// Ошибка только логируется: вход уже состоялся, хеш обновится при следующем входе.
+func (a *authService) rehashPassword(ctx context.Context, user *domain.User, password string) {
+	hashedPassword, err := a.hasher.Hash(password)
+	if err != nil {
+		a.logger.Error("Failed to rehash password",
+			slog.String("userID", user.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	// пароль могли сменить параллельно, тогда старый пароль не должен вернуться
+	replaced, err := a.userRepo.ReplacePasswordHash(ctx, user.ID, user.PasswordHash, hashedPassword)
+	if err != nil {
+		a.logger.Error("Failed to update rehashed password",
+			slog.String("userID", user.ID),
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
+	if !replaced {
+		a.logger.Warn("Password changed concurrently, rehash skipped",
+			slog.String("userID", user.ID),
+		)
+		return
+	}
+
+	a.logger.Info("Password rehashed",
+		slog.String("userID", user.ID),
+	)
+}
+
+// compareDummyHash тратит на неизвестный email столько же времени, сколько на проверку настоящего пароля.
+func (a *authService) compareDummyHash(password string) {
+	a.dummyHashOnce.Do(func() {
+		hash, err := a.hasher.Hash("dummy-password-for-timing")
+		if err != nil {
+			a.logger.Error("Failed to hash dummy password", slog.String("error", err.Error()))
+			return
+		}
+		a.dummyHash = hash
+	})
+
+	if a.dummyHash != "" {
+		a.hasher.Equal(a.dummyHash, password)
+	}
+}
+
+// Refresh обменивает refresh токен на новую пару токенов, старый refresh токен при этом отзывается.
+// Повторное предъявление уже отозванного токена считается утечкой: отзываются все refresh токены пользователя.
+func (a *authService) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	tokenHash := token.HashRefreshToken(refreshToken)
+
+	var (
+		pair   *domain.TokenPair
+		reused bool
+	)
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		stored, err := a.tokenRepo.GetRefreshToken(txCtx, tokenHash)
+		if err != nil {
+			a.logger.Error("Failed to get refresh token", slog.String("error", err.Error()))
+			return err
+		}
+
+		if stored == nil || time.Now().After(stored.ExpiresAt) {
+			a.logger.Warn("Unknown or expired refresh token")
+			return ErrInvalidRefreshToken
+		}
+
+		revoked, err := a.tokenRepo.RevokeRefreshToken(txCtx, tokenHash)
+		if err != nil {
+			a.logger.Error("Failed to revoke refresh token",
+				slog.String("userID", stored.UserID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if !revoked {
+			a.logger.Warn("Refresh token reuse detected, revoking all user tokens",
+				slog.String("userID", stored.UserID))
+			reused = true
+			// транзакция должна закоммитить отзыв, поэтому ошибку возвращаем уже после Do
+			return a.tokenRepo.RevokeUserRefreshTokens(txCtx, stored.UserID)
+		}
+
+		user, err := a.userRepo.GetUserByID(txCtx, stored.UserID)
+		if err != nil {
+			a.logger.Error("Failed to get the user during refresh",
+				slog.String("userID", stored.UserID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if user == nil {
+			a.logger.Warn("User not found during refresh",
+				slog.String("userID", stored.UserID))
+			return ErrInvalidRefreshToken
+		}
+
+		if user.Disabled {
+			a.logger.Warn("Disabled user tried to refresh tokens",
+				slog.String("userID", stored.UserID))
+			return ErrUserDisabled
+		}
+
+		pair, err = a.issueTokens(txCtx, user)
+		return err
+	})
+
+	if err != nil {
+		a.logger.Error("Refresh failed", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if reused {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	return pair, nil
+}
+
+// Logout отзывает текущий access токен по jti и, если передан, refresh токен пользователя.
+func (a *authService) Logout(ctx context.Context, userID string, jti string, accessExpiresAt time.Time, refreshToken string) error {
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		if refreshToken != "" {
+			tokenHash := token.HashRefreshToken(refreshToken)
+
+			stored, err := a.tokenRepo.GetRefreshToken(txCtx, tokenHash)
+			if err != nil {
+				a.logger.Error("Failed to get refresh token during logout",
+					slog.String("userID", userID),
+					slog.String("error", err.Error()))
+				return err
+			}
+
+			if stored == nil || stored.UserID != userID {
+				a.logger.Warn("Refresh token does not belong to the user",
+					slog.String("userID", userID))
+				return ErrInvalidRefreshToken
+			}
+
+			if _, err := a.tokenRepo.RevokeRefreshToken(txCtx, tokenHash); err != nil {
+				a.logger.Error("Failed to revoke refresh token during logout",
+					slog.String("userID", userID),
+					slog.String("error", err.Error()))
+				return err
+			}
+		}
+
+		return a.tokenRepo.RevokeAccessToken(txCtx, jti, accessExpiresAt)
+	})
+
+	if err != nil {
+		a.logger.Error("Logout failed",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	a.logger.Info("Logout successful", slog.String("userID", userID))
+	return nil
+}
+
+func (a *authService) ChangePassword(ctx context.Context, userID string, oldPassword string, newPassword string) error {
+	if err := a.passwordPolicy.Validate(newPassword); err != nil {
+		a.logger.Warn("Password change failed: weak password",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return fmt.Errorf("%w: %w", ErrWeakPassword, err)
+	}
+
+	if oldPassword == newPassword {
+		a.logger.Warn("Password change failed: password is unchanged", slog.String("userID", userID))
+		return fmt.Errorf("%w: new password must differ from the current one", ErrWeakPassword)
+	}
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		user, err := a.userRepo.GetUserByID(txCtx, userID)
+		if err != nil {
+			a.logger.Error("Failed to get the user during password change",
+				slog.String("userID", userID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if user == nil {
+			a.logger.Warn("User not found during password change", slog.String("userID", userID))
+			return ErrUserNotFound
+		}
+
+		if !a.hasher.Equal(user.PasswordHash, oldPassword) {
+			a.logger.Warn("Invalid current password during password change", slog.String("userID", userID))
+			return ErrInvalidCredentials
+		}
+
+		return a.setPassword(txCtx, userID, newPassword)
+	})
+
+	if err != nil {
+		a.logger.Error("Password change failed",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	a.logger.Info("Password changed", slog.String("userID", userID))
+	return nil
+}
+
+func (a *authService) RequestPasswordReset(ctx context.Context, email string) error {
+	if err := credentials.ValidateEmail(email); err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+	}
+
+	var (
+		resetToken string
+		expiresAt  time.Time
+	)
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		user, err := a.userRepo.GetUser(txCtx, email)
+		if err != nil {
+			a.logger.Error("Failed to get the user during password reset request",
+				slog.String("email", email),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if user == nil {
+			a.logger.Warn("Password reset requested for unknown email", slog.String("email", email))
+			return nil
+		}
+
+		// отключенный пользователь получает тот же ответ, что и неизвестный email
+		if user.Disabled {
+			a.logger.Warn("Password reset requested for disabled user", slog.String("userID", user.ID))
+			return nil
+		}
+
+		// действует только последний выданный токен
+		if err := a.resetRepo.InvalidateUserResetTokens(txCtx, user.ID); err != nil {
+			return err
+		}
+
+		resetToken, err = token.NewResetToken()
+		if err != nil {
+			return err
+		}
+		expiresAt = time.Now().Add(a.resetTTL)
+
+		return a.resetRepo.CreateResetToken(txCtx, user.ID, token.HashResetToken(resetToken), expiresAt)
+	})
+
+	if err != nil {
+		a.logger.Error("Password reset request failed",
+			slog.String("email", email),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if resetToken == "" {
+		return nil
+	}
+
+	// уведомление уходит после коммита, иначе пользователь мог бы получить токен, которого нет в БД.
+	// Ошибку доставки наружу не отдаем: ответ не должен зависеть от того, есть ли такой email.
+	if err := a.notifier.SendPasswordReset(ctx, email, resetToken, expiresAt); err != nil {
+		a.logger.Error("Failed to send password reset notification",
+			slog.String("email", email),
+			slog.String("error", err.Error()))
+		return nil
+	}
+
+	a.logger.Info("Password reset token sent", slog.String("email", email))
+	return nil
 }
 
+func (a *authService) ResetPassword(ctx context.Context, resetToken string, newPassword string) error {
+	if resetToken == "" {
+		return ErrInvalidResetToken
+	}
+
+	// политику проверяем до транзакции, чтобы слабый пароль не сжигал токен
+	if err := a.passwordPolicy.Validate(newPassword); err != nil {
+		return fmt.Errorf("%w: %w", ErrWeakPassword, err)
+	}
+
+	var userID string
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		userID, err = a.resetRepo.ConsumeResetToken(txCtx, token.HashResetToken(resetToken))
+		if err != nil {
+			a.logger.Error("Failed to consume password reset token", slog.String("error", err.Error()))
+			return err
+		}
+
+		if userID == "" {
+			a.logger.Warn("Unknown, expired or used password reset token")
+			return ErrInvalidResetToken
+		}
+
+		return a.setPassword(txCtx, userID, newPassword)
+	})
+
+	if err != nil {
+		a.logger.Error("Password reset failed", slog.String("error", err.Error()))
+		return err
+	}
+
+	a.logger.Info("Password reset", slog.String("userID", userID))
+	return nil
+}
+
+// setPassword сохраняет новый пароль и завершает остальные сессии: отзывает refresh токены
+// и гасит неиспользованные токены сброса. Выданные access токены доживают до exp.
+func (a *authService) setPassword(ctx context.Context, userID string, password string) error {
+	hashedPassword, err := a.hasher.Hash(password)
+	if err != nil {
+		a.logger.Error("Failed to hash the new password",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if err := a.userRepo.UpdatePassword(ctx, userID, hashedPassword); err != nil {
+		return err
+	}
+
+	if err := a.tokenRepo.RevokeUserRefreshTokens(ctx, userID); err != nil {
+		return err
+	}
+
+	return a.resetRepo.InvalidateUserResetTokens(ctx, userID)
+}
+
+// issueTokens выпускает access токен и сохраняет новый refresh токен в рамках текущей транзакции.
+func (a *authService) issueTokens(ctx context.Context, user *domain.User) (*domain.TokenPair, error) {
+	accessToken, err := a.token.GenerateToken(user.ID, user.Role)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := token.NewRefreshToken()
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.tokenRepo.CreateRefreshToken(ctx, user.ID, token.HashRefreshToken(refreshToken), time.Now().Add(a.refreshTTL))
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.TokenPair{
+		AccessToken:  accessToken,
+		RefreshToken: refreshToken,
+	}, nil
+}
+
+// Register создает пользователя. Доступны только роли из RBAC.SelfRegisterRoles, остальные выдает администратор.
 func (a *authService) Register(ctx context.Context, email string, password string, role string) (userID string, err error) {
+	if !a.policy.CanSelfRegister(role) {
+		a.logger.Warn("Registration failed: role is not allowed",
+			slog.String("email", email),
+			slog.String("role", role),
+		)
+		return "", ErrInvalidRole
+	}
+	role = strings.ToLower(role)
+
+	if err := credentials.ValidateEmail(email); err != nil {
+		a.logger.Warn("Registration failed: invalid email",
+			slog.String("email", email),
+			slog.String("error", err.Error()),
+		)
+		return "", fmt.Errorf("%w: %w", ErrInvalidEmail, err)
+	}
+
+	if err := a.passwordPolicy.Validate(password); err != nil {
+		a.logger.Warn("Registration failed: weak password",
+			slog.String("email", email),
+			slog.String("error", err.Error()),
+		)
+		return "", fmt.Errorf("%w: %w", ErrWeakPassword, err)
+	}
+
 	err = a.txManager.Do(ctx, func(txCtx context.Context) error {
 		user, err := a.userRepo.GetUser(txCtx, email)
 		if err != nil {
@@ -151,15 +663,21 @@ func (a *authService) Register(ctx context.Context, email string, password strin
 	return userID, nil
 }
 
+// DummyLogin выдает токен без учетной записи. Доступен только в режиме разработки.
 func (a *authService) DummyLogin(ctx context.Context, role string) (token string, err error) {
-	if !slices.Contains(a.acceptableRoles, role) {
+	if !a.dummyLogin {
+		a.logger.Warn("Dummy login attempt while disabled", slog.String("role", role))
+		return "", ErrDummyLoginDisabled
+	}
+
+	if !a.policy.HasRole(role) {
 		a.logger.Error("Dummy login failed: invalid role",
 			slog.String("role", role),
 		)
 		return "", ErrInvalidRole
 	}
 
-	token, err = a.token.GenerateToken("dummyRandomID", role)
+	token, err = a.token.GenerateDummyToken("dummyRandomID", role)
 	if err != nil {
 		a.logger.Error("Dummy login failed: token generation error",
 			slog.String("role", role),
@@ -169,3 +687,262 @@ func (a *authService) DummyLogin(ctx context.Context, role string) (token string
 	}
 	return token, err
 }
+
+func (a *authService) ListUsers(ctx context.Context, cursor string, limit int) (*domain.UserPage, error) {
+	if limit < 1 {
+		a.logger.Warn("Invalid pagination", slog.Int("limit", limit))
+		return nil, ErrInvalidPagination
+	}
+
+	filter := domain.UserFilter{
+		Limit: limit + 1, // лишняя строка показывает, есть ли следующая страница
+	}
+
+	if cursor != "" {
+		afterID, err := decodeUserCursor(cursor)
+		if err != nil {
+			a.logger.Warn("Invalid cursor", slog.String("cursor", cursor))
+			return nil, err
+		}
+		filter.AfterID = afterID
+	}
+
+	users, err := a.userRepo.ListUsers(ctx, filter)
+	if err != nil {
+		a.logger.Error("Failed to list users", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	page := &domain.UserPage{
+		Items: users,
+	}
+	if len(users) > limit {
+		page.Items = users[:limit]
+		page.NextCursor = encodeUserCursor(page.Items[limit-1].ID)
+	}
+
+	return page, nil
+}
+
+func (a *authService) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	if !isUserID(userID) {
+		return nil, ErrUserNotFound
+	}
+
+	user, err := a.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		a.logger.Error("Failed to get user",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if user == nil {
+		a.logger.Warn("User not found", slog.String("userID", userID))
+		return nil, ErrUserNotFound
+	}
+
+	return user, nil
+}
+
+// UpdateUserRole меняет роль в учетной записи. Уже выданные access токены несут прежнюю роль до истечения,
+// новые токены, в том числе через /refresh, выпускаются с новой ролью.
+func (a *authService) UpdateUserRole(ctx context.Context, userID string, role string) (*domain.User, error) {
+	if !a.policy.HasRole(role) {
+		a.logger.Warn("Role update failed: unknown role",
+			slog.String("userID", userID),
+			slog.String("role", role))
+		return nil, ErrInvalidRole
+	}
+	role = strings.ToLower(role)
+
+	if actor, ok := rbac.ActorFromContext(ctx); ok && !a.policy.CanManage(actor.Role, role) {
+		a.logger.Warn("Role update failed: role grants permissions the actor does not hold",
+			slog.String("userID", userID),
+			slog.String("role", role),
+			slog.String("actorRole", actor.Role))
+		return nil, ErrInsufficientPermissions
+	}
+
+	var user *domain.User
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := a.checkManageable(txCtx, userID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = a.userRepo.UpdateRole(txCtx, userID, role)
+		if err != nil {
+			a.logger.Error("Failed to update user role",
+				slog.String("userID", userID),
+				slog.String("role", role),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if user == nil {
+			a.logger.Warn("User not found for role update", slog.String("userID", userID))
+			return ErrUserNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Info("User role updated",
+		slog.String("userID", userID),
+		slog.String("role", role))
+	return user, nil
+}
+
+func (a *authService) DeactivateUser(ctx context.Context, userID string) (*domain.User, error) {
+	var user *domain.User
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := a.checkManageable(txCtx, userID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = a.userRepo.SetDisabled(txCtx, userID, true)
+		if err != nil {
+			a.logger.Error("Failed to disable user",
+				slog.String("userID", userID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if user == nil {
+			a.logger.Warn("User not found for deactivation", slog.String("userID", userID))
+			return ErrUserNotFound
+		}
+
+		// access токены отклоняет проверка статуса в JwtAuth и интерсепторе, refresh токены отзываем сразу
+		return a.tokenRepo.RevokeUserRefreshTokens(txCtx, userID)
+	})
+
+	if err != nil {
+		a.logger.Error("User deactivation failed",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	a.logger.Info("User deactivated", slog.String("userID", userID))
+	return user, nil
+}
+
+func (a *authService) ActivateUser(ctx context.Context, userID string) (*domain.User, error) {
+	var user *domain.User
+
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := a.checkManageable(txCtx, userID); err != nil {
+			return err
+		}
+
+		var err error
+		user, err = a.userRepo.SetDisabled(txCtx, userID, false)
+		if err != nil {
+			a.logger.Error("Failed to enable user",
+				slog.String("userID", userID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if user == nil {
+			a.logger.Warn("User not found for activation", slog.String("userID", userID))
+			return ErrUserNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	a.logger.Info("User activated", slog.String("userID", userID))
+	return user, nil
+}
+
+func (a *authService) DeleteUser(ctx context.Context, userID string) error {
+	err := a.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := a.checkManageable(txCtx, userID); err != nil {
+			return err
+		}
+
+		deleted, err := a.userRepo.DeleteUser(txCtx, userID)
+		if err != nil {
+			a.logger.Error("Failed to delete user",
+				slog.String("userID", userID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if !deleted {
+			a.logger.Warn("User not found for deletion", slog.String("userID", userID))
+			return ErrUserNotFound
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	a.logger.Info("User deleted", slog.String("userID", userID))
+	return nil
+}
+
+// checkManageable не дает пользователю из контекста менять собственную учетную запись
+// и учетные записи с правами, которых у него нет (см. rbac.Policy.CanManage).
+// Вызывается в транзакции изменения: строка пользователя блокируется, и его роль не поменяется
+// параллельно между проверкой и записью. Заодно отсекает нечисловые id, которые БД все равно не примет.
+func (a *authService) checkManageable(txCtx context.Context, userID string) error {
+	if !isUserID(userID) {
+		return ErrUserNotFound
+	}
+
+	actor, ok := rbac.ActorFromContext(txCtx)
+	if !ok {
+		return nil
+	}
+
+	if !actor.Dummy && actor.UserID == userID {
+		a.logger.Warn("User tried to manage own account", slog.String("userID", userID))
+		return ErrSelfManagement
+	}
+
+	user, err := a.userRepo.GetUserByIDForUpdate(txCtx, userID)
+	if err != nil {
+		a.logger.Error("Failed to get user to manage",
+			slog.String("userID", userID),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if user == nil {
+		a.logger.Warn("User to manage not found", slog.String("userID", userID))
+		return ErrUserNotFound
+	}
+
+	if !a.policy.CanManage(actor.Role, user.Role) {
+		a.logger.Warn("User tried to manage account with more permissions",
+			slog.String("userID", userID),
+			slog.String("role", user.Role),
+			slog.String("actorRole", actor.Role))
+		return ErrInsufficientPermissions
+	}
+
+	return nil
+}
+
+func isUserID(userID string) bool {
+	_, err := strconv.ParseUint(userID, 10, 64)
+	return err == nil
+}
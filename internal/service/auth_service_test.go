@@ -6,10 +6,16 @@ import (
 	"context"
 	"log/slog"
 	"testing"
+	"time"
 
+	"github.com/Ranik23/avito-tech-spring/internal/credentials"
 	hashermock "github.com/Ranik23/avito-tech-spring/internal/hasher/mock"
+	limitermock "github.com/Ranik23/avito-tech-spring/internal/limiter/mock"
+	notifiermock "github.com/Ranik23/avito-tech-spring/internal/notifier/mock"
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	repomock "github.com/Ranik23/avito-tech-spring/internal/repository/mock"
+	"github.com/Ranik23/avito-tech-spring/internal/token"
 	tokenmock "github.com/Ranik23/avito-tech-spring/internal/token/mock"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -25,6 +31,8 @@ func TestLogin_Success(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
 
 	email := "test@example.com"
 	password := "password"
@@ -36,21 +44,85 @@ func TestLogin_Success(t *testing.T) {
 		Role:         "Client",
 	}
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(user, nil).Times(1)
 	mockHasher.EXPECT().Equal(user.PasswordHash, password).Return(true).Times(1)
 	mockToken.EXPECT().GenerateToken(user.ID, user.Role).Return(expectedToken, nil).Times(1)
+	mockTokenRepo.EXPECT().CreateRefreshToken(gomock.Any(), user.ID, gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
+	mockLimiter.EXPECT().Success(gomock.Any(), email).Return(nil).Times(1)
+	mockHasher.EXPECT().NeedsRehash(user.PasswordHash).Return(false).Times(1)
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		},
 	).Times(1)
 
-	token, err := authService.Login(context.Background(), email, password)
+	pair, err := authService.Login(context.Background(), email, password, "10.0.0.1")
 
 	assert.NoError(t, err)
-	assert.Equal(t, expectedToken, token)
+	assert.Equal(t, expectedToken, pair.AccessToken)
+	assert.NotEmpty(t, pair.RefreshToken)
+}
+
+func TestLogin_RehashesOutdatedHash(t *testing.T) {
+	tests := []struct {
+		name       string
+		replaced   bool
+		replaceErr error
+	}{
+		{name: "Rehashed", replaced: true},
+		{name: "Changed concurrently", replaced: false},
+		{name: "Update fails", replaceErr: assert.AnError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockUserRepo := repomock.NewMockUserRepository(ctrl)
+			mockTxManager := repomock.NewMockTxManager(ctrl)
+			mockToken := tokenmock.NewMockToken(ctrl)
+			mockHasher := hashermock.NewMockHasher(ctrl)
+			mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+			mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
+
+			email := "test@example.com"
+			password := "password"
+			user := &domain.User{
+				ID:           "userID123",
+				Email:        email,
+				PasswordHash: "$2a$10$bcryptHash",
+				Role:         "employee",
+			}
+
+			authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+			mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil)
+			mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+				func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				},
+			)
+			mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(user, nil)
+			mockHasher.EXPECT().Equal(user.PasswordHash, password).Return(true)
+			mockToken.EXPECT().GenerateToken(user.ID, user.Role).Return("access", nil)
+			mockTokenRepo.EXPECT().CreateRefreshToken(gomock.Any(), user.ID, gomock.Any(), gomock.Any()).Return(nil)
+			mockLimiter.EXPECT().Success(gomock.Any(), email).Return(nil)
+			mockHasher.EXPECT().NeedsRehash(user.PasswordHash).Return(true)
+			mockHasher.EXPECT().Hash(password).Return("$argon2id$newHash", nil)
+			mockUserRepo.EXPECT().ReplacePasswordHash(gomock.Any(), user.ID, user.PasswordHash, "$argon2id$newHash").
+				Return(tt.replaced, tt.replaceErr)
+
+			pair, err := authService.Login(context.Background(), email, password, "10.0.0.1")
+
+			// ошибка пересчета хеша не мешает входу
+			assert.NoError(t, err)
+			assert.Equal(t, "access", pair.AccessToken)
+		})
+	}
 }
 
 func TestLogin_TokenGenerationFails(t *testing.T) {
@@ -61,6 +133,8 @@ func TestLogin_TokenGenerationFails(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
 
 	email := "test@example.com"
 	password := "password"
@@ -71,18 +145,19 @@ func TestLogin_TokenGenerationFails(t *testing.T) {
 		Role:         "Client",
 	}
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(user, nil).Times(1)
 	mockHasher.EXPECT().Equal(user.PasswordHash, password).Return(true).Times(1)
 	mockToken.EXPECT().GenerateToken(user.ID, user.Role).Return("token", assert.AnError).Times(1)
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		},
 	)
 
-	token, err := authService.Login(context.Background(), email, password)
+	token, err := authService.Login(context.Background(), email, password, "10.0.0.1")
 
 	assert.Error(t, err)
 	assert.Empty(t, token)
@@ -96,24 +171,30 @@ func TestLogin_UserNotFound(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
 
 	email := "test@example.com"
 	password := "password"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(nil, nil).Times(1)
 
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
+	mockLimiter.EXPECT().Failure(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
+	mockHasher.EXPECT().Hash(gomock.Any()).Return("dummyHash", nil).Times(1)
+	mockHasher.EXPECT().Equal("dummyHash", password).Return(false).Times(1)
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		},
 	)
 
-	token, err := authService.Login(context.Background(), email, password)
+	token, err := authService.Login(context.Background(), email, password, "10.0.0.1")
 
 	assert.Error(t, err)
-	assert.Equal(t, ErrUserNotFound, err)
+	assert.Equal(t, ErrInvalidCredentials, err)
 	assert.Empty(t, token)
 }
 
@@ -125,6 +206,8 @@ func TestLogin_InvalidCredentials(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
 
 	email := "test@example.com"
 	password := "password"
@@ -135,18 +218,20 @@ func TestLogin_InvalidCredentials(t *testing.T) {
 		Role:         "Client",
 	}
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(user, nil).Times(1)
 	mockHasher.EXPECT().Equal(user.PasswordHash, password).Return(false).Times(1)
 
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
+	mockLimiter.EXPECT().Failure(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		},
 	)
 
-	token, err := authService.Login(context.Background(), email, password)
+	token, err := authService.Login(context.Background(), email, password, "10.0.0.1")
 
 	assert.Error(t, err)
 	assert.Equal(t, ErrInvalidCredentials, err)
@@ -161,14 +246,15 @@ func TestRegister_Success(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	email := "test@example.com"
 	password := "password"
-	role := "Client"
+	role := "employee"
 	userID := "userID123"
 	hashedPassword := "hashedPassword"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(nil, nil).Times(1)
 	mockHasher.EXPECT().Hash(password).Return(hashedPassword, nil).Times(1)
@@ -186,6 +272,22 @@ func TestRegister_Success(t *testing.T) {
 	assert.Equal(t, userID, id)
 }
 
+func TestRegister_RoleNotAllowed(t *testing.T) {
+	policy, err := rbac.NewPolicy(map[string][]string{
+		"employee": {"product:add"},
+		"admin":    {"*"},
+	}, []string{"employee"})
+	assert.NoError(t, err)
+
+	authService := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, policy, credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	for _, role := range []string{"admin", "Client", ""} {
+		id, err := authService.Register(context.Background(), "test@example.com", "password", role)
+		assert.ErrorIs(t, err, ErrInvalidRole, role)
+		assert.Empty(t, id)
+	}
+}
+
 func TestRegister_HashFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -194,12 +296,13 @@ func TestRegister_HashFails(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	email := "test@example.com"
 	password := "password"
-	role := "Client"
+	role := "employee"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(nil, nil).Times(1)
 	mockHasher.EXPECT().Hash(password).Return("", assert.AnError).Times(1)
@@ -222,13 +325,14 @@ func TestRegister_CreateUserFails(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	email := "test@example.com"
 	password := "password"
-	role := "Client"
+	role := "employee"
 	hashedPassword := "hashedPassword"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(nil, nil).Times(1)
 	mockHasher.EXPECT().Hash(password).Return(hashedPassword, nil).Times(1)
@@ -253,12 +357,13 @@ func TestRegister_UserAlreadyExists(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	email := "test@example.com"
 	password := "password"
-	role := "Client"
+	role := "employee"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(&domain.User{}, nil).Times(1)
 
@@ -283,13 +388,14 @@ func TestDummyLogin_Success(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	role := "employee"
 	expectedToken := "dummyToken"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
-	mockToken.EXPECT().GenerateToken("dummyRandomID", role).Return(expectedToken, nil).Times(1)
+	mockToken.EXPECT().GenerateDummyToken("dummyRandomID", role).Return(expectedToken, nil).Times(1)
 
 	token, err := authService.DummyLogin(context.Background(), role)
 
@@ -305,10 +411,11 @@ func TestDummyLogin_InvalidRole(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	role := "InvalidRole"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	token, err := authService.DummyLogin(context.Background(), role)
 
@@ -317,6 +424,20 @@ func TestDummyLogin_InvalidRole(t *testing.T) {
 	assert.Empty(t, token)
 }
 
+func TestDummyLogin_Disabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockToken := tokenmock.NewMockToken(ctrl)
+
+	authService := NewAuthService(nil, nil, nil, nil, mockToken, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, false, slog.Default())
+
+	token, err := authService.DummyLogin(context.Background(), "employee")
+
+	assert.ErrorIs(t, err, ErrDummyLoginDisabled)
+	assert.Empty(t, token)
+}
+
 func TestDummyLogin_TokenGenerationFails(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -325,12 +446,13 @@ func TestDummyLogin_TokenGenerationFails(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	role := "moderator"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
-	mockToken.EXPECT().GenerateToken("dummyRandomID", role).Return("", assert.AnError).Times(1)
+	mockToken.EXPECT().GenerateDummyToken("dummyRandomID", role).Return("", assert.AnError).Times(1)
 
 	token, err := authService.DummyLogin(context.Background(), role)
 
@@ -346,20 +468,23 @@ func TestLogin_GetUserFails(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
 
 	email := "test@example.com"
 	password := "password"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(nil, assert.AnError).Times(1)
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(time.Duration(0), nil).Times(1)
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
 			return fn(ctx)
 		},
 	)
 
-	token, err := authService.Login(context.Background(), email, password)
+	token, err := authService.Login(context.Background(), email, password, "10.0.0.1")
 
 	assert.Error(t, err)
 	assert.Empty(t, token)
@@ -373,12 +498,13 @@ func TestRegister_GetUserFails(t *testing.T) {
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 	mockToken := tokenmock.NewMockToken(ctrl)
 	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
 
 	email := "test@example.com"
 	password := "password"
-	role := "Client"
+	role := "employee"
 
-	authService := NewAuthService(mockUserRepo, mockTxManager, mockToken, mockHasher, slog.Default())
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
 
 	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(nil, assert.AnError).Times(1)
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
@@ -391,4 +517,685 @@ func TestRegister_GetUserFails(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.Empty(t, id)
-}
\ No newline at end of file
+}
+func TestRefresh_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockToken := tokenmock.NewMockToken(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+
+	refreshToken := "refresh"
+	tokenHash := token.HashRefreshToken(refreshToken)
+	user := &domain.User{ID: "userID123", Role: "moderator"}
+
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, mockToken, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockTokenRepo.EXPECT().GetRefreshToken(gomock.Any(), tokenHash).
+		Return(&domain.RefreshToken{UserID: user.ID, TokenHash: tokenHash, ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	mockTokenRepo.EXPECT().RevokeRefreshToken(gomock.Any(), tokenHash).Return(true, nil)
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil)
+	mockToken.EXPECT().GenerateToken(user.ID, user.Role).Return("access", nil)
+
+	var newHash string
+	mockTokenRepo.EXPECT().CreateRefreshToken(gomock.Any(), user.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, userID string, hash string, expiresAt time.Time) error {
+			newHash = hash
+			assert.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Second)
+			return nil
+		},
+	)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	pair, err := authService.Refresh(context.Background(), refreshToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "access", pair.AccessToken)
+	assert.NotEqual(t, refreshToken, pair.RefreshToken)
+	assert.Equal(t, token.HashRefreshToken(pair.RefreshToken), newHash)
+}
+
+func TestRefresh_UnknownOrExpired(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored *domain.RefreshToken
+	}{
+		{name: "unknown", stored: nil},
+		{name: "expired", stored: &domain.RefreshToken{UserID: "1", ExpiresAt: time.Now().Add(-time.Minute)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockTxManager := repomock.NewMockTxManager(ctrl)
+			mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+
+			authService := NewAuthService(nil, mockTokenRepo, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+			mockTokenRepo.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(tt.stored, nil)
+			mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+				func(ctx context.Context, fn func(context.Context) error) error {
+					return fn(ctx)
+				},
+			)
+
+			pair, err := authService.Refresh(context.Background(), "refresh")
+
+			assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+			assert.Nil(t, pair)
+		})
+	}
+}
+
+func TestRefresh_ReuseRevokesAllUserTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+
+	authService := NewAuthService(nil, mockTokenRepo, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockTokenRepo.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).
+		Return(&domain.RefreshToken{UserID: "1", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	// токен уже отозван: кто-то обменял его раньше
+	mockTokenRepo.EXPECT().RevokeRefreshToken(gomock.Any(), gomock.Any()).Return(false, nil)
+	mockTokenRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), "1").Return(nil)
+
+	var txErr error
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			txErr = fn(ctx)
+			return txErr
+		},
+	)
+
+	pair, err := authService.Refresh(context.Background(), "refresh")
+
+	assert.NoError(t, txErr, "revocation must be committed")
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+	assert.Nil(t, pair)
+}
+
+func TestLogout_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+
+	authService := NewAuthService(nil, mockTokenRepo, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	expiresAt := time.Now().Add(time.Minute)
+	tokenHash := token.HashRefreshToken("refresh")
+
+	mockTokenRepo.EXPECT().GetRefreshToken(gomock.Any(), tokenHash).Return(&domain.RefreshToken{UserID: "1"}, nil)
+	mockTokenRepo.EXPECT().RevokeRefreshToken(gomock.Any(), tokenHash).Return(true, nil)
+	mockTokenRepo.EXPECT().RevokeAccessToken(gomock.Any(), "jti-1", expiresAt).Return(nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	err := authService.Logout(context.Background(), "1", "jti-1", expiresAt, "refresh")
+	assert.NoError(t, err)
+}
+
+func TestLogout_ForeignRefreshToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+
+	authService := NewAuthService(nil, mockTokenRepo, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockTokenRepo.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any()).Return(&domain.RefreshToken{UserID: "2"}, nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	err := authService.Logout(context.Background(), "1", "jti-1", time.Now(), "refresh")
+	assert.ErrorIs(t, err, ErrInvalidRefreshToken)
+}
+
+func TestLogin_Locked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
+
+	email := "test@example.com"
+
+	authService := NewAuthService(nil, nil, nil, nil, nil, nil, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "10.0.0.1").Return(2*time.Minute, nil).Times(1)
+
+	pair, err := authService.Login(context.Background(), email, "password", "10.0.0.1")
+
+	assert.ErrorIs(t, err, ErrTooManyAttempts)
+	var locked *LoginLockedError
+	assert.ErrorAs(t, err, &locked)
+	assert.Equal(t, 2*time.Minute, locked.RetryAfter)
+	assert.Nil(t, pair)
+}
+
+func TestLogin_LimiterFailureErrorKeepsInvalidCredentials(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
+
+	email := "test@example.com"
+	password := "password"
+	user := &domain.User{
+		ID:           "userID123",
+		Email:        email,
+		PasswordHash: "hashedPassword",
+		Role:         "employee",
+	}
+
+	authService := NewAuthService(mockUserRepo, nil, nil, mockTxManager, nil, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockLimiter.EXPECT().Check(gomock.Any(), email, "").Return(time.Duration(0), nil).Times(1)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), email).Return(user, nil).Times(1)
+	mockHasher.EXPECT().Equal(user.PasswordHash, password).Return(false).Times(1)
+	mockLimiter.EXPECT().Failure(gomock.Any(), email, "").Return(time.Duration(0), assert.AnError).Times(1)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	pair, err := authService.Login(context.Background(), email, password, "")
+
+	assert.Equal(t, ErrInvalidCredentials, err)
+	assert.Nil(t, pair)
+}
+
+func TestRegister_InvalidEmail(t *testing.T) {
+	authService := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	for _, email := range []string{"", "user", "user@localhost", "User <user@example.com>"} {
+		id, err := authService.Register(context.Background(), email, "password", "employee")
+		assert.ErrorIs(t, err, ErrInvalidEmail, email)
+		assert.Empty(t, id)
+	}
+}
+
+func TestRegister_WeakPassword(t *testing.T) {
+	policy := credentials.DefaultPasswordPolicy()
+	policy.RequireDigit = true
+
+	authService := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, rbac.DefaultPolicy(), policy, time.Hour, time.Hour, true, slog.Default())
+
+	for _, password := range []string{"", "short1", "password"} {
+		id, err := authService.Register(context.Background(), "test@example.com", password, "employee")
+		assert.ErrorIs(t, err, ErrWeakPassword, password)
+		assert.Empty(t, id)
+	}
+}
+
+func TestChangePassword_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockResetRepo := repomock.NewMockPasswordResetRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockHasher := hashermock.NewMockHasher(ctrl)
+
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, mockResetRepo, mockTxManager, nil, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	user := &domain.User{ID: "1", Email: "test@example.com", PasswordHash: "oldHash", Role: "employee"}
+
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), "1").Return(user, nil)
+	mockHasher.EXPECT().Equal("oldHash", "oldPassword").Return(true)
+	mockHasher.EXPECT().Hash("newPassword").Return("newHash", nil)
+	mockUserRepo.EXPECT().UpdatePassword(gomock.Any(), "1", "newHash").Return(nil)
+	mockTokenRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), "1").Return(nil)
+	mockResetRepo.EXPECT().InvalidateUserResetTokens(gomock.Any(), "1").Return(nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	err := authService.ChangePassword(context.Background(), "1", "oldPassword", "newPassword")
+	assert.NoError(t, err)
+}
+
+func TestChangePassword_WrongOldPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockHasher := hashermock.NewMockHasher(ctrl)
+
+	authService := NewAuthService(mockUserRepo, nil, nil, mockTxManager, nil, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), "1").Return(&domain.User{ID: "1", PasswordHash: "oldHash"}, nil)
+	mockHasher.EXPECT().Equal("oldHash", "wrongPassword").Return(false)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	err := authService.ChangePassword(context.Background(), "1", "wrongPassword", "newPassword")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestChangePassword_RejectedByPolicy(t *testing.T) {
+	authService := NewAuthService(nil, nil, nil, nil, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	err := authService.ChangePassword(context.Background(), "1", "oldPassword", "short")
+	assert.ErrorIs(t, err, ErrWeakPassword)
+
+	err = authService.ChangePassword(context.Background(), "1", "samePassword", "samePassword")
+	assert.ErrorIs(t, err, ErrWeakPassword)
+}
+
+func TestRequestPasswordReset_SendsToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockResetRepo := repomock.NewMockPasswordResetRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockNotifier := notifiermock.NewMockNotifier(ctrl)
+
+	authService := NewAuthService(mockUserRepo, nil, mockResetRepo, mockTxManager, nil, nil, nil, mockNotifier, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, 30*time.Minute, true, slog.Default())
+
+	var storedHash string
+	var storedExpiresAt time.Time
+
+	gomock.InOrder(
+		mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error {
+				return fn(ctx)
+			},
+		),
+		mockNotifier.EXPECT().SendPasswordReset(gomock.Any(), "test@example.com", gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, email string, resetToken string, expiresAt time.Time) error {
+				assert.Equal(t, storedHash, token.HashResetToken(resetToken), "only the hash is stored")
+				assert.Equal(t, storedExpiresAt, expiresAt)
+				return nil
+			},
+		),
+	)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "test@example.com").Return(&domain.User{ID: "1", Email: "test@example.com"}, nil)
+	mockResetRepo.EXPECT().InvalidateUserResetTokens(gomock.Any(), "1").Return(nil)
+	mockResetRepo.EXPECT().CreateResetToken(gomock.Any(), "1", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, userID string, tokenHash string, expiresAt time.Time) error {
+			storedHash = tokenHash
+			storedExpiresAt = expiresAt
+			assert.WithinDuration(t, time.Now().Add(30*time.Minute), expiresAt, time.Minute)
+			return nil
+		},
+	)
+
+	err := authService.RequestPasswordReset(context.Background(), "test@example.com")
+	assert.NoError(t, err)
+}
+
+func TestRequestPasswordReset_UnknownEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockNotifier := notifiermock.NewMockNotifier(ctrl)
+
+	authService := NewAuthService(mockUserRepo, nil, nil, mockTxManager, nil, nil, nil, mockNotifier, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "ghost@example.com").Return(nil, nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+	mockNotifier.EXPECT().SendPasswordReset(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	err := authService.RequestPasswordReset(context.Background(), "ghost@example.com")
+	assert.NoError(t, err)
+}
+
+func TestRequestPasswordReset_NotifierFailureIsHidden(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockResetRepo := repomock.NewMockPasswordResetRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockNotifier := notifiermock.NewMockNotifier(ctrl)
+
+	authService := NewAuthService(mockUserRepo, nil, mockResetRepo, mockTxManager, nil, nil, nil, mockNotifier, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), "test@example.com").Return(&domain.User{ID: "1"}, nil)
+	mockResetRepo.EXPECT().InvalidateUserResetTokens(gomock.Any(), "1").Return(nil)
+	mockResetRepo.EXPECT().CreateResetToken(gomock.Any(), "1", gomock.Any(), gomock.Any()).Return(nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+	mockNotifier.EXPECT().SendPasswordReset(gomock.Any(), "test@example.com", gomock.Any(), gomock.Any()).Return(assert.AnError)
+
+	err := authService.RequestPasswordReset(context.Background(), "test@example.com")
+	assert.NoError(t, err)
+}
+
+func TestResetPassword_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockResetRepo := repomock.NewMockPasswordResetRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockHasher := hashermock.NewMockHasher(ctrl)
+
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, mockResetRepo, mockTxManager, nil, mockHasher, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockResetRepo.EXPECT().ConsumeResetToken(gomock.Any(), token.HashResetToken("reset")).Return("1", nil)
+	mockHasher.EXPECT().Hash("newPassword").Return("newHash", nil)
+	mockUserRepo.EXPECT().UpdatePassword(gomock.Any(), "1", "newHash").Return(nil)
+	mockTokenRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), "1").Return(nil)
+	mockResetRepo.EXPECT().InvalidateUserResetTokens(gomock.Any(), "1").Return(nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	err := authService.ResetPassword(context.Background(), "reset", "newPassword")
+	assert.NoError(t, err)
+}
+
+func TestResetPassword_InvalidToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockResetRepo := repomock.NewMockPasswordResetRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	authService := NewAuthService(nil, nil, mockResetRepo, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockResetRepo.EXPECT().ConsumeResetToken(gomock.Any(), gomock.Any()).Return("", nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+
+	err := authService.ResetPassword(context.Background(), "reset", "newPassword")
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+
+	err = authService.ResetPassword(context.Background(), "", "newPassword")
+	assert.ErrorIs(t, err, ErrInvalidResetToken)
+}
+
+func TestResetPassword_WeakPasswordKeepsToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockResetRepo := repomock.NewMockPasswordResetRepository(ctrl)
+
+	authService := NewAuthService(nil, nil, mockResetRepo, nil, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockResetRepo.EXPECT().ConsumeResetToken(gomock.Any(), gomock.Any()).Times(0)
+
+	err := authService.ResetPassword(context.Background(), "reset", "short")
+	assert.ErrorIs(t, err, ErrWeakPassword)
+}
+
+func TestLogin_DisabledUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockHasher := hashermock.NewMockHasher(ctrl)
+	mockLimiter := limitermock.NewMockLoginLimiter(ctrl)
+
+	authService := NewAuthService(mockUserRepo, nil, nil, mockTxManager, nil, mockHasher, mockLimiter, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	user := &domain.User{ID: "1", Email: "test@example.com", PasswordHash: "hash", Role: "employee", Disabled: true}
+
+	mockLimiter.EXPECT().Check(gomock.Any(), user.Email, "10.0.0.1").Return(time.Duration(0), nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+	mockUserRepo.EXPECT().GetUser(gomock.Any(), user.Email).Return(user, nil)
+	mockHasher.EXPECT().Equal("hash", "password").Return(true)
+
+	// верный пароль отключенного пользователя не считается неудачной попыткой
+	_, err := authService.Login(context.Background(), user.Email, "password", "10.0.0.1")
+	assert.ErrorIs(t, err, ErrUserDisabled)
+}
+
+func TestRefresh_DisabledUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	refreshToken := "refresh"
+	tokenHash := token.HashRefreshToken(refreshToken)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+	mockTokenRepo.EXPECT().GetRefreshToken(gomock.Any(), tokenHash).
+		Return(&domain.RefreshToken{UserID: "1", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	mockTokenRepo.EXPECT().RevokeRefreshToken(gomock.Any(), tokenHash).Return(true, nil)
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), "1").Return(&domain.User{ID: "1", Role: "employee", Disabled: true}, nil)
+
+	_, err := authService.Refresh(context.Background(), refreshToken)
+	assert.ErrorIs(t, err, ErrUserDisabled)
+}
+
+func TestListUsers_Pagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+
+	authService := NewAuthService(mockUserRepo, nil, nil, nil, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockUserRepo.EXPECT().ListUsers(gomock.Any(), domain.UserFilter{Limit: 3}).
+		Return([]domain.User{{ID: "1"}, {ID: "2"}, {ID: "3"}}, nil)
+
+	page, err := authService.ListUsers(context.Background(), "", 2)
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.NotEmpty(t, page.NextCursor)
+
+	mockUserRepo.EXPECT().ListUsers(gomock.Any(), domain.UserFilter{AfterID: "2", Limit: 3}).
+		Return([]domain.User{{ID: "3"}}, nil)
+
+	page, err = authService.ListUsers(context.Background(), page.NextCursor, 2)
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Empty(t, page.NextCursor)
+
+	_, err = authService.ListUsers(context.Background(), encodePvzCursor("2"), 2)
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestUpdateUserRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+
+	roles := rbac.DefaultRoles()
+	roles["admin"] = []string{rbac.Wildcard}
+	policy, err := rbac.NewPolicy(roles, rbac.DefaultSelfRegisterRoles())
+	assert.NoError(t, err)
+
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+
+	authService := NewAuthService(mockUserRepo, nil, nil, mockTxManager, nil, nil, nil, nil, policy, credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+	moderatorCtx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "1", Role: "moderator"})
+
+	tests := []struct {
+		name        string
+		userID      string
+		role        string
+		mockExpect  func()
+		expectedErr error
+	}{
+		{
+			name:   "Success",
+			userID: "2",
+			role:   "Moderator",
+			mockExpect: func() {
+				mockUserRepo.EXPECT().GetUserByIDForUpdate(gomock.Any(), "2").Return(&domain.User{ID: "2", Role: "employee"}, nil)
+				mockUserRepo.EXPECT().UpdateRole(gomock.Any(), "2", "moderator").
+					Return(&domain.User{ID: "2", Role: "moderator"}, nil)
+			},
+		},
+		{
+			name:        "Moderator grants admin",
+			userID:      "2",
+			role:        "admin",
+			mockExpect:  func() {},
+			expectedErr: ErrInsufficientPermissions,
+		},
+		{
+			name:   "Moderator demotes admin",
+			userID: "4",
+			role:   "employee",
+			mockExpect: func() {
+				mockUserRepo.EXPECT().GetUserByIDForUpdate(gomock.Any(), "4").Return(&domain.User{ID: "4", Role: "admin"}, nil)
+			},
+			expectedErr: ErrInsufficientPermissions,
+		},
+		{
+			name:        "Unknown role",
+			userID:      "2",
+			role:        "root",
+			mockExpect:  func() {},
+			expectedErr: ErrInvalidRole,
+		},
+		{
+			name:        "Own account",
+			userID:      "1",
+			role:        "employee",
+			mockExpect:  func() {},
+			expectedErr: ErrSelfManagement,
+		},
+		{
+			name:        "Non-numeric id",
+			userID:      "dummyRandomID",
+			role:        "employee",
+			mockExpect:  func() {},
+			expectedErr: ErrUserNotFound,
+		},
+		{
+			name:   "Not found",
+			userID: "3",
+			role:   "employee",
+			mockExpect: func() {
+				mockUserRepo.EXPECT().GetUserByIDForUpdate(gomock.Any(), "3").Return(nil, nil)
+			},
+			expectedErr: ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockExpect()
+
+			user, err := authService.UpdateUserRole(moderatorCtx, tt.userID, tt.role)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "moderator", user.Role)
+		})
+	}
+}
+
+func TestDeactivateUser_RevokesRefreshTokens(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTokenRepo := repomock.NewMockTokenRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	authService := NewAuthService(mockUserRepo, mockTokenRepo, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	)
+	mockUserRepo.EXPECT().SetDisabled(gomock.Any(), "2", true).Return(&domain.User{ID: "2", Disabled: true}, nil)
+	mockTokenRepo.EXPECT().RevokeUserRefreshTokens(gomock.Any(), "2").Return(nil)
+
+	user, err := authService.DeactivateUser(context.Background(), "2")
+	assert.NoError(t, err)
+	assert.True(t, user.Disabled)
+}
+
+func TestDeleteUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
+		func(ctx context.Context, fn func(context.Context) error) error {
+			return fn(ctx)
+		},
+	).AnyTimes()
+
+	authService := NewAuthService(mockUserRepo, nil, nil, mockTxManager, nil, nil, nil, nil, rbac.DefaultPolicy(), credentials.DefaultPasswordPolicy(), time.Hour, time.Hour, true, slog.Default())
+
+	mockUserRepo.EXPECT().DeleteUser(gomock.Any(), "2").Return(true, nil)
+	assert.NoError(t, authService.DeleteUser(context.Background(), "2"))
+
+	mockUserRepo.EXPECT().DeleteUser(gomock.Any(), "3").Return(false, nil)
+	assert.ErrorIs(t, authService.DeleteUser(context.Background(), "3"), ErrUserNotFound)
+
+	selfCtx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "1", Role: "moderator"})
+	assert.ErrorIs(t, authService.DeleteUser(selfCtx, "1"), ErrSelfManagement)
+
+	// модератор не может удалить учетную запись с ролью, прав которой у него нет
+	mockUserRepo.EXPECT().GetUserByIDForUpdate(gomock.Any(), "4").Return(&domain.User{ID: "4", Role: "admin"}, nil)
+	assert.ErrorIs(t, authService.DeleteUser(selfCtx, "4"), ErrInsufficientPermissions)
+}
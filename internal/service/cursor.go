@@ -0,0 +1,63 @@
+package service
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+)
+
+const (
+	pvzCursorPrefix   = "pvz:"
+	auditCursorPrefix = "audit:"
+	userCursorPrefix  = "user:"
+)
+
+// Курсор непрозрачен для клиентов: внутри лежит id последнего отданного ПВЗ.
+func encodePvzCursor(lastID string) string {
+	return encodeCursor(pvzCursorPrefix, lastID)
+}
+
+func decodePvzCursor(cursor string) (string, error) {
+	return decodeCursor(pvzCursorPrefix, cursor)
+}
+
+// Курсор журнала аудита хранит id последней отданной записи. Префикс не дает
+// передать курсор списка ПВЗ в журнал и наоборот.
+func encodeAuditCursor(lastID string) string {
+	return encodeCursor(auditCursorPrefix, lastID)
+}
+
+func decodeAuditCursor(cursor string) (string, error) {
+	return decodeCursor(auditCursorPrefix, cursor)
+}
+
+// Курсор списка пользователей хранит id последнего отданного пользователя.
+func encodeUserCursor(lastID string) string {
+	return encodeCursor(userCursorPrefix, lastID)
+}
+
+func decodeUserCursor(cursor string) (string, error) {
+	return decodeCursor(userCursorPrefix, cursor)
+}
+
+func encodeCursor(prefix string, lastID string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(prefix + lastID))
+}
+
+func decodeCursor(prefix string, cursor string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", ErrInvalidCursor
+	}
+
+	lastID, ok := strings.CutPrefix(string(raw), prefix)
+	if !ok {
+		return "", ErrInvalidCursor
+	}
+
+	if _, err := strconv.ParseUint(lastID, 10, 64); err != nil {
+		return "", ErrInvalidCursor
+	}
+
+	return lastID, nil
+}
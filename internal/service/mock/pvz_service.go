@@ -12,7 +12,6 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
-	time "time"
 
 	domain "github.com/Ranik23/avito-tech-spring/internal/models/domain"
 	gomock "go.uber.org/mock/gomock"
@@ -43,18 +42,62 @@ func (m *MockPVZService) EXPECT() *MockPVZServiceMockRecorder {
 }
 
 // AddProduct mocks base method.
-func (m *MockPVZService) AddProduct(ctx context.Context, pvzID, productType string) (*domain.Product, error) {
+func (m *MockPVZService) AddProduct(ctx context.Context, pvzID string, item domain.ProductItem) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, productType)
+	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, item)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockPVZServiceMockRecorder) AddProduct(ctx, pvzID, productType any) *gomock.Call {
+func (mr *MockPVZServiceMockRecorder) AddProduct(ctx, pvzID, item any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockPVZService)(nil).AddProduct), ctx, pvzID, productType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockPVZService)(nil).AddProduct), ctx, pvzID, item)
+}
+
+// AddProducts mocks base method.
+func (m *MockPVZService) AddProducts(ctx context.Context, pvzID string, items []domain.ProductItem) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProducts", ctx, pvzID, items)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProducts indicates an expected call of AddProducts.
+func (mr *MockPVZServiceMockRecorder) AddProducts(ctx, pvzID, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProducts", reflect.TypeOf((*MockPVZService)(nil).AddProducts), ctx, pvzID, items)
+}
+
+// AssignEmployee mocks base method.
+func (m *MockPVZService) AssignEmployee(ctx context.Context, pvzID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignEmployee", ctx, pvzID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignEmployee indicates an expected call of AssignEmployee.
+func (mr *MockPVZServiceMockRecorder) AssignEmployee(ctx, pvzID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignEmployee", reflect.TypeOf((*MockPVZService)(nil).AssignEmployee), ctx, pvzID, userID)
+}
+
+// CancelReception mocks base method.
+func (m *MockPVZService) CancelReception(ctx context.Context, receptionID, reason string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReception", ctx, receptionID, reason)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReception indicates an expected call of CancelReception.
+func (mr *MockPVZServiceMockRecorder) CancelReception(ctx, receptionID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReception", reflect.TypeOf((*MockPVZService)(nil).CancelReception), ctx, receptionID, reason)
 }
 
 // CloseReception mocks base method.
@@ -87,6 +130,21 @@ func (mr *MockPVZServiceMockRecorder) CreatePVZ(ctx, city any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockPVZService)(nil).CreatePVZ), ctx, city)
 }
 
+// CreateProductType mocks base method.
+func (m *MockPVZService) CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, productType)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockPVZServiceMockRecorder) CreateProductType(ctx, productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockPVZService)(nil).CreateProductType), ctx, productType)
+}
+
 // DeleteLastProduct mocks base method.
 func (m *MockPVZService) DeleteLastProduct(ctx context.Context, pvzID string) error {
 	m.ctrl.T.Helper()
@@ -101,49 +159,182 @@ func (mr *MockPVZServiceMockRecorder) DeleteLastProduct(ctx, pvzID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockPVZService)(nil).DeleteLastProduct), ctx, pvzID)
 }
 
+// DeleteProduct mocks base method.
+func (m *MockPVZService) DeleteProduct(ctx context.Context, pvzID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, pvzID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockPVZServiceMockRecorder) DeleteProduct(ctx, pvzID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockPVZService)(nil).DeleteProduct), ctx, pvzID, productID)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockPVZService) DeleteProductType(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockPVZServiceMockRecorder) DeleteProductType(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockPVZService)(nil).DeleteProductType), ctx, name)
+}
+
+// FindProductsByBarcode mocks base method.
+func (m *MockPVZService) FindProductsByBarcode(ctx context.Context, barcode string) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcode", ctx, barcode)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcode indicates an expected call of FindProductsByBarcode.
+func (mr *MockPVZServiceMockRecorder) FindProductsByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcode", reflect.TypeOf((*MockPVZService)(nil).FindProductsByBarcode), ctx, barcode)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockPVZService) GetAuditLog(ctx context.Context, query domain.AuditQuery) (*domain.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, query)
+	ret0, _ := ret[0].(*domain.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockPVZServiceMockRecorder) GetAuditLog(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockPVZService)(nil).GetAuditLog), ctx, query)
+}
+
 // GetPVZList mocks base method.
-func (m *MockPVZService) GetPVZList(ctx context.Context) ([]domain.Pvz, error) {
+func (m *MockPVZService) GetPVZList(ctx context.Context, cursor string, limit int) (*domain.PvzPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZList", ctx)
-	ret0, _ := ret[0].([]domain.Pvz)
+	ret := m.ctrl.Call(m, "GetPVZList", ctx, cursor, limit)
+	ret0, _ := ret[0].(*domain.PvzPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPVZList indicates an expected call of GetPVZList.
-func (mr *MockPVZServiceMockRecorder) GetPVZList(ctx any) *gomock.Call {
+func (mr *MockPVZServiceMockRecorder) GetPVZList(ctx, cursor, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockPVZService)(nil).GetPVZList), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockPVZService)(nil).GetPVZList), ctx, cursor, limit)
 }
 
 // GetPVZSInfo mocks base method.
-func (m *MockPVZService) GetPVZSInfo(ctx context.Context, start, end time.Time, offset, limit int) ([]domain.PvzInfo, error) {
+func (m *MockPVZService) GetPVZSInfo(ctx context.Context, query domain.PvzInfoQuery) (*domain.PvzInfoPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZSInfo", ctx, start, end, offset, limit)
-	ret0, _ := ret[0].([]domain.PvzInfo)
+	ret := m.ctrl.Call(m, "GetPVZSInfo", ctx, query)
+	ret0, _ := ret[0].(*domain.PvzInfoPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPVZSInfo indicates an expected call of GetPVZSInfo.
-func (mr *MockPVZServiceMockRecorder) GetPVZSInfo(ctx, start, end, offset, limit any) *gomock.Call {
+func (mr *MockPVZServiceMockRecorder) GetPVZSInfo(ctx, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfo", reflect.TypeOf((*MockPVZService)(nil).GetPVZSInfo), ctx, start, end, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfo", reflect.TypeOf((*MockPVZService)(nil).GetPVZSInfo), ctx, query)
 }
 
-// GetPVZSInfoOptimized mocks base method.
-func (m *MockPVZService) GetPVZSInfoOptimized(ctx context.Context, start, end time.Time, offset, limit int) ([]domain.PvzInfo, error) {
+// GetReceptionHistory mocks base method.
+func (m *MockPVZService) GetReceptionHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZSInfoOptimized", ctx, start, end, offset, limit)
-	ret0, _ := ret[0].([]domain.PvzInfo)
+	ret := m.ctrl.Call(m, "GetReceptionHistory", ctx, receptionID)
+	ret0, _ := ret[0].([]domain.ReceptionStatusChange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPVZSInfoOptimized indicates an expected call of GetPVZSInfoOptimized.
-func (mr *MockPVZServiceMockRecorder) GetPVZSInfoOptimized(ctx, start, end, offset, limit any) *gomock.Call {
+// GetReceptionHistory indicates an expected call of GetReceptionHistory.
+func (mr *MockPVZServiceMockRecorder) GetReceptionHistory(ctx, receptionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfoOptimized", reflect.TypeOf((*MockPVZService)(nil).GetPVZSInfoOptimized), ctx, start, end, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionHistory", reflect.TypeOf((*MockPVZService)(nil).GetReceptionHistory), ctx, receptionID)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockPVZService) ListProductTypes(ctx context.Context) ([]domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockPVZServiceMockRecorder) ListProductTypes(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockPVZService)(nil).ListProductTypes), ctx)
+}
+
+// PauseReception mocks base method.
+func (m *MockPVZService) PauseReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseReception", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseReception indicates an expected call of PauseReception.
+func (mr *MockPVZServiceMockRecorder) PauseReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseReception", reflect.TypeOf((*MockPVZService)(nil).PauseReception), ctx, receptionID)
+}
+
+// ReopenReception mocks base method.
+func (m *MockPVZService) ReopenReception(ctx context.Context, receptionID, reason string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", ctx, receptionID, reason)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockPVZServiceMockRecorder) ReopenReception(ctx, receptionID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockPVZService)(nil).ReopenReception), ctx, receptionID, reason)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockPVZService) RestoreProduct(ctx context.Context, pvzID, productID string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, pvzID, productID)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockPVZServiceMockRecorder) RestoreProduct(ctx, pvzID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockPVZService)(nil).RestoreProduct), ctx, pvzID, productID)
+}
+
+// ResumeReception mocks base method.
+func (m *MockPVZService) ResumeReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeReception", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeReception indicates an expected call of ResumeReception.
+func (mr *MockPVZServiceMockRecorder) ResumeReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeReception", reflect.TypeOf((*MockPVZService)(nil).ResumeReception), ctx, receptionID)
 }
 
 // StartReception mocks base method.
@@ -160,3 +351,47 @@ func (mr *MockPVZServiceMockRecorder) StartReception(ctx, pvzID any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReception", reflect.TypeOf((*MockPVZService)(nil).StartReception), ctx, pvzID)
 }
+
+// UnassignEmployee mocks base method.
+func (m *MockPVZService) UnassignEmployee(ctx context.Context, pvzID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignEmployee", ctx, pvzID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignEmployee indicates an expected call of UnassignEmployee.
+func (mr *MockPVZServiceMockRecorder) UnassignEmployee(ctx, pvzID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignEmployee", reflect.TypeOf((*MockPVZService)(nil).UnassignEmployee), ctx, pvzID, userID)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockPVZService) UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, productType)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockPVZServiceMockRecorder) UpdateProductType(ctx, productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockPVZService)(nil).UpdateProductType), ctx, productType)
+}
+
+// WatchPVZ mocks base method.
+func (m *MockPVZService) WatchPVZ(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPVZ", ctx, pvzIDs, fromSequence)
+	ret0, _ := ret[0].(<-chan domain.PvzEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchPVZ indicates an expected call of WatchPVZ.
+func (mr *MockPVZServiceMockRecorder) WatchPVZ(ctx, pvzIDs, fromSequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPVZ", reflect.TypeOf((*MockPVZService)(nil).WatchPVZ), ctx, pvzIDs, fromSequence)
+}
@@ -42,19 +42,92 @@ func (m *MockService) EXPECT() *MockServiceMockRecorder {
 	return m.recorder
 }
 
+// ActivateUser mocks base method.
+func (m *MockService) ActivateUser(ctx context.Context, userID string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateUser", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateUser indicates an expected call of ActivateUser.
+func (mr *MockServiceMockRecorder) ActivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateUser", reflect.TypeOf((*MockService)(nil).ActivateUser), ctx, userID)
+}
+
 // AddProduct mocks base method.
-func (m *MockService) AddProduct(ctx context.Context, pvzID, productType string) (*domain.Product, error) {
+func (m *MockService) AddProduct(ctx context.Context, pvzID string, item domain.ProductItem) (*domain.Product, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, productType)
+	ret := m.ctrl.Call(m, "AddProduct", ctx, pvzID, item)
 	ret0, _ := ret[0].(*domain.Product)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddProduct indicates an expected call of AddProduct.
-func (mr *MockServiceMockRecorder) AddProduct(ctx, pvzID, productType any) *gomock.Call {
+func (mr *MockServiceMockRecorder) AddProduct(ctx, pvzID, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockService)(nil).AddProduct), ctx, pvzID, item)
+}
+
+// AddProducts mocks base method.
+func (m *MockService) AddProducts(ctx context.Context, pvzID string, items []domain.ProductItem) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProducts", ctx, pvzID, items)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProducts indicates an expected call of AddProducts.
+func (mr *MockServiceMockRecorder) AddProducts(ctx, pvzID, items any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProducts", reflect.TypeOf((*MockService)(nil).AddProducts), ctx, pvzID, items)
+}
+
+// AssignEmployee mocks base method.
+func (m *MockService) AssignEmployee(ctx context.Context, pvzID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignEmployee", ctx, pvzID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignEmployee indicates an expected call of AssignEmployee.
+func (mr *MockServiceMockRecorder) AssignEmployee(ctx, pvzID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignEmployee", reflect.TypeOf((*MockService)(nil).AssignEmployee), ctx, pvzID, userID)
+}
+
+// CancelReception mocks base method.
+func (m *MockService) CancelReception(ctx context.Context, receptionID, reason string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReception", ctx, receptionID, reason)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReception indicates an expected call of CancelReception.
+func (mr *MockServiceMockRecorder) CancelReception(ctx, receptionID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReception", reflect.TypeOf((*MockService)(nil).CancelReception), ctx, receptionID, reason)
+}
+
+// ChangePassword mocks base method.
+func (m *MockService) ChangePassword(ctx context.Context, userID, oldPassword, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", ctx, userID, oldPassword, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockServiceMockRecorder) ChangePassword(ctx, userID, oldPassword, newPassword any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProduct", reflect.TypeOf((*MockService)(nil).AddProduct), ctx, pvzID, productType)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockService)(nil).ChangePassword), ctx, userID, oldPassword, newPassword)
 }
 
 // CloseReception mocks base method.
@@ -87,6 +160,36 @@ func (mr *MockServiceMockRecorder) CreatePVZ(ctx, city any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockService)(nil).CreatePVZ), ctx, city)
 }
 
+// CreateProductType mocks base method.
+func (m *MockService) CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, productType)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockServiceMockRecorder) CreateProductType(ctx, productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockService)(nil).CreateProductType), ctx, productType)
+}
+
+// DeactivateUser mocks base method.
+func (m *MockService) DeactivateUser(ctx context.Context, userID string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateUser", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeactivateUser indicates an expected call of DeactivateUser.
+func (mr *MockServiceMockRecorder) DeactivateUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateUser", reflect.TypeOf((*MockService)(nil).DeactivateUser), ctx, userID)
+}
+
 // DeleteLastProduct mocks base method.
 func (m *MockService) DeleteLastProduct(ctx context.Context, pvzID string) error {
 	m.ctrl.T.Helper()
@@ -101,6 +204,48 @@ func (mr *MockServiceMockRecorder) DeleteLastProduct(ctx, pvzID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLastProduct", reflect.TypeOf((*MockService)(nil).DeleteLastProduct), ctx, pvzID)
 }
 
+// DeleteProduct mocks base method.
+func (m *MockService) DeleteProduct(ctx context.Context, pvzID, productID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProduct", ctx, pvzID, productID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProduct indicates an expected call of DeleteProduct.
+func (mr *MockServiceMockRecorder) DeleteProduct(ctx, pvzID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockService)(nil).DeleteProduct), ctx, pvzID, productID)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockService) DeleteProductType(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockServiceMockRecorder) DeleteProductType(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockService)(nil).DeleteProductType), ctx, name)
+}
+
+// DeleteUser mocks base method.
+func (m *MockService) DeleteUser(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUser", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUser indicates an expected call of DeleteUser.
+func (mr *MockServiceMockRecorder) DeleteUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockService)(nil).DeleteUser), ctx, userID)
+}
+
 // DummyLogin mocks base method.
 func (m *MockService) DummyLogin(ctx context.Context, role string) (string, error) {
 	m.ctrl.T.Helper()
@@ -116,64 +261,183 @@ func (mr *MockServiceMockRecorder) DummyLogin(ctx, role any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DummyLogin", reflect.TypeOf((*MockService)(nil).DummyLogin), ctx, role)
 }
 
+// FindProductsByBarcode mocks base method.
+func (m *MockService) FindProductsByBarcode(ctx context.Context, barcode string) ([]domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcode", ctx, barcode)
+	ret0, _ := ret[0].([]domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcode indicates an expected call of FindProductsByBarcode.
+func (mr *MockServiceMockRecorder) FindProductsByBarcode(ctx, barcode any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcode", reflect.TypeOf((*MockService)(nil).FindProductsByBarcode), ctx, barcode)
+}
+
+// GetAuditLog mocks base method.
+func (m *MockService) GetAuditLog(ctx context.Context, query domain.AuditQuery) (*domain.AuditPage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuditLog", ctx, query)
+	ret0, _ := ret[0].(*domain.AuditPage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuditLog indicates an expected call of GetAuditLog.
+func (mr *MockServiceMockRecorder) GetAuditLog(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuditLog", reflect.TypeOf((*MockService)(nil).GetAuditLog), ctx, query)
+}
+
 // GetPVZList mocks base method.
-func (m *MockService) GetPVZList(ctx context.Context) ([]domain.Pvz, error) {
+func (m *MockService) GetPVZList(ctx context.Context, cursor string, limit int) (*domain.PvzPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZList", ctx)
-	ret0, _ := ret[0].([]domain.Pvz)
+	ret := m.ctrl.Call(m, "GetPVZList", ctx, cursor, limit)
+	ret0, _ := ret[0].(*domain.PvzPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPVZList indicates an expected call of GetPVZList.
-func (mr *MockServiceMockRecorder) GetPVZList(ctx any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetPVZList(ctx, cursor, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockService)(nil).GetPVZList), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZList", reflect.TypeOf((*MockService)(nil).GetPVZList), ctx, cursor, limit)
 }
 
 // GetPVZSInfo mocks base method.
-func (m *MockService) GetPVZSInfo(ctx context.Context, start, end time.Time, offset, limit int) ([]domain.PvzInfo, error) {
+func (m *MockService) GetPVZSInfo(ctx context.Context, query domain.PvzInfoQuery) (*domain.PvzInfoPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZSInfo", ctx, start, end, offset, limit)
-	ret0, _ := ret[0].([]domain.PvzInfo)
+	ret := m.ctrl.Call(m, "GetPVZSInfo", ctx, query)
+	ret0, _ := ret[0].(*domain.PvzInfoPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPVZSInfo indicates an expected call of GetPVZSInfo.
-func (mr *MockServiceMockRecorder) GetPVZSInfo(ctx, start, end, offset, limit any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetPVZSInfo(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfo", reflect.TypeOf((*MockService)(nil).GetPVZSInfo), ctx, query)
+}
+
+// GetReceptionHistory mocks base method.
+func (m *MockService) GetReceptionHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionHistory", ctx, receptionID)
+	ret0, _ := ret[0].([]domain.ReceptionStatusChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionHistory indicates an expected call of GetReceptionHistory.
+func (mr *MockServiceMockRecorder) GetReceptionHistory(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionHistory", reflect.TypeOf((*MockService)(nil).GetReceptionHistory), ctx, receptionID)
+}
+
+// GetUser mocks base method.
+func (m *MockService) GetUser(ctx context.Context, userID string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUser indicates an expected call of GetUser.
+func (mr *MockServiceMockRecorder) GetUser(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockService)(nil).GetUser), ctx, userID)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockService) ListProductTypes(ctx context.Context) ([]domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockServiceMockRecorder) ListProductTypes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfo", reflect.TypeOf((*MockService)(nil).GetPVZSInfo), ctx, start, end, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockService)(nil).ListProductTypes), ctx)
 }
 
-// GetPVZSInfoOptimized mocks base method.
-func (m *MockService) GetPVZSInfoOptimized(ctx context.Context, start, end time.Time, offset, limit int) ([]domain.PvzInfo, error) {
+// ListUsers mocks base method.
+func (m *MockService) ListUsers(ctx context.Context, cursor string, limit int) (*domain.UserPage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPVZSInfoOptimized", ctx, start, end, offset, limit)
-	ret0, _ := ret[0].([]domain.PvzInfo)
+	ret := m.ctrl.Call(m, "ListUsers", ctx, cursor, limit)
+	ret0, _ := ret[0].(*domain.UserPage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPVZSInfoOptimized indicates an expected call of GetPVZSInfoOptimized.
-func (mr *MockServiceMockRecorder) GetPVZSInfoOptimized(ctx, start, end, offset, limit any) *gomock.Call {
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockServiceMockRecorder) ListUsers(ctx, cursor, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZSInfoOptimized", reflect.TypeOf((*MockService)(nil).GetPVZSInfoOptimized), ctx, start, end, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockService)(nil).ListUsers), ctx, cursor, limit)
 }
 
 // Login mocks base method.
-func (m *MockService) Login(ctx context.Context, email, password string) (string, error) {
+func (m *MockService) Login(ctx context.Context, email, password, clientIP string) (*domain.TokenPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", ctx, email, password)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Login", ctx, email, password, clientIP)
+	ret0, _ := ret[0].(*domain.TokenPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockServiceMockRecorder) Login(ctx, email, password any) *gomock.Call {
+func (mr *MockServiceMockRecorder) Login(ctx, email, password, clientIP any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockService)(nil).Login), ctx, email, password, clientIP)
+}
+
+// Logout mocks base method.
+func (m *MockService) Logout(ctx context.Context, userID, jti string, accessExpiresAt time.Time, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, userID, jti, accessExpiresAt, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockServiceMockRecorder) Logout(ctx, userID, jti, accessExpiresAt, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockService)(nil).Logout), ctx, userID, jti, accessExpiresAt, refreshToken)
+}
+
+// PauseReception mocks base method.
+func (m *MockService) PauseReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseReception", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseReception indicates an expected call of PauseReception.
+func (mr *MockServiceMockRecorder) PauseReception(ctx, receptionID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockService)(nil).Login), ctx, email, password)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseReception", reflect.TypeOf((*MockService)(nil).PauseReception), ctx, receptionID)
+}
+
+// Refresh mocks base method.
+func (m *MockService) Refresh(ctx context.Context, refreshToken string) (*domain.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", ctx, refreshToken)
+	ret0, _ := ret[0].(*domain.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockServiceMockRecorder) Refresh(ctx, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockService)(nil).Refresh), ctx, refreshToken)
 }
 
 // Register mocks base method.
@@ -191,6 +455,79 @@ func (mr *MockServiceMockRecorder) Register(ctx, email, password, role any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockService)(nil).Register), ctx, email, password, role)
 }
 
+// ReopenReception mocks base method.
+func (m *MockService) ReopenReception(ctx context.Context, receptionID, reason string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", ctx, receptionID, reason)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockServiceMockRecorder) ReopenReception(ctx, receptionID, reason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockService)(nil).ReopenReception), ctx, receptionID, reason)
+}
+
+// RequestPasswordReset mocks base method.
+func (m *MockService) RequestPasswordReset(ctx context.Context, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestPasswordReset", ctx, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestPasswordReset indicates an expected call of RequestPasswordReset.
+func (mr *MockServiceMockRecorder) RequestPasswordReset(ctx, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestPasswordReset", reflect.TypeOf((*MockService)(nil).RequestPasswordReset), ctx, email)
+}
+
+// ResetPassword mocks base method.
+func (m *MockService) ResetPassword(ctx context.Context, resetToken, newPassword string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPassword", ctx, resetToken, newPassword)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetPassword indicates an expected call of ResetPassword.
+func (mr *MockServiceMockRecorder) ResetPassword(ctx, resetToken, newPassword any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPassword", reflect.TypeOf((*MockService)(nil).ResetPassword), ctx, resetToken, newPassword)
+}
+
+// RestoreProduct mocks base method.
+func (m *MockService) RestoreProduct(ctx context.Context, pvzID, productID string) (*domain.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreProduct", ctx, pvzID, productID)
+	ret0, _ := ret[0].(*domain.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreProduct indicates an expected call of RestoreProduct.
+func (mr *MockServiceMockRecorder) RestoreProduct(ctx, pvzID, productID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreProduct", reflect.TypeOf((*MockService)(nil).RestoreProduct), ctx, pvzID, productID)
+}
+
+// ResumeReception mocks base method.
+func (m *MockService) ResumeReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeReception", ctx, receptionID)
+	ret0, _ := ret[0].(*domain.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeReception indicates an expected call of ResumeReception.
+func (mr *MockServiceMockRecorder) ResumeReception(ctx, receptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeReception", reflect.TypeOf((*MockService)(nil).ResumeReception), ctx, receptionID)
+}
+
 // StartReception mocks base method.
 func (m *MockService) StartReception(ctx context.Context, pvzID string) (*domain.Reception, error) {
 	m.ctrl.T.Helper()
@@ -205,3 +542,62 @@ func (mr *MockServiceMockRecorder) StartReception(ctx, pvzID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReception", reflect.TypeOf((*MockService)(nil).StartReception), ctx, pvzID)
 }
+
+// UnassignEmployee mocks base method.
+func (m *MockService) UnassignEmployee(ctx context.Context, pvzID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignEmployee", ctx, pvzID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassignEmployee indicates an expected call of UnassignEmployee.
+func (mr *MockServiceMockRecorder) UnassignEmployee(ctx, pvzID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignEmployee", reflect.TypeOf((*MockService)(nil).UnassignEmployee), ctx, pvzID, userID)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockService) UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, productType)
+	ret0, _ := ret[0].(*domain.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockServiceMockRecorder) UpdateProductType(ctx, productType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockService)(nil).UpdateProductType), ctx, productType)
+}
+
+// UpdateUserRole mocks base method.
+func (m *MockService) UpdateUserRole(ctx context.Context, userID, role string) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserRole", ctx, userID, role)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserRole indicates an expected call of UpdateUserRole.
+func (mr *MockServiceMockRecorder) UpdateUserRole(ctx, userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserRole", reflect.TypeOf((*MockService)(nil).UpdateUserRole), ctx, userID, role)
+}
+
+// WatchPVZ mocks base method.
+func (m *MockService) WatchPVZ(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPVZ", ctx, pvzIDs, fromSequence)
+	ret0, _ := ret[0].(<-chan domain.PvzEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchPVZ indicates an expected call of WatchPVZ.
+func (mr *MockServiceMockRecorder) WatchPVZ(ctx, pvzIDs, fromSequence any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPVZ", reflect.TypeOf((*MockService)(nil).WatchPVZ), ctx, pvzIDs, fromSequence)
+}
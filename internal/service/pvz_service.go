@@ -2,27 +2,55 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/Ranik23/avito-tech-spring/internal/events"
 	"github.com/Ranik23/avito-tech-spring/internal/metrics"
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/repository"
 )
 
 type PVZService interface {
 	CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error)
 
-	GetPVZSInfo(ctx context.Context, start time.Time, end time.Time, offset int, limit int) ([]domain.PvzInfo, error)
-	GetPVZList(ctx context.Context) ([]domain.Pvz, error)
+	GetPVZSInfo(ctx context.Context, query domain.PvzInfoQuery) (*domain.PvzInfoPage, error)
+	GetPVZList(ctx context.Context, cursor string, limit int) (*domain.PvzPage, error)
 
-	AddProduct(ctx context.Context, pvzID string, productType string) (*domain.Product, error)
+	AddProduct(ctx context.Context, pvzID string, item domain.ProductItem) (*domain.Product, error)
+	AddProducts(ctx context.Context, pvzID string, items []domain.ProductItem) ([]domain.Product, error)
 	DeleteLastProduct(ctx context.Context, pvzID string) error
+	DeleteProduct(ctx context.Context, pvzID string, productID string) error
+	RestoreProduct(ctx context.Context, pvzID string, productID string) (*domain.Product, error)
+	FindProductsByBarcode(ctx context.Context, barcode string) ([]domain.Product, error)
 
 	StartReception(ctx context.Context, pvzID string) (*domain.Reception, error)
 	CloseReception(ctx context.Context, pvzID string) (*domain.Reception, error)
+	PauseReception(ctx context.Context, receptionID string) (*domain.Reception, error)
+	ResumeReception(ctx context.Context, receptionID string) (*domain.Reception, error)
+	CancelReception(ctx context.Context, receptionID string, reason string) (*domain.Reception, error)
+	ReopenReception(ctx context.Context, receptionID string, reason string) (*domain.Reception, error)
+	GetReceptionHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error)
+
+	WatchPVZ(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error)
+
+	AssignEmployee(ctx context.Context, pvzID string, userID string) error
+	UnassignEmployee(ctx context.Context, pvzID string, userID string) error
+
+	GetAuditLog(ctx context.Context, query domain.AuditQuery) (*domain.AuditPage, error)
+
+	ListProductTypes(ctx context.Context) ([]domain.ProductType, error)
+	CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error)
+	UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error)
+	DeleteProductType(ctx context.Context, name string) error
 }
 
 type pvzService struct {
@@ -30,57 +58,134 @@ type pvzService struct {
 	pvzRepo       repository.PvzRepository
 	receptionRepo repository.ReceptionRepository
 	productRepo   repository.ProductRepository
+	typeRepo      repository.ProductTypeRepository
+	outboxRepo    repository.OutboxRepository
+	auditRepo     repository.AuditRepository
+	assignRepo    repository.AssignmentRepository
+	userRepo      repository.UserRepository
 	txManager     repository.TxManager
+	broker        events.Broker
+	policy        *rbac.Policy
 	cities        []string
 }
 
 func NewPVZService(pvzRepo repository.PvzRepository, receptionRepo repository.ReceptionRepository, cities []string,
-	productRepo repository.ProductRepository, manager repository.TxManager, logger *slog.Logger) PVZService {
+	productRepo repository.ProductRepository, typeRepo repository.ProductTypeRepository, outboxRepo repository.OutboxRepository, auditRepo repository.AuditRepository,
+	assignRepo repository.AssignmentRepository, userRepo repository.UserRepository, manager repository.TxManager,
+	broker events.Broker, policy *rbac.Policy, logger *slog.Logger) PVZService {
 	return &pvzService{
 		logger:        logger,
 		pvzRepo:       pvzRepo,
 		receptionRepo: receptionRepo,
 		productRepo:   productRepo,
+		typeRepo:      typeRepo,
+		outboxRepo:    outboxRepo,
+		auditRepo:     auditRepo,
+		assignRepo:    assignRepo,
+		userRepo:      userRepo,
 		txManager:     manager,
+		broker:        broker,
+		policy:        policy,
 		cities:        cities,
 	}
 }
 
-func (p *pvzService) GetPVZList(ctx context.Context) ([]domain.Pvz, error) {
-	pvzs, err := p.pvzRepo.GetListOfPVZS(ctx)
+// GetPVZList отдает ПВЗ страницами по курсору, пустой cursor - первая страница.
+func (p *pvzService) GetPVZList(ctx context.Context, cursor string, limit int) (*domain.PvzPage, error) {
+	if limit < 1 {
+		p.logger.Warn("Invalid pagination", slog.Int("limit", limit))
+		return nil, ErrInvalidPagination
+	}
+
+	filter := domain.PvzFilter{
+		Limit: limit + 1, // лишняя строка показывает, есть ли следующая страница
+	}
+
+	if cursor != "" {
+		afterID, err := decodePvzCursor(cursor)
+		if err != nil {
+			p.logger.Warn("Invalid cursor", slog.String("cursor", cursor))
+			return nil, err
+		}
+		filter.AfterID = afterID
+	}
+
+	pvzIDs, visible, err := p.restrictToAssigned(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return &domain.PvzPage{Items: []domain.Pvz{}}, nil
+	}
+	filter.PvzIDs = pvzIDs
+
+	pvzs, err := p.pvzRepo.GetPVZS(ctx, filter)
 	if err != nil {
 		p.logger.Error("Failed to get PVZ list", slog.String("error", err.Error()))
 		return nil, err
 	}
-	return pvzs, nil
+
+	page := &domain.PvzPage{
+		Items: pvzs,
+	}
+	if len(pvzs) > limit {
+		page.Items = pvzs[:limit]
+		page.NextCursor = encodePvzCursor(page.Items[limit-1].ID)
+	}
+
+	return page, nil
 }
 
-func (p *pvzService) AddProduct(ctx context.Context, pvzID string, productType string) (*domain.Product, error) {
+func (p *pvzService) AddProduct(ctx context.Context, pvzID string, item domain.ProductItem) (*domain.Product, error) {
+	items, err := p.checkProductItems(ctx, []domain.ProductItem{item})
+	if err != nil {
+		return nil, err
+	}
+	item = items[0]
+
 	var product *domain.Product
 
-	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
-		reception, err := p.receptionRepo.FindOpen(txCtx, pvzID)
-		if err != nil {
-			p.logger.Error("Failed to find the open reception",
-				slog.String("pvzID", pvzID), slog.String("productType", productType),
-				slog.String("error", err.Error()))
+	err = p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkPVZAccess(txCtx, pvzID); err != nil {
 			return err
 		}
 
-		if reception == nil {
-			p.logger.Warn("No open reception found", slog.String("pvzID", pvzID),
-				slog.String("productType", productType))
-			return ErrAllReceptionsClosed
+		reception, err := p.findReceptionInProgress(txCtx, pvzID)
+		if err != nil {
+			return err
 		}
 
-		product, err = p.productRepo.CreateProduct(txCtx, productType, reception.ID)
+		product, err = p.productRepo.CreateProduct(txCtx, item, reception.ID)
 		if err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				p.logger.Warn("Barcode already scanned in the reception", slog.String("pvzID", pvzID),
+					slog.String("barcode", item.Barcode))
+				return ErrDuplicateBarcode
+			}
 			p.logger.Error("Failed to create the product", slog.String("pvzID", pvzID),
-				slog.String("productType", productType), slog.String("error", err.Error()))
+				slog.String("productType", item.Type), slog.String("error", err.Error()))
 			return err
 		}
 
-		return nil
+		err = p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action:      domain.AuditProductAdded,
+			PvzID:       pvzID,
+			ReceptionID: product.ReceptionID,
+			ProductID:   product.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return p.addOutboxEvent(txCtx, domain.EventProductAdded, eventPayload{
+			PvzID:       pvzID,
+			ReceptionID: product.ReceptionID,
+			ProductID:   product.ID,
+			ProductType: product.Type,
+			Barcode:     product.Barcode,
+			OrderID:     product.OrderID,
+			OccurredAt:  product.DateTime,
+		})
 	})
 
 	if err != nil {
@@ -90,13 +195,113 @@ func (p *pvzService) AddProduct(ctx context.Context, pvzID string, productType s
 
 	metrics.ProductsAddedTotal.Inc()
 
+	p.broker.Publish(domain.PvzEvent{
+		Type:        domain.EventProductAdded,
+		PvzID:       pvzID,
+		ReceptionID: product.ReceptionID,
+		ProductID:   product.ID,
+		OccurredAt:  product.DateTime,
+	})
+
 	return product, nil
 }
 
+// AddProducts добавляет все товары в открытую приемку одной транзакцией: либо все, либо ни одного.
+func (p *pvzService) AddProducts(ctx context.Context, pvzID string, items []domain.ProductItem) ([]domain.Product, error) {
+	if len(items) == 0 || len(items) > MaxProductsBatchSize {
+		p.logger.Warn("Invalid products batch size", slog.String("pvzID", pvzID),
+			slog.Int("size", len(items)))
+		return nil, ErrInvalidBatchSize
+	}
+
+	items, err := p.checkProductItems(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+
+	var products []domain.Product
+
+	err = p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkPVZAccess(txCtx, pvzID); err != nil {
+			return err
+		}
+
+		reception, err := p.findReceptionInProgress(txCtx, pvzID)
+		if err != nil {
+			return err
+		}
+
+		products, err = p.productRepo.CreateProducts(txCtx, items, reception.ID)
+		if err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				p.logger.Warn("Barcode already scanned in the reception", slog.String("pvzID", pvzID))
+				return ErrDuplicateBarcode
+			}
+			p.logger.Error("Failed to create the products", slog.String("pvzID", pvzID),
+				slog.Int("size", len(items)), slog.String("error", err.Error()))
+			return err
+		}
+
+		for _, product := range products {
+			err := p.addAuditEntry(txCtx, domain.AuditEntry{
+				Action:      domain.AuditProductAdded,
+				PvzID:       pvzID,
+				ReceptionID: product.ReceptionID,
+				ProductID:   product.ID,
+			})
+			if err != nil {
+				return err
+			}
+
+			err = p.addOutboxEvent(txCtx, domain.EventProductAdded, eventPayload{
+				PvzID:       pvzID,
+				ReceptionID: product.ReceptionID,
+				ProductID:   product.ID,
+				ProductType: product.Type,
+				Barcode:     product.Barcode,
+				OrderID:     product.OrderID,
+				OccurredAt:  product.DateTime,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		p.logger.Error("Failed to Add products", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	metrics.ProductsAddedTotal.Add(float64(len(products)))
+
+	for _, product := range products {
+		p.broker.Publish(domain.PvzEvent{
+			Type:        domain.EventProductAdded,
+			PvzID:       pvzID,
+			ReceptionID: product.ReceptionID,
+			ProductID:   product.ID,
+			OccurredAt:  product.DateTime,
+		})
+	}
+
+	return products, nil
+}
+
+// CloseReception закрывает открытую приемку ПВЗ, в том числе приостановленную.
 func (p *pvzService) CloseReception(ctx context.Context, pvzID string) (*domain.Reception, error) {
-	var receptionToReturn *domain.Reception
+	var (
+		receptionToReturn *domain.Reception
+		change            *domain.ReceptionStatusChange
+	)
 
 	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkPVZAccess(txCtx, pvzID); err != nil {
+			return err
+		}
+
 		reception, err := p.receptionRepo.FindOpen(txCtx, pvzID)
 		if err != nil {
 			p.logger.Error("Failed to find the open reception", slog.String("pvzID", pvzID),
@@ -109,10 +314,8 @@ func (p *pvzService) CloseReception(ctx context.Context, pvzID string) (*domain.
 			return ErrAllReceptionsClosed
 		}
 
-		err = p.receptionRepo.UpdateReceptionStatus(txCtx, reception.ID, "closed")
+		change, err = p.applyReceptionTransition(txCtx, reception, domain.ReceptionActionClose, "")
 		if err != nil {
-			p.logger.Error("Failed to update the reception status", slog.String("pvzID", pvzID),
-				slog.String("error", err.Error()))
 			return err
 		}
 
@@ -125,163 +328,1240 @@ func (p *pvzService) CloseReception(ctx context.Context, pvzID string) (*domain.
 		return nil, err
 	}
 
+	p.publishReceptionEvent(receptionToReturn, change)
+
 	return receptionToReturn, nil
 }
 
-func (p *pvzService) CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error) {
-	if !slices.Contains(p.cities, city) {
-		p.logger.Warn("Invalid city for PVZ creation", slog.String("city", city))
-		return nil, ErrInvalidCity
+// PauseReception приостанавливает приемку: товары в нее не добавляются и не удаляются до ResumeReception.
+func (p *pvzService) PauseReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	return p.changeReceptionStatus(ctx, receptionID, domain.ReceptionActionPause, "")
+}
+
+func (p *pvzService) ResumeReception(ctx context.Context, receptionID string) (*domain.Reception, error) {
+	return p.changeReceptionStatus(ctx, receptionID, domain.ReceptionActionResume, "")
+}
+
+// CancelReception отменяет незавершенную приемку. Принятые товары остаются в ней, но приемку
+// больше нельзя вернуть в работу.
+func (p *pvzService) CancelReception(ctx context.Context, receptionID string, reason string) (*domain.Reception, error) {
+	return p.changeReceptionStatus(ctx, receptionID, domain.ReceptionActionCancel, reason)
+}
+
+// ReopenReception возвращает в работу закрытую приемку, если в ПВЗ нет другой открытой.
+// Право reception:reopen по умолчанию есть только у модератора.
+func (p *pvzService) ReopenReception(ctx context.Context, receptionID string, reason string) (*domain.Reception, error) {
+	return p.changeReceptionStatus(ctx, receptionID, domain.ReceptionActionReopen, reason)
+}
+
+// GetReceptionHistory отдает переходы приемки от открытия к последнему.
+func (p *pvzService) GetReceptionHistory(ctx context.Context, receptionID string) ([]domain.ReceptionStatusChange, error) {
+	if !isReceptionID(receptionID) {
+		p.logger.Warn("Invalid reception id", slog.String("receptionID", receptionID))
+		return nil, ErrReceptionNotFound
 	}
 
-	var pvz *domain.Pvz
+	var history []domain.ReceptionStatusChange
 
 	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if _, err := p.getAccessibleReception(txCtx, receptionID); err != nil {
+			return err
+		}
+
 		var err error
-		pvz, err = p.pvzRepo.CreatePVZ(txCtx, city)
+		history, err = p.receptionRepo.GetStatusHistory(txCtx, receptionID)
 		if err != nil {
-			if errors.Is(err, repository.ErrAlreadyExists) {
-				p.logger.Warn("PVZ already exists", slog.String("city", city))
-				return ErrAlreadyExists
-			}
-			p.logger.Error("Failed to create PVZ", slog.String("city", city),
+			p.logger.Error("Failed to get reception status history", slog.String("receptionID", receptionID),
 				slog.String("error", err.Error()))
 			return err
 		}
+
 		return nil
 	})
 	if err != nil {
-		p.logger.Error("Failed to CreatePVZ", slog.String("city", city),
-			slog.String("error", err.Error()))
+		p.logger.Error("Failed to get reception history", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	metrics.PvzCreatedTotal.Inc()
-
-	return pvz, nil
+	return history, nil
 }
 
-func (p *pvzService) DeleteLastProduct(ctx context.Context, pvzID string) error {
-	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
-		reception, err := p.receptionRepo.FindOpen(txCtx, pvzID)
-		if err != nil {
-			p.logger.Error("Failed to find the open reception", slog.String("pvzID", pvzID),
-				slog.String("error", err.Error()))
-			return err
-		}
+// changeReceptionStatus выполняет переход action над приемкой по ее id.
+func (p *pvzService) changeReceptionStatus(ctx context.Context, receptionID string, action string, reason string) (*domain.Reception, error) {
+	if !isReceptionID(receptionID) {
+		p.logger.Warn("Invalid reception id", slog.String("receptionID", receptionID))
+		return nil, ErrReceptionNotFound
+	}
 
-		if reception == nil {
-			p.logger.Warn("No open reception found", slog.String("pvzID", pvzID))
-			return ErrAllReceptionsClosed
-		}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxReceptionReasonLength {
+		p.logger.Warn("Reception status change reason is too long", slog.String("receptionID", receptionID),
+			slog.Int("length", utf8.RuneCountInString(reason)))
+		return nil, ErrInvalidReason
+	}
+
+	var (
+		receptionToReturn *domain.Reception
+		change            *domain.ReceptionStatusChange
+	)
 
-		lastProduct, err := p.productRepo.FindTheLastProduct(txCtx, pvzID)
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		reception, err := p.getAccessibleReception(txCtx, receptionID)
 		if err != nil {
-			p.logger.Error("Failed to find the last product", slog.String("pvzID", pvzID),
-				slog.String("error", err.Error()))
 			return err
 		}
 
-		if lastProduct == nil {
-			return ErrReceptionEmpty
-		}
-
-		err = p.productRepo.DeleteProduct(txCtx, lastProduct.ID)
+		change, err = p.applyReceptionTransition(txCtx, reception, action, reason)
 		if err != nil {
-			p.logger.Error("Failed to delete the last product", slog.String("pvzID", pvzID),
-				slog.String("error", err.Error()))
 			return err
 		}
 
+		receptionToReturn = reception
 		return nil
 	})
+	if err != nil {
+		p.logger.Error("Failed to change reception status", slog.String("receptionID", receptionID),
+			slog.String("action", action), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.publishReceptionEvent(receptionToReturn, change)
+
+	return receptionToReturn, nil
+}
 
+// getAccessibleReception находит приемку и проверяет, что пользователь может работать с ее ПВЗ.
+func (p *pvzService) getAccessibleReception(txCtx context.Context, receptionID string) (*domain.Reception, error) {
+	reception, err := p.receptionRepo.GetReception(txCtx, receptionID)
 	if err != nil {
-		p.logger.Error("Failed to Delete Last product", slog.String("error", err.Error()))
-		return err
+		p.logger.Error("Failed to get the reception", slog.String("receptionID", receptionID),
+			slog.String("error", err.Error()))
+		return nil, err
 	}
 
-	return nil
+	if reception == nil {
+		p.logger.Warn("Reception not found", slog.String("receptionID", receptionID))
+		return nil, ErrReceptionNotFound
+	}
+
+	if err := p.checkPVZAccess(txCtx, reception.PvzID); err != nil {
+		return nil, err
+	}
+
+	return reception, nil
 }
 
-func (p *pvzService) GetPVZSInfo(ctx context.Context, start time.Time, end time.Time, offset int, limit int) ([]domain.PvzInfo, error) {
-	var pvZsInfo []domain.PvzInfo
+// applyReceptionTransition проверяет переход по автомату статусов, меняет статус приемки и пишет
+// историю, аудит и событие. Вызывается внутри txManager.Do, reception получает новый статус.
+func (p *pvzService) applyReceptionTransition(txCtx context.Context, reception *domain.Reception,
+	action string, reason string) (*domain.ReceptionStatusChange, error) {
+	toStatus, ok := domain.NextReceptionStatus(reception.Status, action)
+	if !ok {
+		p.logger.Warn("Invalid reception status transition", slog.String("receptionID", reception.ID),
+			slog.String("status", reception.Status), slog.String("action", action))
+		return nil, ErrInvalidReceptionTransition
+	}
 
-	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
-		pvzs, err := p.pvzRepo.GetPVZS(txCtx, offset, limit)
+	// вернуть в работу завершенную приемку можно, только если в ПВЗ нет другой открытой
+	active := domain.ActiveReceptionStatuses()
+	if !slices.Contains(active, reception.Status) && slices.Contains(active, toStatus) {
+		open, err := p.receptionRepo.FindOpen(txCtx, reception.PvzID)
 		if err != nil {
-			p.logger.Error("Failed to get PVZS", slog.String("error", err.Error()))
-			return err
+			p.logger.Error("Failed to find the open reception", slog.String("pvzID", reception.PvzID),
+				slog.String("error", err.Error()))
+			return nil, err
 		}
-		for _, pvz := range pvzs {
-			receptions, err := p.receptionRepo.GetReceptionsFiltered(txCtx, pvz.ID, start, end)
-			if err != nil {
-				p.logger.Error("Failed to get filtered receptions", slog.String("pvzID", pvz.ID),
-					slog.String("error", err.Error()))
-				return err
-			}
-
-			var receptionsInfo []domain.ReceptionInfo
-			for _, reception := range receptions {
-				products, err := p.productRepo.GetProducts(txCtx, reception.ID)
-				if err != nil {
-					p.logger.Error("Failed to get products", slog.String("receptionID", reception.ID),
-						slog.String("error", err.Error()))
-					return err
-				}
-				receptionsInfo = append(receptionsInfo, domain.ReceptionInfo{
-					Reception: *reception,
-					Products:  products,
-				})
-			}
+		if open != nil {
+			p.logger.Warn("Reception already open", slog.String("pvzID", reception.PvzID),
+				slog.String("openReceptionID", open.ID))
+			return nil, ErrAlreadyOpen
+		}
+	}
 
-			pvZsInfo = append(pvZsInfo, domain.PvzInfo{
-				Pvz:        pvz,
-				Receptions: receptionsInfo,
-			})
+	updated, err := p.receptionRepo.UpdateReceptionStatus(txCtx, reception.ID, reception.Status, toStatus)
+	if err != nil {
+		if errors.Is(err, repository.ErrAlreadyExists) {
+			p.logger.Warn("Reception already open", slog.String("pvzID", reception.PvzID))
+			return nil, ErrAlreadyOpen
 		}
+		p.logger.Error("Failed to update the reception status", slog.String("receptionID", reception.ID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
 
-		return nil
+	// статус успели сменить параллельно, переход из него уже не проверен
+	if !updated {
+		p.logger.Warn("Reception status changed concurrently", slog.String("receptionID", reception.ID),
+			slog.String("action", action))
+		return nil, ErrInvalidReceptionTransition
+	}
+
+	change, err := p.addReceptionStatusChange(txCtx, domain.ReceptionStatusChange{
+		ReceptionID: reception.ID,
+		FromStatus:  reception.Status,
+		ToStatus:    toStatus,
+		Action:      action,
+		Reason:      reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	reception.Status = toStatus
+	eventType := receptionEvents[action]
+
+	err = p.addAuditEntry(txCtx, domain.AuditEntry{
+		Action:      eventType,
+		PvzID:       reception.PvzID,
+		ReceptionID: reception.ID,
 	})
 	if err != nil {
-		p.logger.Error("Failed to get PVZ info", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	return pvZsInfo, nil
+	err = p.addOutboxEvent(txCtx, eventType, eventPayload{
+		PvzID:           reception.PvzID,
+		ReceptionID:     reception.ID,
+		ReceptionStatus: toStatus,
+		Reason:          reason,
+		OccurredAt:      change.ChangedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return change, nil
 }
 
-func (p *pvzService) StartReception(ctx context.Context, pvzID string) (*domain.Reception, error) {
-	var receptionToReturn *domain.Reception
+// addReceptionStatusChange записывает переход в историю приемки от имени пользователя из контекста.
+func (p *pvzService) addReceptionStatusChange(txCtx context.Context, change domain.ReceptionStatusChange) (*domain.ReceptionStatusChange, error) {
+	if actor, ok := rbac.ActorFromContext(txCtx); ok {
+		change.UserID = actor.UserID
+		change.Role = actor.Role
+	}
+
+	added, err := p.receptionRepo.AddStatusChange(txCtx, change)
+	if err != nil {
+		p.logger.Error("Failed to add reception status change", slog.String("receptionID", change.ReceptionID),
+			slog.String("action", change.Action), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return added, nil
+}
+
+func (p *pvzService) publishReceptionEvent(reception *domain.Reception, change *domain.ReceptionStatusChange) {
+	p.broker.Publish(domain.PvzEvent{
+		Type:        receptionEvents[change.Action],
+		PvzID:       reception.PvzID,
+		ReceptionID: reception.ID,
+		OccurredAt:  change.ChangedAt,
+	})
+}
+
+// findReceptionInProgress возвращает открытую приемку ПВЗ, в которую можно добавлять и из которой
+// можно удалять товары: приостановленная приемка для этого не подходит.
+func (p *pvzService) findReceptionInProgress(txCtx context.Context, pvzID string) (*domain.Reception, error) {
+	reception, err := p.receptionRepo.FindOpen(txCtx, pvzID)
+	if err != nil {
+		p.logger.Error("Failed to find the open reception", slog.String("pvzID", pvzID),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if reception == nil {
+		p.logger.Warn("No open reception found", slog.String("pvzID", pvzID))
+		return nil, ErrAllReceptionsClosed
+	}
+
+	if reception.Status == domain.ReceptionPaused {
+		p.logger.Warn("Reception is paused", slog.String("pvzID", pvzID),
+			slog.String("receptionID", reception.ID))
+		return nil, ErrReceptionPaused
+	}
+
+	return reception, nil
+}
+
+// isReceptionID отсекает id, которые не могут быть id приемки, до запроса в БД.
+func isReceptionID(receptionID string) bool {
+	_, err := strconv.ParseUint(receptionID, 10, 64)
+	return err == nil
+}
+
+// isProductID отсекает id, которые не могут быть id товара: колонка product.id целочисленная,
+// и такой id сломал бы запрос к БД вместо ответа "не найден".
+func isProductID(productID string) bool {
+	_, err := strconv.ParseUint(productID, 10, 64)
+	return err == nil
+}
+
+func (p *pvzService) CreatePVZ(ctx context.Context, city string) (*domain.Pvz, error) {
+	if !slices.Contains(p.cities, city) {
+		p.logger.Warn("Invalid city for PVZ creation", slog.String("city", city))
+		return nil, ErrInvalidCity
+	}
+
+	var pvz *domain.Pvz
 
 	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
-		reception, err := p.receptionRepo.FindOpen(txCtx, pvzID)
+		var err error
+		pvz, err = p.pvzRepo.CreatePVZ(txCtx, city)
 		if err != nil {
-			p.logger.Error("Failed to find open reception", slog.String("pvzID", pvzID),
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				p.logger.Warn("PVZ already exists", slog.String("city", city))
+				return ErrAlreadyExists
+			}
+			p.logger.Error("Failed to create PVZ", slog.String("city", city),
 				slog.String("error", err.Error()))
 			return err
 		}
 
-		if reception != nil {
-			p.logger.Warn("Reception already open", slog.String("pvzID", pvzID))
-			return ErrAlreadyOpen
-		}
-
-		receptionToReturn, err = p.receptionRepo.CreateReception(txCtx, pvzID)
+		err = p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action: domain.AuditPvzCreated,
+			PvzID:  pvz.ID,
+		})
 		if err != nil {
-			p.logger.Error("Failed to create reception", slog.String("pvzID", pvzID),
-				slog.String("error", err.Error()))
 			return err
 		}
 
-		return nil
+		return p.addOutboxEvent(txCtx, domain.EventPvzCreated, eventPayload{
+			PvzID:      pvz.ID,
+			City:       pvz.City,
+			OccurredAt: pvz.RegistrationDate,
+		})
 	})
 	if err != nil {
-		p.logger.Error("Failed to Start Reception", slog.String("error", err.Error()))
+		p.logger.Error("Failed to CreatePVZ", slog.String("city", city),
+			slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	metrics.OrderReceptionsCreatedTotal.Inc()
+	metrics.PvzCreatedTotal.Inc()
 
-	return receptionToReturn, nil
+	return pvz, nil
+}
+
+// DeleteLastProduct удаляет последний добавленный товар открытой приемки (LIFO).
+func (p *pvzService) DeleteLastProduct(ctx context.Context, pvzID string) error {
+	return p.deleteProduct(ctx, pvzID, func(txCtx context.Context, reception *domain.Reception) (*domain.Product, error) {
+		lastProduct, err := p.productRepo.FindTheLastProduct(txCtx, reception.ID)
+		if err != nil {
+			p.logger.Error("Failed to find the last product", slog.String("pvzID", pvzID),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		if lastProduct == nil {
+			return nil, ErrReceptionEmpty
+		}
+
+		return lastProduct, nil
+	})
+}
+
+// DeleteProduct удаляет произвольный товар открытой приемки по id.
+func (p *pvzService) DeleteProduct(ctx context.Context, pvzID string, productID string) error {
+	if !isProductID(productID) {
+		p.logger.Warn("Invalid product id", slog.String("productID", productID))
+		return ErrProductNotFound
+	}
+
+	return p.deleteProduct(ctx, pvzID, func(txCtx context.Context, reception *domain.Reception) (*domain.Product, error) {
+		product, err := p.productRepo.GetProduct(txCtx, productID)
+		if err != nil {
+			p.logger.Error("Failed to get the product", slog.String("productID", productID),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		// товары закрытых приемок и уже удаленные товары для удаления не существуют
+		if product == nil || product.ReceptionID != reception.ID || product.DeletedAt != nil {
+			p.logger.Warn("Product not found in the open reception", slog.String("pvzID", pvzID),
+				slog.String("productID", productID))
+			return nil, ErrProductNotFound
+		}
+
+		return product, nil
+	})
+}
+
+// deleteProduct мягко удаляет товар открытой приемки, который выбирает pick, и пишет аудит и событие.
+func (p *pvzService) deleteProduct(ctx context.Context, pvzID string,
+	pick func(txCtx context.Context, reception *domain.Reception) (*domain.Product, error)) error {
+	var deletedProduct *domain.Product
+
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkPVZAccess(txCtx, pvzID); err != nil {
+			return err
+		}
+
+		reception, err := p.findReceptionInProgress(txCtx, pvzID)
+		if err != nil {
+			return err
+		}
+
+		product, err := pick(txCtx, reception)
+		if err != nil {
+			return err
+		}
+
+		deletedProduct, err = p.productRepo.DeleteProduct(txCtx, product.ID)
+		if err != nil {
+			p.logger.Error("Failed to delete the product", slog.String("pvzID", pvzID),
+				slog.String("productID", product.ID), slog.String("error", err.Error()))
+			return err
+		}
+
+		// товар успели удалить параллельно
+		if deletedProduct == nil {
+			return ErrProductNotFound
+		}
+
+		err = p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action:      domain.AuditProductDeleted,
+			PvzID:       pvzID,
+			ReceptionID: deletedProduct.ReceptionID,
+			ProductID:   deletedProduct.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return p.addOutboxEvent(txCtx, domain.EventProductDeleted, eventPayload{
+			PvzID:       pvzID,
+			ReceptionID: deletedProduct.ReceptionID,
+			ProductID:   deletedProduct.ID,
+			ProductType: deletedProduct.Type,
+			Barcode:     deletedProduct.Barcode,
+			OrderID:     deletedProduct.OrderID,
+			OccurredAt:  *deletedProduct.DeletedAt,
+		})
+	})
+
+	if err != nil {
+		p.logger.Error("Failed to Delete product", slog.String("error", err.Error()))
+		return err
+	}
+
+	p.broker.Publish(domain.PvzEvent{
+		Type:        domain.EventProductDeleted,
+		PvzID:       pvzID,
+		ReceptionID: deletedProduct.ReceptionID,
+		ProductID:   deletedProduct.ID,
+		OccurredAt:  *deletedProduct.DeletedAt,
+	})
+
+	return nil
+}
+
+// RestoreProduct отменяет удаление товара, пока его приемка открыта.
+func (p *pvzService) RestoreProduct(ctx context.Context, pvzID string, productID string) (*domain.Product, error) {
+	if !isProductID(productID) {
+		p.logger.Warn("Invalid product id", slog.String("productID", productID))
+		return nil, ErrProductNotFound
+	}
+
+	var (
+		restoredProduct *domain.Product
+		// колонки со временем восстановления нет, поэтому outbox и broker получают одно значение
+		restoredAt time.Time
+	)
+
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkPVZAccess(txCtx, pvzID); err != nil {
+			return err
+		}
+
+		reception, err := p.findReceptionInProgress(txCtx, pvzID)
+		if err != nil {
+			return err
+		}
+
+		product, err := p.productRepo.GetProduct(txCtx, productID)
+		if err != nil {
+			p.logger.Error("Failed to get the product", slog.String("productID", productID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if product == nil || product.ReceptionID != reception.ID {
+			p.logger.Warn("Product not found in the open reception", slog.String("pvzID", pvzID),
+				slog.String("productID", productID))
+			return ErrProductNotFound
+		}
+
+		if product.DeletedAt == nil {
+			return ErrProductNotDeleted
+		}
+
+		restoredProduct, err = p.productRepo.RestoreProduct(txCtx, productID)
+		if err != nil {
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				p.logger.Warn("Barcode of the restored product is already scanned", slog.String("pvzID", pvzID),
+					slog.String("productID", productID), slog.String("barcode", product.Barcode))
+				return ErrDuplicateBarcode
+			}
+			p.logger.Error("Failed to restore the product", slog.String("pvzID", pvzID),
+				slog.String("productID", productID), slog.String("error", err.Error()))
+			return err
+		}
+
+		// товар успели восстановить параллельно
+		if restoredProduct == nil {
+			return ErrProductNotDeleted
+		}
+		restoredAt = time.Now()
+
+		err = p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action:      domain.AuditProductRestored,
+			PvzID:       pvzID,
+			ReceptionID: restoredProduct.ReceptionID,
+			ProductID:   restoredProduct.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return p.addOutboxEvent(txCtx, domain.EventProductRestored, eventPayload{
+			PvzID:       pvzID,
+			ReceptionID: restoredProduct.ReceptionID,
+			ProductID:   restoredProduct.ID,
+			ProductType: restoredProduct.Type,
+			Barcode:     restoredProduct.Barcode,
+			OrderID:     restoredProduct.OrderID,
+			OccurredAt:  restoredAt,
+		})
+	})
+
+	if err != nil {
+		p.logger.Error("Failed to Restore product", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	p.broker.Publish(domain.PvzEvent{
+		Type:        domain.EventProductRestored,
+		PvzID:       pvzID,
+		ReceptionID: restoredProduct.ReceptionID,
+		ProductID:   restoredProduct.ID,
+		OccurredAt:  restoredAt,
+	})
+
+	return restoredProduct, nil
+}
+
+// FindProductsByBarcode ищет товар по штрихкоду во всех приемках ПВЗ, доступных пользователю.
+func (p *pvzService) FindProductsByBarcode(ctx context.Context, barcode string) ([]domain.Product, error) {
+	barcode = strings.TrimSpace(barcode)
+	if barcode == "" || utf8.RuneCountInString(barcode) > MaxBarcodeLength {
+		p.logger.Warn("Invalid barcode for lookup", slog.String("barcode", barcode))
+		return nil, ErrInvalidBarcode
+	}
+
+	pvzIDs, visible, err := p.restrictToAssigned(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []domain.Product{}, nil
+	}
+
+	products, err := p.productRepo.FindProductsByBarcode(ctx, barcode, pvzIDs)
+	if err != nil {
+		p.logger.Error("Failed to find products by barcode", slog.String("barcode", barcode),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (p *pvzService) GetPVZSInfo(ctx context.Context, query domain.PvzInfoQuery) (*domain.PvzInfoPage, error) {
+	if query.Limit < 1 || (query.Cursor == "" && query.Page < 1) {
+		p.logger.Warn("Invalid pagination", slog.Int("page", query.Page), slog.Int("limit", query.Limit))
+		return nil, ErrInvalidPagination
+	}
+
+	if err := p.validatePvzInfoQuery(query); err != nil {
+		return nil, err
+	}
+
+	// типы в product.type записаны нормализованными, поэтому фильтр приводим к тому же виду и сверяем
+	// со справочником так же, как при добавлении товара
+	productTypes := query.ProductTypes
+	if len(productTypes) > 0 {
+		var err error
+		productTypes, err = p.checkProductTypes(ctx, productTypes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	filter := domain.PvzFilter{
+		StartDate:         query.StartDate,
+		EndDate:           query.EndDate,
+		Cities:            query.Cities,
+		PvzIDs:            query.PvzIDs,
+		ReceptionStatuses: query.ReceptionStatuses,
+		ProductTypes:      productTypes,
+		Limit:             query.Limit + 1, // лишняя строка показывает, есть ли следующая страница
+	}
+
+	if query.Cursor != "" {
+		afterID, err := decodePvzCursor(query.Cursor)
+		if err != nil {
+			p.logger.Warn("Invalid cursor", slog.String("cursor", query.Cursor))
+			return nil, err
+		}
+		filter.AfterID = afterID
+		query.Page = 0
+	} else {
+		filter.Offset = (query.Page - 1) * query.Limit
+	}
+
+	pvZsInfo := []domain.PvzInfo{}
+	total := 0
+
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		pvzIDs, visible, err := p.restrictToAssigned(txCtx, filter.PvzIDs)
+		if err != nil {
+			return err
+		}
+		if !visible {
+			return nil
+		}
+		filter.PvzIDs = pvzIDs
+
+		total, err = p.pvzRepo.CountPVZS(txCtx, filter)
+		if err != nil {
+			p.logger.Error("Failed to count PVZS", slog.String("error", err.Error()))
+			return err
+		}
+
+		pvZsInfo, err = p.pvzRepo.GetPVZSInfo(txCtx, filter)
+		if err != nil {
+			p.logger.Error("Failed to get PVZS info", slog.String("error", err.Error()))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		p.logger.Error("Failed to get PVZ info", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	result := &domain.PvzInfoPage{
+		Items: pvZsInfo,
+		Total: total,
+		Page:  query.Page,
+		Limit: query.Limit,
+	}
+
+	if len(pvZsInfo) > query.Limit {
+		result.Items = pvZsInfo[:query.Limit]
+		result.NextCursor = encodePvzCursor(result.Items[query.Limit-1].Pvz.ID)
+		if query.Page > 0 {
+			result.NextPage = query.Page + 1
+		}
+	}
+
+	return result, nil
+}
+
+func (p *pvzService) StartReception(ctx context.Context, pvzID string) (*domain.Reception, error) {
+	var receptionToReturn *domain.Reception
+
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkPVZAccess(txCtx, pvzID); err != nil {
+			return err
+		}
+
+		reception, err := p.receptionRepo.FindOpen(txCtx, pvzID)
+		if err != nil {
+			p.logger.Error("Failed to find open reception", slog.String("pvzID", pvzID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if reception != nil {
+			p.logger.Warn("Reception already open", slog.String("pvzID", pvzID))
+			return ErrAlreadyOpen
+		}
+
+		receptionToReturn, err = p.receptionRepo.CreateReception(txCtx, pvzID)
+		if err != nil {
+			// параллельный запрос успел открыть приемку между FindOpen и вставкой, его отсекает уникальный индекс
+			if errors.Is(err, repository.ErrAlreadyExists) {
+				p.logger.Warn("Reception already open", slog.String("pvzID", pvzID))
+				return ErrAlreadyOpen
+			}
+			p.logger.Error("Failed to create reception", slog.String("pvzID", pvzID),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		_, err = p.addReceptionStatusChange(txCtx, domain.ReceptionStatusChange{
+			ReceptionID: receptionToReturn.ID,
+			ToStatus:    receptionToReturn.Status,
+			Action:      domain.ReceptionActionStart,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action:      domain.AuditReceptionStarted,
+			PvzID:       pvzID,
+			ReceptionID: receptionToReturn.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return p.addOutboxEvent(txCtx, domain.EventReceptionStarted, eventPayload{
+			PvzID:           pvzID,
+			ReceptionID:     receptionToReturn.ID,
+			ReceptionStatus: receptionToReturn.Status,
+			OccurredAt:      receptionToReturn.DateTime,
+		})
+	})
+	if err != nil {
+		p.logger.Error("Failed to Start Reception", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	metrics.OrderReceptionsCreatedTotal.Inc()
+
+	p.broker.Publish(domain.PvzEvent{
+		Type:        domain.EventReceptionStarted,
+		PvzID:       pvzID,
+		ReceptionID: receptionToReturn.ID,
+		OccurredAt:  receptionToReturn.DateTime,
+	})
+
+	return receptionToReturn, nil
+}
+
+// WatchPVZ для сотрудника без права pvz:any подписывает только на назначенные ПВЗ.
+// Пустой pvzIDs в этом случае означает все назначенные ПВЗ, а не все вообще.
+func (p *pvzService) WatchPVZ(ctx context.Context, pvzIDs []string, fromSequence uint64) (<-chan domain.PvzEvent, error) {
+	if actor, scoped := p.scopedActor(ctx); scoped {
+		assigned, err := p.assignRepo.GetAssignedPVZIDs(ctx, actor.UserID)
+		if err != nil {
+			p.logger.Error("Failed to get assigned PVZ", slog.String("userID", actor.UserID),
+				slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		if len(pvzIDs) == 0 {
+			pvzIDs = assigned
+		}
+		if len(pvzIDs) == 0 {
+			p.logger.Warn("User has no assigned PVZ to watch", slog.String("userID", actor.UserID))
+			return nil, ErrPVZAccessDenied
+		}
+		for _, pvzID := range pvzIDs {
+			if !slices.Contains(assigned, pvzID) {
+				p.logger.Warn("User is not assigned to watched PVZ", slog.String("userID", actor.UserID),
+					slog.String("pvzID", pvzID))
+				return nil, ErrPVZAccessDenied
+			}
+		}
+	}
+
+	eventsCh, err := p.broker.Subscribe(ctx, pvzIDs, fromSequence)
+	if err != nil {
+		if errors.Is(err, events.ErrSequenceExpired) {
+			p.logger.Warn("Requested sequence is no longer available",
+				slog.Uint64("fromSequence", fromSequence))
+			return nil, ErrSequenceExpired
+		}
+		p.logger.Error("Failed to subscribe to PVZ events", slog.String("error", err.Error()))
+		return nil, err
+	}
+	return eventsCh, nil
+}
+
+// AssignEmployee назначает пользователя на ПВЗ. Повторное назначение ничего не меняет.
+func (p *pvzService) AssignEmployee(ctx context.Context, pvzID string, userID string) error {
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		if err := p.checkAssignment(txCtx, pvzID, userID); err != nil {
+			return err
+		}
+
+		if err := p.assignRepo.AssignUser(txCtx, userID, pvzID); err != nil {
+			return err
+		}
+
+		return p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action:       domain.AuditEmployeeAssigned,
+			PvzID:        pvzID,
+			TargetUserID: userID,
+		})
+	})
+	if err != nil {
+		p.logger.Error("Failed to assign employee", slog.String("pvzID", pvzID),
+			slog.String("userID", userID), slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Employee assigned", slog.String("pvzID", pvzID), slog.String("userID", userID))
+	return nil
+}
+
+func (p *pvzService) UnassignEmployee(ctx context.Context, pvzID string, userID string) error {
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		removed, err := p.assignRepo.UnassignUser(txCtx, userID, pvzID)
+		if err != nil {
+			return err
+		}
+
+		if !removed {
+			p.logger.Warn("Employee is not assigned to PVZ", slog.String("pvzID", pvzID),
+				slog.String("userID", userID))
+			return ErrNotFound
+		}
+
+		return p.addAuditEntry(txCtx, domain.AuditEntry{
+			Action:       domain.AuditEmployeeUnassigned,
+			PvzID:        pvzID,
+			TargetUserID: userID,
+		})
+	})
+	if err != nil {
+		p.logger.Error("Failed to unassign employee", slog.String("pvzID", pvzID),
+			slog.String("userID", userID), slog.String("error", err.Error()))
+		return err
+	}
+
+	p.logger.Info("Employee unassigned", slog.String("pvzID", pvzID), slog.String("userID", userID))
+	return nil
+}
+
+// GetAuditLog отдает журнал действий от новых записей к старым. Сотрудник без права pvz:any
+// видит только записи по назначенным ему ПВЗ.
+func (p *pvzService) GetAuditLog(ctx context.Context, query domain.AuditQuery) (*domain.AuditPage, error) {
+	if query.Limit < 1 || query.Limit > MaxPageLimit {
+		p.logger.Warn("Invalid pagination", slog.Int("limit", query.Limit))
+		return nil, ErrInvalidPagination
+	}
+
+	if query.From != nil && query.To != nil && query.From.After(*query.To) {
+		p.logger.Warn("Invalid audit time range", slog.Time("from", *query.From), slog.Time("to", *query.To))
+		return nil, ErrInvalidFilter
+	}
+
+	filter := domain.AuditFilter{
+		UserID: query.UserID,
+		From:   query.From,
+		To:     query.To,
+		Limit:  query.Limit + 1, // лишняя строка показывает, есть ли следующая страница
+	}
+
+	if query.Cursor != "" {
+		beforeID, err := decodeAuditCursor(query.Cursor)
+		if err != nil {
+			p.logger.Warn("Invalid cursor", slog.String("cursor", query.Cursor))
+			return nil, err
+		}
+		filter.BeforeID = beforeID
+	}
+
+	pvzIDs, visible, err := p.restrictToAssigned(ctx, query.PvzIDs)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return &domain.AuditPage{Items: []domain.AuditEntry{}}, nil
+	}
+	filter.PvzIDs = pvzIDs
+
+	entries, err := p.auditRepo.GetEntries(ctx, filter)
+	if err != nil {
+		p.logger.Error("Failed to get audit log", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	page := &domain.AuditPage{
+		Items: entries,
+	}
+	if len(entries) > query.Limit {
+		page.Items = entries[:query.Limit]
+		page.NextCursor = encodeAuditCursor(page.Items[query.Limit-1].ID)
+	}
+
+	return page, nil
+}
+
+func (p *pvzService) ListProductTypes(ctx context.Context) ([]domain.ProductType, error) {
+	productTypes, err := p.typeRepo.ListProductTypes(ctx)
+	if err != nil {
+		p.logger.Error("Failed to list product types", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	return productTypes, nil
+}
+
+func (p *pvzService) CreateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	productType.Name = normalizeProductType(productType.Name)
+	if err := p.validateProductType(productType); err != nil {
+		p.logger.Warn("Invalid product type", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	var created *domain.ProductType
+
+	err := p.txManager.Do(ctx, func(txCtx context.Context) error {
+		existing, err := p.typeRepo.GetProductType(txCtx, productType.Name)
+		if err != nil {
+			p.logger.Error("Failed to get the product type", slog.String("name", productType.Name),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		if existing != nil {
+			p.logger.Warn("Product type already exists", slog.String("name", productType.Name))
+			return ErrAlreadyExists
+		}
+
+		created, err = p.typeRepo.CreateProductType(txCtx, productType)
+		if err != nil {
+			p.logger.Error("Failed to create the product type", slog.String("name", productType.Name),
+				slog.String("error", err.Error()))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// UpdateProductType меняет атрибуты типа. Название не меняется: по нему записаны уже принятые товары.
+func (p *pvzService) UpdateProductType(ctx context.Context, productType domain.ProductType) (*domain.ProductType, error) {
+	productType.Name = normalizeProductType(productType.Name)
+	if err := p.validateProductType(productType); err != nil {
+		p.logger.Warn("Invalid product type", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	updated, err := p.typeRepo.UpdateProductType(ctx, productType)
+	if err != nil {
+		p.logger.Error("Failed to update the product type", slog.String("name", productType.Name),
+			slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if updated == nil {
+		p.logger.Warn("Product type not found", slog.String("name", productType.Name))
+		return nil, ErrProductTypeNotFound
+	}
+
+	return updated, nil
+}
+
+// DeleteProductType убирает тип из справочника. Уже принятые товары этого типа не меняются,
+// новые с этим типом добавить нельзя.
+func (p *pvzService) DeleteProductType(ctx context.Context, name string) error {
+	name = normalizeProductType(name)
+
+	deleted, err := p.typeRepo.DeleteProductType(ctx, name)
+	if err != nil {
+		p.logger.Error("Failed to delete the product type", slog.String("name", name),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if !deleted {
+		p.logger.Warn("Product type not found", slog.String("name", name))
+		return ErrProductTypeNotFound
+	}
+
+	return nil
+}
+
+// checkProductItems приводит товары к каноническому виду и проверяет их до начала транзакции:
+// типы должны быть в справочнике, штрихкод не может повторяться внутри одного запроса.
+func (p *pvzService) checkProductItems(ctx context.Context, items []domain.ProductItem) ([]domain.ProductItem, error) {
+	productTypes := make([]string, 0, len(items))
+	for _, item := range items {
+		productTypes = append(productTypes, item.Type)
+	}
+
+	productTypes, err := p.checkProductTypes(ctx, productTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := make([]domain.ProductItem, 0, len(items))
+	barcodes := make(map[string]struct{}, len(items))
+
+	for i, item := range items {
+		item = domain.ProductItem{
+			Type:    productTypes[i],
+			Barcode: strings.TrimSpace(item.Barcode),
+			OrderID: strings.TrimSpace(item.OrderID),
+		}
+
+		if utf8.RuneCountInString(item.Barcode) > MaxBarcodeLength {
+			p.logger.Warn("Barcode is too long", slog.String("barcode", item.Barcode))
+			return nil, ErrInvalidBarcode
+		}
+		if utf8.RuneCountInString(item.OrderID) > MaxOrderIDLength {
+			p.logger.Warn("Order id is too long", slog.String("orderID", item.OrderID))
+			return nil, ErrInvalidOrderID
+		}
+
+		if item.Barcode != "" {
+			if _, ok := barcodes[item.Barcode]; ok {
+				p.logger.Warn("Barcode is repeated in the request", slog.String("barcode", item.Barcode))
+				return nil, ErrDuplicateBarcode
+			}
+			barcodes[item.Barcode] = struct{}{}
+		}
+
+		normalized = append(normalized, item)
+	}
+
+	return normalized, nil
+}
+
+// checkProductTypes приводит типы к виду справочника и проверяет, что все они в нем есть.
+// Возвращает приведенные типы в исходном порядке.
+func (p *pvzService) checkProductTypes(ctx context.Context, productTypes []string) ([]string, error) {
+	normalized := make([]string, 0, len(productTypes))
+	names := make([]string, 0, len(productTypes))
+	missing := make(map[string]struct{}, len(productTypes))
+
+	for _, productType := range productTypes {
+		name := normalizeProductType(productType)
+		normalized = append(normalized, name)
+		if _, ok := missing[name]; !ok {
+			missing[name] = struct{}{}
+			names = append(names, name)
+		}
+	}
+
+	known, err := p.typeRepo.GetProductTypes(ctx, names)
+	if err != nil {
+		p.logger.Error("Failed to get product types", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	for _, productType := range known {
+		delete(missing, productType.Name)
+	}
+
+	for _, name := range names {
+		if _, ok := missing[name]; ok {
+			p.logger.Warn("Unknown product type", slog.String("productType", name))
+			return nil, &InvalidProductTypeError{Type: name, Reason: "not in the product type catalog"}
+		}
+	}
+
+	return normalized, nil
+}
+
+func (p *pvzService) validateProductType(productType domain.ProductType) error {
+	if productType.Name == "" {
+		return &InvalidProductTypeError{Type: productType.Name, Reason: "name is empty"}
+	}
+	if utf8.RuneCountInString(productType.Name) > MaxProductTypeLength {
+		return &InvalidProductTypeError{
+			Type:   productType.Name,
+			Reason: fmt.Sprintf("name is longer than %d characters", MaxProductTypeLength),
+		}
+	}
+	if productType.MaxWeightGrams < 0 {
+		return &InvalidProductTypeError{Type: productType.Name, Reason: "max weight must not be negative"}
+	}
+	return nil
+}
+
+// normalizeProductType убирает пробелы по краям и приводит тип к нижнему регистру,
+// чтобы "Электроника" и "электроника " считались одним типом.
+func normalizeProductType(productType string) string {
+	return strings.ToLower(strings.TrimSpace(productType))
+}
+
+func (p *pvzService) checkAssignment(txCtx context.Context, pvzID string, userID string) error {
+	pvz, err := p.pvzRepo.GetPVZ(txCtx, pvzID)
+	if err != nil {
+		return err
+	}
+	if pvz == nil {
+		p.logger.Warn("PVZ not found for assignment", slog.String("pvzID", pvzID))
+		return ErrNoPVZFound
+	}
+
+	user, err := p.userRepo.GetUserByID(txCtx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		p.logger.Warn("User not found for assignment", slog.String("userID", userID))
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
+// scopedActor возвращает пользователя из контекста, если он ограничен назначенными ПВЗ.
+// Вызовы без пользователя в контексте - внутренние, их не ограничиваем: транспортный слой
+// кладет пользователя всегда, когда запрос пришел с токеном. Dummy токены не привязаны к
+// учетной записи, назначений у них быть не может, поэтому их тоже не ограничиваем.
+func (p *pvzService) scopedActor(ctx context.Context) (rbac.Actor, bool) {
+	actor, ok := rbac.ActorFromContext(ctx)
+	if !ok || actor.Dummy || p.policy.Allowed(actor.Role, rbac.PVZAny) {
+		return rbac.Actor{}, false
+	}
+	return actor, true
+}
+
+func (p *pvzService) checkPVZAccess(txCtx context.Context, pvzID string) error {
+	actor, scoped := p.scopedActor(txCtx)
+	if !scoped {
+		return nil
+	}
+
+	assigned, err := p.assignRepo.IsAssigned(txCtx, actor.UserID, pvzID)
+	if err != nil {
+		p.logger.Error("Failed to check PVZ assignment", slog.String("userID", actor.UserID),
+			slog.String("pvzID", pvzID), slog.String("error", err.Error()))
+		return err
+	}
+	if !assigned {
+		p.logger.Warn("User is not assigned to PVZ", slog.String("userID", actor.UserID),
+			slog.String("pvzID", pvzID))
+		return ErrPVZAccessDenied
+	}
+
+	return nil
+}
+
+// restrictToAssigned сужает фильтр по id ПВЗ до назначенных пользователю. visible=false значит,
+// что пересечение пустое и запрос в БД можно не делать: пустой PvzIDs в фильтре снял бы ограничение.
+func (p *pvzService) restrictToAssigned(ctx context.Context, requested []string) (pvzIDs []string, visible bool, err error) {
+	actor, scoped := p.scopedActor(ctx)
+	if !scoped {
+		return requested, true, nil
+	}
+
+	assigned, err := p.assignRepo.GetAssignedPVZIDs(ctx, actor.UserID)
+	if err != nil {
+		p.logger.Error("Failed to get assigned PVZ", slog.String("userID", actor.UserID),
+			slog.String("error", err.Error()))
+		return nil, false, err
+	}
+
+	if len(requested) == 0 {
+		return assigned, len(assigned) > 0, nil
+	}
+
+	for _, pvzID := range requested {
+		if slices.Contains(assigned, pvzID) {
+			pvzIDs = append(pvzIDs, pvzID)
+		}
+	}
+	return pvzIDs, len(pvzIDs) > 0, nil
+}
+
+// eventPayload - тело события в outbox, которое получают внешние системы.
+type eventPayload struct {
+	PvzID       string    `json:"pvzId"`
+	City        string    `json:"city,omitempty"`
+	ReceptionID string    `json:"receptionId,omitempty"`
+	ProductID   string    `json:"productId,omitempty"`
+	ProductType string    `json:"productType,omitempty"`
+	Barcode     string    `json:"barcode,omitempty"`
+	OrderID     string    `json:"orderId,omitempty"`
+	OccurredAt  time.Time `json:"occurredAt"`
+
+	// ReceptionStatus - статус приемки после перехода, Reason - причина отмены или возврата в работу.
+	ReceptionStatus string `json:"receptionStatus,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+}
+
+// addAuditEntry записывает в журнал действие пользователя из контекста. Как и addOutboxEvent,
+// вызывается внутри txManager.Do: запись появляется только вместе с самим изменением.
+func (p *pvzService) addAuditEntry(txCtx context.Context, entry domain.AuditEntry) error {
+	if actor, ok := rbac.ActorFromContext(txCtx); ok {
+		entry.UserID = actor.UserID
+		entry.Role = actor.Role
+		entry.Dummy = actor.Dummy
+	}
+
+	if err := p.auditRepo.AddEntry(txCtx, entry); err != nil {
+		p.logger.Error("Failed to add audit entry", slog.String("action", entry.Action),
+			slog.String("pvzID", entry.PvzID), slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// addOutboxEvent должен вызываться внутри txManager.Do, чтобы событие
+// записалось в той же транзакции, что и изменение.
+func (p *pvzService) addOutboxEvent(txCtx context.Context, eventType string, payload eventPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		p.logger.Error("Failed to marshal outbox event", slog.String("eventType", eventType),
+			slog.String("error", err.Error()))
+		return err
+	}
+
+	if err := p.outboxRepo.AddEvent(txCtx, eventType, payload.PvzID, data); err != nil {
+		p.logger.Error("Failed to add outbox event", slog.String("eventType", eventType),
+			slog.String("pvzID", payload.PvzID), slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
+
+// receptionEvents - событие каждого перехода приемки. Действие в журнале аудита совпадает с типом события.
+var receptionEvents = map[string]string{
+	domain.ReceptionActionStart:  domain.EventReceptionStarted,
+	domain.ReceptionActionPause:  domain.EventReceptionPaused,
+	domain.ReceptionActionResume: domain.EventReceptionResumed,
+	domain.ReceptionActionClose:  domain.EventReceptionClosed,
+	domain.ReceptionActionCancel: domain.EventReceptionCancelled,
+	domain.ReceptionActionReopen: domain.EventReceptionReopened,
+}
+
+// validatePvzInfoQuery отсекает фильтры, которые заведомо ничего не найдут или сломают запрос к БД.
+func (p *pvzService) validatePvzInfoQuery(query domain.PvzInfoQuery) error {
+	if query.StartDate != nil && query.EndDate != nil && query.StartDate.After(*query.EndDate) {
+		p.logger.Warn("Invalid reception date range in PVZ filter", slog.Time("startDate", *query.StartDate),
+			slog.Time("endDate", *query.EndDate))
+		return ErrInvalidFilter
+	}
+
+	for _, city := range query.Cities {
+		if !slices.Contains(p.cities, city) {
+			p.logger.Warn("Invalid city in PVZ filter", slog.String("city", city))
+			return ErrInvalidCity
+		}
+	}
+
+	for _, id := range query.PvzIDs {
+		if _, err := strconv.ParseUint(id, 10, 64); err != nil {
+			p.logger.Warn("Invalid PVZ id in PVZ filter", slog.String("pvzID", id))
+			return ErrInvalidFilter
+		}
+	}
+
+	for _, status := range query.ReceptionStatuses {
+		if !slices.Contains(domain.ReceptionStatuses(), status) {
+			p.logger.Warn("Invalid reception status in PVZ filter", slog.String("status", status))
+			return ErrInvalidFilter
+		}
+	}
+
+	return nil
 }
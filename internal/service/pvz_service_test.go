@@ -4,12 +4,17 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log/slog"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/Ranik23/avito-tech-spring/internal/events"
+	eventsmock "github.com/Ranik23/avito-tech-spring/internal/events/mock"
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/repository"
 	repomock "github.com/Ranik23/avito-tech-spring/internal/repository/mock"
 	"github.com/stretchr/testify/assert"
@@ -17,6 +22,28 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+// anyAuditRepo принимает любые записи журнала: аудит проверяется в отдельных тестах.
+func anyAuditRepo(ctrl *gomock.Controller) *repomock.MockAuditRepository {
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().AddEntry(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return mockAuditRepo
+}
+
+// anyProductTypeRepo считает любой тип товара известным: справочник проверяется в отдельных тестах.
+func anyProductTypeRepo(ctrl *gomock.Controller) *repomock.MockProductTypeRepository {
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTypeRepo.EXPECT().GetProductTypes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, names []string) ([]domain.ProductType, error) {
+			productTypes := make([]domain.ProductType, 0, len(names))
+			for _, name := range names {
+				productTypes = append(productTypes, domain.ProductType{Name: name})
+			}
+			return productTypes, nil
+		},
+	).AnyTimes()
+	return mockTypeRepo
+}
+
 func TestCreatePVZ_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -25,6 +52,8 @@ func TestCreatePVZ_Success(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	ctx := context.Background()
@@ -41,7 +70,7 @@ func TestCreatePVZ_Success(t *testing.T) {
 		},
 	).Times(1)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	pvz, err := pvzService.CreatePVZ(ctx, city)
 
@@ -58,6 +87,8 @@ func TestPVZService_StartReception_CreateReceptionFail(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	exampleCtx := context.Background()
 	examplePvzID := "pvz456"
@@ -69,7 +100,7 @@ func TestPVZService_StartReception_CreateReceptionFail(t *testing.T) {
 		return fn(ctx)
 	})
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, []string{"Moscow"}, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, []string{"Moscow"}, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	_, err := pvzService.StartReception(exampleCtx, examplePvzID)
 	assert.Error(t, err)
@@ -83,10 +114,12 @@ func TestCreatePVZ_UnknownCity(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 
 	cities := []string{"Moscow"}
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	_, err := pvzService.CreatePVZ(context.Background(), "London")
 
@@ -101,6 +134,8 @@ func TestCreatePVZ_AlreadyExists(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	ctx := context.Background()
@@ -113,7 +148,7 @@ func TestCreatePVZ_AlreadyExists(t *testing.T) {
 		},
 	).Times(1)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	_, err := pvzService.CreatePVZ(ctx, city)
 
@@ -128,6 +163,8 @@ func TestPVZService_CloseReceptionFail(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	ctx := context.Background()
@@ -141,7 +178,7 @@ func TestPVZService_CloseReceptionFail(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	_, err := pvzService.CloseReception(ctx, pvzID)
 	assert.Equal(t, err, ErrAllReceptionsClosed)
@@ -155,18 +192,26 @@ func TestPVZService_CloseReceptionSuccess(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	ctx := context.Background()
 	examplepvzID := "pvz456"
 	exampleReception := &domain.Reception{
 		ID: "1",
-		Status: "open",
+		Status: domain.ReceptionInProgress,
 		PvzID: examplepvzID,
 	}
 
 	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), examplepvzID).Return(exampleReception, nil)
-	mockReceptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), exampleReception.ID, "closed").Return(nil)
+	mockReceptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), exampleReception.ID, domain.ReceptionInProgress, domain.ReceptionClosed).Return(true, nil)
+	mockReceptionRepo.EXPECT().AddStatusChange(gomock.Any(), domain.ReceptionStatusChange{
+		ReceptionID: "1",
+		FromStatus:  domain.ReceptionInProgress,
+		ToStatus:    domain.ReceptionClosed,
+		Action:      domain.ReceptionActionClose,
+	}).Return(&domain.ReceptionStatusChange{Action: domain.ReceptionActionClose}, nil)
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
@@ -174,13 +219,13 @@ func TestPVZService_CloseReceptionSuccess(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	reception, err := pvzService.CloseReception(ctx, examplepvzID)
 	require.NoError(t, err)
 	require.Equal(t, reception.ID, "1")
 	require.Equal(t, reception.PvzID, examplepvzID)
-	require.Equal(t, reception.Status, "open")
+	require.Equal(t, reception.Status, domain.ReceptionClosed)
 }
 
 func TestPVZService_DeleteLastProductFail(t *testing.T) {
@@ -191,15 +236,17 @@ func TestPVZService_DeleteLastProductFail(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
 	examplePvzID := "pvz456"
 
-	exampleReception := domain.Reception{}
+	exampleReception := domain.Reception{ID: "r1"}
 
 	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), examplePvzID).Return(&exampleReception, nil)
-	mockProductRepo.EXPECT().FindTheLastProduct(gomock.Any(), examplePvzID).Return(nil, nil)
+	mockProductRepo.EXPECT().FindTheLastProduct(gomock.Any(), exampleReception.ID).Return(nil, nil)
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
@@ -207,7 +254,7 @@ func TestPVZService_DeleteLastProductFail(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	err := pvzService.DeleteLastProduct(exampleCtx, examplePvzID)
 	assert.Equal(t, err, ErrReceptionEmpty)
@@ -221,6 +268,8 @@ func TestPVZService_DeleteLastProductSucces(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -228,12 +277,13 @@ func TestPVZService_DeleteLastProductSucces(t *testing.T) {
 	exampleProduct := &domain.Product{
 		ID: "123",
 	}
+	deletedAt := time.Now()
 
-	exampleReception := domain.Reception{}
+	exampleReception := domain.Reception{ID: "r1"}
 
 	mockReceptionRepo.EXPECT().FindOpen(exampleCtx, examplePvzID).Return(&exampleReception, nil)
-	mockProductRepo.EXPECT().FindTheLastProduct(exampleCtx, examplePvzID).Return(exampleProduct, nil)
-	mockProductRepo.EXPECT().DeleteProduct(exampleCtx, exampleProduct.ID).Return(nil)
+	mockProductRepo.EXPECT().FindTheLastProduct(exampleCtx, exampleReception.ID).Return(exampleProduct, nil)
+	mockProductRepo.EXPECT().DeleteProduct(exampleCtx, exampleProduct.ID).Return(&domain.Product{ID: "123", DeletedAt: &deletedAt}, nil)
 
 	mockTxManager.EXPECT().Do(exampleCtx, gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
@@ -241,7 +291,7 @@ func TestPVZService_DeleteLastProductSucces(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	err := pvzService.DeleteLastProduct(exampleCtx, examplePvzID)
 	assert.NoError(t, err)
@@ -255,6 +305,8 @@ func TestPVZService_DeleteLastProductNoOpen(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -268,7 +320,7 @@ func TestPVZService_DeleteLastProductNoOpen(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	err := pvzService.DeleteLastProduct(exampleCtx, examplePvzID)
 	assert.Equal(t, err, ErrAllReceptionsClosed)
@@ -282,6 +334,8 @@ func TestPVZService_StartReception_Success(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -292,6 +346,7 @@ func TestPVZService_StartReception_Success(t *testing.T) {
 
 	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), examplePvzID).Return(nil, nil)
 	mockReceptionRepo.EXPECT().CreateReception(gomock.Any(), examplePvzID).Return(exampleReception, nil)
+	mockReceptionRepo.EXPECT().AddStatusChange(gomock.Any(), gomock.Any()).Return(&domain.ReceptionStatusChange{}, nil)
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
@@ -299,7 +354,7 @@ func TestPVZService_StartReception_Success(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	reception, err := pvzService.StartReception(exampleCtx, examplePvzID)
 	assert.NoError(t, err)
@@ -314,6 +369,8 @@ func TestPVZService_StartReception_AlreadyOpen(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -330,12 +387,33 @@ func TestPVZService_StartReception_AlreadyOpen(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
 	_, err := pvzService.StartReception(exampleCtx, examplePvzID)
 	assert.Equal(t, err, ErrAlreadyOpen)
 }
 
+func TestPVZService_StartReception_ConcurrentlyOpened(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	// FindOpen не видит приемку, открытую параллельным запросом, вставку отклоняет уникальный индекс
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+	mockReceptionRepo.EXPECT().CreateReception(gomock.Any(), "pvz1").Return(nil, repository.ErrAlreadyExists)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, nil, nil, nil, nil, mockTxManager,
+		events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
+
+	_, err := pvzService.StartReception(context.Background(), "pvz1")
+	assert.ErrorIs(t, err, ErrAlreadyOpen)
+}
+
 func TestPVZService_AddProduct_Success(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -344,6 +422,8 @@ func TestPVZService_AddProduct_Success(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -355,7 +435,7 @@ func TestPVZService_AddProduct_Success(t *testing.T) {
 	}
 
 	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), examplePvzID).Return(exampleReception, nil)
-	mockProductRepo.EXPECT().CreateProduct(gomock.Any(), exampleProductType, exampleReception.ID).Return(expectedProduct, nil)
+	mockProductRepo.EXPECT().CreateProduct(gomock.Any(), domain.ProductItem{Type: exampleProductType}, exampleReception.ID).Return(expectedProduct, nil)
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
@@ -363,9 +443,9 @@ func TestPVZService_AddProduct_Success(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
-	product, err := pvzService.AddProduct(exampleCtx, examplePvzID, exampleProductType)
+	product, err := pvzService.AddProduct(exampleCtx, examplePvzID, domain.ProductItem{Type: exampleProductType})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedProduct.ID, product.ID)
 }
@@ -378,6 +458,8 @@ func TestPVZService_AddProduct_AllReceptionsClosed(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -392,9 +474,9 @@ func TestPVZService_AddProduct_AllReceptionsClosed(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
-	productID, err := pvzService.AddProduct(exampleCtx, examplePvzID, exampleProductType)
+	productID, err := pvzService.AddProduct(exampleCtx, examplePvzID, domain.ProductItem{Type: exampleProductType})
 	assert.Error(t, err)
 	assert.Equal(t, err, ErrAllReceptionsClosed)
 	assert.Empty(t, productID)
@@ -408,6 +490,8 @@ func TestPVZService_AddProduct_AllReceptionsFail(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	exampleCtx := context.Background()
@@ -416,7 +500,7 @@ func TestPVZService_AddProduct_AllReceptionsFail(t *testing.T) {
 	exampleReception := &domain.Reception{ID: "reception123"}
 
 	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), examplePvzID).Return(exampleReception, nil).Times(1)
-	mockProductRepo.EXPECT().CreateProduct(gomock.Any(), exampleProductType, exampleReception.ID).Return(nil, errors.New("fail"))
+	mockProductRepo.EXPECT().CreateProduct(gomock.Any(), domain.ProductItem{Type: exampleProductType}, exampleReception.ID).Return(nil, errors.New("fail"))
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.AssignableToTypeOf(fn)).DoAndReturn(
 		func(ctx context.Context, fn func(context.Context) error) error {
@@ -424,9 +508,9 @@ func TestPVZService_AddProduct_AllReceptionsFail(t *testing.T) {
 		},
 	)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
-	_, err := pvzService.AddProduct(exampleCtx, examplePvzID, exampleProductType)
+	_, err := pvzService.AddProduct(exampleCtx, examplePvzID, domain.ProductItem{Type: exampleProductType})
 	assert.Error(t, err)
 }
 
@@ -438,38 +522,45 @@ func TestPVZService_GetPVZSInfo_Success(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
 	cities := []string{"Moscow"}
 
 	ctx := context.Background()
 	start := time.Now().Add(-24 * time.Hour)
 	end := time.Now()
-	offset := 0
+	page := 1
 	limit := 10
 
-	examplePvz := domain.Pvz{ID: "pvz1"}
-	exampleReception := &domain.Reception{ID: "reception1"}
-	exampleProduct := domain.Product{ID: "product1"}
-
-	mockPVZRepo.EXPECT().GetPVZS(gomock.Any(), offset, limit).Return([]domain.Pvz{examplePvz}, nil)
-
-	mockReceptionRepo.EXPECT().GetReceptionsFiltered(gomock.Any(), examplePvz.ID, start, end).Return([]*domain.Reception{exampleReception}, nil)
+	exampleInfo := domain.PvzInfo{
+		Pvz: domain.Pvz{ID: "pvz1"},
+		Receptions: []domain.ReceptionInfo{{
+			Reception: domain.Reception{ID: "reception1"},
+			Products:  []domain.Product{{ID: "product1"}},
+		}},
+	}
 
-	mockProductRepo.EXPECT().GetProducts(gomock.Any(), exampleReception.ID).Return([]domain.Product{exampleProduct}, nil)
+	filter := domain.PvzFilter{StartDate: &start, EndDate: &end, Limit: limit + 1}
+	mockPVZRepo.EXPECT().CountPVZS(gomock.Any(), filter).Return(1, nil)
+	mockPVZRepo.EXPECT().GetPVZSInfo(gomock.Any(), filter).Return([]domain.PvzInfo{exampleInfo}, nil)
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
 		return fn(ctx)
 	})
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
-	pvzInfos, err := pvzService.GetPVZSInfo(ctx, start, end, offset, limit)
+	result, err := pvzService.GetPVZSInfo(ctx, domain.PvzInfoQuery{StartDate: &start, EndDate: &end, Page: page, Limit: limit})
 	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Zero(t, result.NextPage)
+	assert.Empty(t, result.NextCursor)
+	pvzInfos := result.Items
 	assert.Len(t, pvzInfos, 1)
-	assert.Equal(t, examplePvz.ID, pvzInfos[0].Pvz.ID)
+	assert.Equal(t, "pvz1", pvzInfos[0].Pvz.ID)
 	assert.Len(t, pvzInfos[0].Receptions, 1)
-	assert.Equal(t, exampleReception.ID, pvzInfos[0].Receptions[0].Reception.ID)
+	assert.Equal(t, "reception1", pvzInfos[0].Receptions[0].Reception.ID)
 	assert.Len(t, pvzInfos[0].Receptions[0].Products, 1)
-	assert.Equal(t, exampleProduct.ID, pvzInfos[0].Receptions[0].Products[0].ID)
+	assert.Equal(t, "product1", pvzInfos[0].Receptions[0].Products[0].ID)
 }
 
 func TestPVZService_GetPVZSInfo_PartialByOffsetLimit(t *testing.T) {
@@ -477,73 +568,187 @@ func TestPVZService_GetPVZSInfo_PartialByOffsetLimit(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
-	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
-	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
-	cities := []string{"Moscow"}
 
 	ctx := context.Background()
 	start := time.Now().Add(-48 * time.Hour)
 	end := time.Now()
-	offset := 1
+	page := 2
 	limit := 1
 
-	allPvzs := []domain.Pvz{
-		{ID: "pvz1"},
-		{ID: "pvz2"},
-		{ID: "pvz3"},
-	}
+	// вторая страница по одному элементу начинается со смещения 1
+	filter := domain.PvzFilter{StartDate: &start, EndDate: &end, Offset: 1, Limit: limit + 1}
+	mockPVZRepo.EXPECT().CountPVZS(gomock.Any(), filter).Return(3, nil)
+	mockPVZRepo.EXPECT().GetPVZSInfo(gomock.Any(), filter).Return([]domain.PvzInfo{{Pvz: domain.Pvz{ID: "2"}}, {Pvz: domain.Pvz{ID: "3"}}}, nil)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	pvzService := NewPVZService(mockPVZRepo, nil, []string{"Moscow"}, nil, nil, nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
+
+	result, err := pvzService.GetPVZSInfo(ctx, domain.PvzInfoQuery{StartDate: &start, EndDate: &end, Page: page, Limit: limit})
+	assert.NoError(t, err)
+	assert.Len(t, result.Items, 1)
+	assert.Equal(t, "2", result.Items[0].Pvz.ID)
+	assert.Equal(t, 3, result.Total)
+	assert.Equal(t, 3, result.NextPage)
+	assert.Equal(t, encodePvzCursor("2"), result.NextCursor)
+}
+
+func TestPVZService_GetPVZSInfo_RepoFail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	selectedPvz := allPvzs[1]
-	exampleReception := &domain.Reception{ID: "reception2"}
-	exampleProduct := domain.Product{ID: "product2"}
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
 
-	mockPVZRepo.EXPECT().GetPVZS(gomock.Any(), offset, limit).Return([]domain.Pvz{selectedPvz}, nil)
-	mockReceptionRepo.EXPECT().GetReceptionsFiltered(gomock.Any(), selectedPvz.ID, start, end).Return([]*domain.Reception{exampleReception}, nil)
-	mockProductRepo.EXPECT().GetProducts(gomock.Any(), exampleReception.ID).Return([]domain.Product{exampleProduct}, nil)
+	mockPVZRepo.EXPECT().CountPVZS(gomock.Any(), gomock.Any()).Return(1, nil)
+	mockPVZRepo.EXPECT().GetPVZSInfo(gomock.Any(), domain.PvzFilter{Limit: 2}).Return(nil, errors.New("db error"))
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
 		return fn(ctx)
 	})
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, nil, []string{"Moscow"}, nil, nil, nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
 
-	pvzInfos, err := pvzService.GetPVZSInfo(ctx, start, end, offset, limit)
-	assert.NoError(t, err)
-	assert.Len(t, pvzInfos, 1)
-	assert.Equal(t, "pvz2", pvzInfos[0].Pvz.ID)
+	_, err := pvzService.GetPVZSInfo(context.Background(), domain.PvzInfoQuery{Page: 1, Limit: 1})
+	assert.Error(t, err)
+}
+
+func TestPVZService_GetPVZSInfo_InvalidPagination(t *testing.T) {
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.GetPVZSInfo(context.Background(), domain.PvzInfoQuery{Page: 0, Limit: 10})
+	assert.ErrorIs(t, err, ErrInvalidPagination)
 }
 
-func TestPVZService_GetPVZSInfo_ProductFail(t *testing.T) {
+func TestPVZService_GetPVZSInfo_Cursor(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
 	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
-	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
-	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
 
-	ctx := context.Background()
-	start := time.Now().Add(-24 * time.Hour)
-	end := time.Now()
-	offset := 0
-	limit := 1
+	// курсор заменяет смещение: выборка продолжается строго после id 5
+	filter := domain.PvzFilter{AfterID: "5", Limit: 2}
+	mockPVZRepo.EXPECT().CountPVZS(gomock.Any(), filter).Return(10, nil)
+	mockPVZRepo.EXPECT().GetPVZSInfo(gomock.Any(), filter).Return([]domain.PvzInfo{{Pvz: domain.Pvz{ID: "6"}}, {Pvz: domain.Pvz{ID: "7"}}}, nil)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	pvzService := NewPVZService(mockPVZRepo, nil, []string{"Moscow"}, nil, nil, nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
+
+	result, err := pvzService.GetPVZSInfo(context.Background(), domain.PvzInfoQuery{Page: 1, Limit: 1, Cursor: encodePvzCursor("5")})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "6", result.Items[0].Pvz.ID)
+	assert.Equal(t, encodePvzCursor("6"), result.NextCursor)
+	assert.Zero(t, result.Page)
+	assert.Zero(t, result.NextPage)
+}
+
+func TestPVZService_GetPVZSInfo_Filters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	examplePvz := domain.Pvz{ID: "pvz1"}
-	exampleReception := &domain.Reception{ID: "reception1"}
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
 
-	mockPVZRepo.EXPECT().GetPVZS(gomock.Any(), offset, limit).Return([]domain.Pvz{examplePvz}, nil)
-	mockReceptionRepo.EXPECT().GetReceptionsFiltered(gomock.Any(), examplePvz.ID, start, end).Return([]*domain.Reception{exampleReception}, nil)
-	mockProductRepo.EXPECT().GetProducts(gomock.Any(), exampleReception.ID).Return(nil, errors.New("db error"))
+	// фильтры доходят до репозитория и учитываются в подсчете, тип товара - нормализованным
+	filter := domain.PvzFilter{
+		Cities:            []string{"Kazan"},
+		PvzIDs:            []string{"1", "2"},
+		ReceptionStatuses: []string{"closed"},
+		ProductTypes:      []string{"электроника"},
+		Limit:             11,
+	}
+	mockPVZRepo.EXPECT().CountPVZS(gomock.Any(), filter).Return(1, nil)
+	mockPVZRepo.EXPECT().GetPVZSInfo(gomock.Any(), filter).Return([]domain.PvzInfo{{Pvz: domain.Pvz{ID: "1", City: "Kazan"}}}, nil)
 
 	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
 		return fn(ctx)
 	})
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, []string{"Moscow"}, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, nil, []string{"Moscow", "Kazan"}, nil, anyProductTypeRepo(ctrl), nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
 
-	_, err := pvzService.GetPVZSInfo(ctx, start, end, offset, limit)
-	assert.Error(t, err)
+	result, err := pvzService.GetPVZSInfo(context.Background(), domain.PvzInfoQuery{
+		Cities:            []string{"Kazan"},
+		PvzIDs:            []string{"1", "2"},
+		ReceptionStatuses: []string{"closed"},
+		ProductTypes:      []string{" Электроника"},
+		Page:              1,
+		Limit:             10,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, 1, result.Total)
+}
+
+func TestPVZService_GetPVZSInfo_UnknownProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTypeRepo.EXPECT().GetProductTypes(gomock.Any(), []string{"electronic"}).Return([]domain.ProductType{}, nil)
+
+	pvzService := NewPVZService(nil, nil, []string{"Moscow"}, nil, mockTypeRepo, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.GetPVZSInfo(context.Background(), domain.PvzInfoQuery{
+		ProductTypes: []string{"Electronic"},
+		Page:         1,
+		Limit:        10,
+	})
+	assert.ErrorIs(t, err, ErrInvalidProductType)
+}
+
+func TestPVZService_GetPVZSInfo_InvalidFilters(t *testing.T) {
+	pvzService := NewPVZService(nil, nil, []string{"Moscow"}, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+	rangeStart := time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)
+	rangeEnd := rangeStart.Add(24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		query       domain.PvzInfoQuery
+		expectedErr error
+	}{
+		{
+			name:        "unknown city",
+			query:       domain.PvzInfoQuery{Cities: []string{"Paris"}, Page: 1, Limit: 10},
+			expectedErr: ErrInvalidCity,
+		},
+		{
+			name:        "non numeric pvz id",
+			query:       domain.PvzInfoQuery{PvzIDs: []string{"abc"}, Page: 1, Limit: 10},
+			expectedErr: ErrInvalidFilter,
+		},
+		{
+			name:        "unknown reception status",
+			query:       domain.PvzInfoQuery{ReceptionStatuses: []string{"lost"}, Page: 1, Limit: 10},
+			expectedErr: ErrInvalidFilter,
+		},
+		{
+			name:        "start date after end date",
+			query:       domain.PvzInfoQuery{StartDate: &rangeEnd, EndDate: &rangeStart, Page: 1, Limit: 10},
+			expectedErr: ErrInvalidFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pvzService.GetPVZSInfo(context.Background(), tt.query)
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestPVZService_GetPVZSInfo_InvalidCursor(t *testing.T) {
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.GetPVZSInfo(context.Background(), domain.PvzInfoQuery{Page: 1, Limit: 10, Cursor: "broken"})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
 }
 
 func TestPVZService_GetPVZList(t *testing.T) {
@@ -554,6 +759,8 @@ func TestPVZService_GetPVZList(t *testing.T) {
 	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
 	mockProductRepo := repomock.NewMockProductRepository(ctrl)
 	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 	cities := []string{"Moscow"}
 
 	examplePVZS := []domain.Pvz{
@@ -561,15 +768,995 @@ func TestPVZService_GetPVZList(t *testing.T) {
 		{ID: "2"},
 	}
 
-	mockPVZRepo.EXPECT().GetListOfPVZS(gomock.Any()).Return(examplePVZS, nil).Times(1)
+	mockPVZRepo.EXPECT().GetPVZS(gomock.Any(), domain.PvzFilter{Limit: 3}).Return(examplePVZS, nil).Times(1)
 
-	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, mockTxManager, slog.Default())
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, cities, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
 
-	pvzs, err := pvzService.GetPVZList(context.Background())
+	page, err := pvzService.GetPVZList(context.Background(), "", 2)
 	require.NoError(t, err)
 
+	pvzs := page.Items
 	require.Equal(t, len(examplePVZS), len(pvzs))
 	require.Equal(t, examplePVZS[0].ID, pvzs[0].ID)
 	require.Equal(t, examplePVZS[1].ID, pvzs[1].ID)
+	require.Empty(t, page.NextCursor)
+
+}
+
+func TestPVZService_GetPVZList_NextCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+
+	mockPVZRepo.EXPECT().GetPVZS(gomock.Any(), domain.PvzFilter{AfterID: "1", Limit: 3}).
+		Return([]domain.Pvz{{ID: "2"}, {ID: "3"}, {ID: "4"}}, nil)
+
+	pvzService := NewPVZService(mockPVZRepo, nil, []string{"Moscow"}, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	page, err := pvzService.GetPVZList(context.Background(), encodePvzCursor("1"), 2)
+	require.NoError(t, err)
+	require.Len(t, page.Items, 2)
+	require.Equal(t, "3", page.Items[1].ID)
+	require.Equal(t, encodePvzCursor("3"), page.NextCursor)
+}
+
+func TestPVZService_GetPVZList_InvalidCursor(t *testing.T) {
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.GetPVZList(context.Background(), "broken", 10)
+	require.ErrorIs(t, err, ErrInvalidCursor)
+
+	_, err = pvzService.GetPVZList(context.Background(), "", 0)
+	require.ErrorIs(t, err, ErrInvalidPagination)
+}
+
+func TestPVZService_StartReception_PublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	reception := &domain.Reception{ID: "r1", PvzID: "pvz1", Status: domain.ReceptionInProgress}
+
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+	mockReceptionRepo.EXPECT().CreateReception(gomock.Any(), "pvz1").Return(reception, nil)
+	mockReceptionRepo.EXPECT().AddStatusChange(gomock.Any(), gomock.Any()).Return(&domain.ReceptionStatusChange{}, nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockBroker.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event domain.PvzEvent) domain.PvzEvent {
+		assert.Equal(t, domain.EventReceptionStarted, event.Type)
+		assert.Equal(t, "pvz1", event.PvzID)
+		assert.Equal(t, "r1", event.ReceptionID)
+		return event
+	})
+
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, []string{"Moscow"}, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+
+	_, err := pvzService.StartReception(context.Background(), "pvz1")
+	require.NoError(t, err)
+}
+
+func TestPVZService_AddProduct_NoEventOnRollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, []string{"Moscow"}, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+
+	_, err := pvzService.AddProduct(context.Background(), "pvz1", domain.ProductItem{Type: "обувь"})
+	require.ErrorIs(t, err, ErrAllReceptionsClosed)
+}
+
+func TestPVZService_WatchPVZ_SequenceExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+	mockBroker.EXPECT().Subscribe(gomock.Any(), []string{"pvz1"}, uint64(7)).Return(nil, events.ErrSequenceExpired)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, mockBroker, nil, slog.Default())
+
+	_, err := pvzService.WatchPVZ(context.Background(), []string{"pvz1"}, 7)
+	require.ErrorIs(t, err, ErrSequenceExpired)
+}
+
+func TestPVZService_AddProducts_Success(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	items := []domain.ProductItem{{Type: "обувь"}, {Type: "одежда", Barcode: "4601234567890", OrderID: "ORD-1"}}
+	created := []domain.Product{
+		{ID: "1", Type: "обувь", ReceptionID: "r1"},
+		{ID: "2", Type: "одежда", ReceptionID: "r1"},
+	}
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r1"}, nil)
+	mockProductRepo.EXPECT().CreateProducts(gomock.Any(), items, "r1").Return(created, nil)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventProductAdded, "pvz1", gomock.Any()).Return(nil).Times(2)
+	mockBroker.EXPECT().Publish(gomock.Any()).Return(domain.PvzEvent{}).Times(2)
+
+	pvzService := NewPVZService(mockPVZRepo, mockReceptionRepo, []string{"Moscow"}, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+
+	products, err := pvzService.AddProducts(context.Background(), "pvz1", items)
+	require.NoError(t, err)
+	assert.Equal(t, created, products)
+}
+
+func TestPVZService_AddProducts_InvalidBatchSize(t *testing.T) {
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProducts(context.Background(), "pvz1", nil)
+	assert.ErrorIs(t, err, ErrInvalidBatchSize)
+
+	_, err = pvzService.AddProducts(context.Background(), "pvz1", make([]domain.ProductItem, MaxProductsBatchSize+1))
+	assert.ErrorIs(t, err, ErrInvalidBatchSize)
+}
+
+func TestPVZService_AddProducts_NoOpenReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, anyProductTypeRepo(ctrl), nil, nil, nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+
+	_, err := pvzService.AddProducts(context.Background(), "pvz1", []domain.ProductItem{{Type: "обувь"}})
+	assert.ErrorIs(t, err, ErrAllReceptionsClosed)
+}
+
+func TestPVZService_StartReception_NotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockAssignRepo.EXPECT().IsAssigned(gomock.Any(), "7", "pvz1").Return(false, nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, nil, nil, mockAssignRepo, nil, mockTxManager, nil, rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	_, err := pvzService.StartReception(ctx, "pvz1")
+	assert.ErrorIs(t, err, ErrPVZAccessDenied)
+}
+
+func TestPVZService_AddProduct_Assigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockAssignRepo.EXPECT().IsAssigned(gomock.Any(), "7", "pvz1").Return(true, nil)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r1"}, nil)
+	mockProductRepo.EXPECT().CreateProduct(gomock.Any(), domain.ProductItem{Type: "обувь"}, "r1").Return(&domain.Product{ID: "1", Type: "обувь", ReceptionID: "r1"}, nil)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventProductAdded, "pvz1", gomock.Any()).Return(nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, mockProductRepo, anyProductTypeRepo(ctrl), mockOutboxRepo, anyAuditRepo(ctrl), mockAssignRepo, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	product, err := pvzService.AddProduct(ctx, "pvz1", domain.ProductItem{Type: "обувь"})
+	require.NoError(t, err)
+	assert.Equal(t, "1", product.ID)
+}
+
+func TestPVZService_DeleteLastProduct_AnyPVZSkipsAssignment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+
+	policy, err := rbac.NewPolicy(map[string][]string{
+		"employee": {string(rbac.ProductDelete), string(rbac.PVZAny)},
+	}, nil)
+	require.NoError(t, err)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, nil, nil, mockAssignRepo, nil, mockTxManager, nil, policy, slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	err = pvzService.DeleteLastProduct(ctx, "pvz1")
+	assert.ErrorIs(t, err, ErrAllReceptionsClosed)
+}
+
+func TestPVZService_GetPVZSInfo_ScopedToAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockAssignRepo.EXPECT().GetAssignedPVZIDs(gomock.Any(), "7").Return([]string{"1", "3"}, nil)
+
+	// из запрошенных 1 и 2 сотруднику виден только 1
+	filter := domain.PvzFilter{PvzIDs: []string{"1"}, Limit: 2}
+	mockPVZRepo.EXPECT().CountPVZS(gomock.Any(), filter).Return(1, nil)
+	mockPVZRepo.EXPECT().GetPVZSInfo(gomock.Any(), filter).Return([]domain.PvzInfo{{Pvz: domain.Pvz{ID: "1"}}}, nil)
+
+	pvzService := NewPVZService(mockPVZRepo, nil, nil, nil, nil, nil, nil, mockAssignRepo, nil, mockTxManager, nil, rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	result, err := pvzService.GetPVZSInfo(ctx, domain.PvzInfoQuery{PvzIDs: []string{"1", "2"}, Page: 1, Limit: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Len(t, result.Items, 1)
+}
+
+func TestPVZService_GetPVZSInfo_NoAssignments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockAssignRepo.EXPECT().GetAssignedPVZIDs(gomock.Any(), "7").Return(nil, nil)
+
+	// без назначений в репозиторий ПВЗ не ходим: пустой фильтр по id вернул бы все ПВЗ
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, mockAssignRepo, nil, mockTxManager, nil, rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	result, err := pvzService.GetPVZSInfo(ctx, domain.PvzInfoQuery{Page: 1, Limit: 10})
+	require.NoError(t, err)
+	assert.Zero(t, result.Total)
+	assert.Empty(t, result.Items)
+}
+
+func TestPVZService_WatchPVZ_NotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockAssignRepo.EXPECT().GetAssignedPVZIDs(gomock.Any(), "7").Return([]string{"1"}, nil)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, mockAssignRepo, nil, nil, nil, rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	_, err := pvzService.WatchPVZ(ctx, []string{"1", "2"}, 0)
+	assert.ErrorIs(t, err, ErrPVZAccessDenied)
+}
+
+func TestPVZService_AssignEmployee(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPVZRepo := repomock.NewMockPvzRepository(ctrl)
+	mockUserRepo := repomock.NewMockUserRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Times(3)
+
+	mockPVZRepo.EXPECT().GetPVZ(gomock.Any(), "404").Return(nil, nil)
+	mockPVZRepo.EXPECT().GetPVZ(gomock.Any(), "1").Return(&domain.Pvz{ID: "1"}, nil).Times(2)
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), "404").Return(nil, nil)
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), "7").Return(&domain.User{ID: "7"}, nil)
+	mockAssignRepo.EXPECT().AssignUser(gomock.Any(), "7", "1").Return(nil)
+
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+	mockAuditRepo.EXPECT().AddEntry(gomock.Any(), domain.AuditEntry{
+		UserID:       "1",
+		Role:         "moderator",
+		Action:       domain.AuditEmployeeAssigned,
+		PvzID:        "1",
+		TargetUserID: "7",
+	}).Return(nil)
+
+	pvzService := NewPVZService(mockPVZRepo, nil, nil, nil, nil, nil, mockAuditRepo, mockAssignRepo, mockUserRepo, mockTxManager, nil, rbac.DefaultPolicy(), slog.Default())
+
+	assert.ErrorIs(t, pvzService.AssignEmployee(context.Background(), "404", "7"), ErrNoPVZFound)
+	assert.ErrorIs(t, pvzService.AssignEmployee(context.Background(), "1", "404"), ErrUserNotFound)
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "1", Role: "moderator"})
+	assert.NoError(t, pvzService.AssignEmployee(ctx, "1", "7"))
+}
+
+func TestPVZService_UnassignEmployee_NotAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockAssignRepo.EXPECT().UnassignUser(gomock.Any(), "7", "1").Return(false, nil)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, mockAssignRepo, nil, mockTxManager, nil, rbac.DefaultPolicy(), slog.Default())
+
+	assert.ErrorIs(t, pvzService.UnassignEmployee(context.Background(), "1", "7"), ErrNotFound)
+}
+
+func TestPVZService_StartReception_WritesAuditEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+	mockReceptionRepo.EXPECT().CreateReception(gomock.Any(), "pvz1").
+		Return(&domain.Reception{ID: "r1", PvzID: "pvz1", Status: domain.ReceptionInProgress}, nil)
+	mockReceptionRepo.EXPECT().AddStatusChange(gomock.Any(), domain.ReceptionStatusChange{
+		ReceptionID: "r1",
+		ToStatus:    domain.ReceptionInProgress,
+		Action:      domain.ReceptionActionStart,
+		UserID:      "dummyRandomID",
+		Role:        "employee",
+	}).Return(&domain.ReceptionStatusChange{}, nil)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventReceptionStarted, "pvz1", gomock.Any()).Return(nil)
+	mockAuditRepo.EXPECT().AddEntry(gomock.Any(), domain.AuditEntry{
+		UserID:      "dummyRandomID",
+		Role:        "employee",
+		Dummy:       true,
+		Action:      domain.AuditReceptionStarted,
+		PvzID:       "pvz1",
+		ReceptionID: "r1",
+	}).Return(nil)
+
+	// dummy токен не ограничен назначениями, в репозиторий назначений не ходим
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, mockOutboxRepo, mockAuditRepo, nil, nil, mockTxManager,
+		events.NewBroker(10, 10, slog.Default()), rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "dummyRandomID", Role: "employee", Dummy: true})
+	_, err := pvzService.StartReception(ctx, "pvz1")
+	require.NoError(t, err)
+}
+
+func TestPVZService_AddProduct_AuditFailRollsBack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r1"}, nil)
+	mockProductRepo.EXPECT().CreateProduct(gomock.Any(), domain.ProductItem{Type: "обувь"}, "r1").Return(&domain.Product{ID: "1", ReceptionID: "r1"}, nil)
+	mockAuditRepo.EXPECT().AddEntry(gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, mockProductRepo, anyProductTypeRepo(ctrl), nil, mockAuditRepo, nil, nil, mockTxManager,
+		mockBroker, nil, slog.Default())
+
+	_, err := pvzService.AddProduct(context.Background(), "pvz1", domain.ProductItem{Type: "обувь"})
+	assert.Error(t, err)
+}
+
+func TestPVZService_GetAuditLog_Cursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+
+	from := time.Now().Add(-time.Hour)
+	mockAuditRepo.EXPECT().GetEntries(gomock.Any(), domain.AuditFilter{UserID: "7", From: &from, Limit: 3}).
+		Return([]domain.AuditEntry{{ID: "30"}, {ID: "20"}, {ID: "10"}}, nil)
+	mockAuditRepo.EXPECT().GetEntries(gomock.Any(), domain.AuditFilter{UserID: "7", From: &from, BeforeID: "20", Limit: 3}).
+		Return([]domain.AuditEntry{{ID: "10"}}, nil)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, mockAuditRepo, nil, nil, nil, nil, rbac.DefaultPolicy(), slog.Default())
+
+	page, err := pvzService.GetAuditLog(context.Background(), domain.AuditQuery{UserID: "7", From: &from, Limit: 2})
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 2)
+	assert.Equal(t, encodeAuditCursor("20"), page.NextCursor)
+
+	page, err = pvzService.GetAuditLog(context.Background(), domain.AuditQuery{UserID: "7", From: &from, Limit: 2, Cursor: page.NextCursor})
+	require.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Empty(t, page.NextCursor)
+}
+
+func TestPVZService_GetAuditLog_ScopedToAssigned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+
+	mockAssignRepo.EXPECT().GetAssignedPVZIDs(gomock.Any(), "7").Return([]string{"1"}, nil)
+	mockAuditRepo.EXPECT().GetEntries(gomock.Any(), domain.AuditFilter{PvzIDs: []string{"1"}, Limit: 11}).Return(nil, nil)
+
+	policy, err := rbac.NewPolicy(map[string][]string{"lead": {string(rbac.AuditRead)}}, nil)
+	require.NoError(t, err)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, mockAuditRepo, mockAssignRepo, nil, nil, nil, policy, slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "lead"})
+	_, err = pvzService.GetAuditLog(ctx, domain.AuditQuery{Limit: 10})
+	require.NoError(t, err)
+}
+
+func TestPVZService_GetAuditLog_InvalidQuery(t *testing.T) {
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.GetAuditLog(context.Background(), domain.AuditQuery{Limit: 0})
+	assert.ErrorIs(t, err, ErrInvalidPagination)
+
+	from, to := time.Now(), time.Now().Add(-time.Hour)
+	_, err = pvzService.GetAuditLog(context.Background(), domain.AuditQuery{From: &from, To: &to, Limit: 10})
+	assert.ErrorIs(t, err, ErrInvalidFilter)
+
+	// курсор списка ПВЗ не подходит журналу
+	_, err = pvzService.GetAuditLog(context.Background(), domain.AuditQuery{Limit: 10, Cursor: encodePvzCursor("1")})
+	assert.ErrorIs(t, err, ErrInvalidCursor)
+}
+
+func TestPVZService_AddProduct_UnknownType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTypeRepo.EXPECT().GetProductTypes(gomock.Any(), []string{"electronic"}).Return([]domain.ProductType{}, nil)
+
+	// до транзакции дело не доходит
+	pvzService := NewPVZService(nil, nil, nil, nil, mockTypeRepo, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProduct(context.Background(), "pvz1", domain.ProductItem{Type: " Electronic"})
+	require.ErrorIs(t, err, ErrInvalidProductType)
+
+	var invalidType *InvalidProductTypeError
+	require.ErrorAs(t, err, &invalidType)
+	assert.Equal(t, "electronic", invalidType.Type)
+}
+
+func TestPVZService_AddProducts_NormalizesTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	mockTypeRepo.EXPECT().GetProductTypes(gomock.Any(), []string{"обувь", "одежда"}).
+		Return([]domain.ProductType{{Name: "обувь"}, {Name: "одежда"}}, nil)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r1"}, nil)
+	mockProductRepo.EXPECT().CreateProducts(gomock.Any(), []domain.ProductItem{{Type: "обувь"}, {Type: "одежда"}, {Type: "обувь"}}, "r1").
+		Return([]domain.Product{{ID: "1"}, {ID: "2"}, {ID: "3"}}, nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, mockProductRepo, mockTypeRepo, mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
+
+	products, err := pvzService.AddProducts(context.Background(), "pvz1", []domain.ProductItem{{Type: "Обувь"}, {Type: " одежда "}, {Type: "ОБУВЬ"}})
+	require.NoError(t, err)
+	assert.Len(t, products, 3)
+}
+
+func TestPVZService_AddProducts_UnknownType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTypeRepo.EXPECT().GetProductTypes(gomock.Any(), []string{"обувь", "электроника", "shoes"}).
+		Return([]domain.ProductType{{Name: "обувь"}, {Name: "электроника"}}, nil)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, mockTypeRepo, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProducts(context.Background(), "pvz1", []domain.ProductItem{{Type: "обувь"}, {Type: "электроника"}, {Type: "shoes"}})
+	var invalidType *InvalidProductTypeError
+	require.ErrorAs(t, err, &invalidType)
+	assert.Equal(t, "shoes", invalidType.Type)
+}
+
+func TestPVZService_CreateProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+
+	pvzService := NewPVZService(nil, nil, nil, nil, mockTypeRepo, nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
+
+	mockTypeRepo.EXPECT().GetProductType(gomock.Any(), "посуда").Return(nil, nil)
+	mockTypeRepo.EXPECT().CreateProductType(gomock.Any(), domain.ProductType{Name: "посуда", Fragile: true, MaxWeightGrams: 5000}).
+		Return(&domain.ProductType{Name: "посуда", Fragile: true, MaxWeightGrams: 5000}, nil)
+
+	created, err := pvzService.CreateProductType(context.Background(), domain.ProductType{Name: " Посуда", Fragile: true, MaxWeightGrams: 5000})
+	require.NoError(t, err)
+	assert.Equal(t, "посуда", created.Name)
+
+	mockTypeRepo.EXPECT().GetProductType(gomock.Any(), "обувь").Return(&domain.ProductType{Name: "обувь"}, nil)
+
+	_, err = pvzService.CreateProductType(context.Background(), domain.ProductType{Name: "Обувь"})
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+
+	tests := []domain.ProductType{
+		{Name: "  "},
+		{Name: strings.Repeat("я", MaxProductTypeLength+1)},
+		{Name: "книги", MaxWeightGrams: -1},
+	}
+	for _, productType := range tests {
+		_, err = pvzService.CreateProductType(context.Background(), productType)
+		assert.ErrorIs(t, err, ErrInvalidProductType, productType.Name)
+	}
+}
+
+func TestPVZService_UpdateAndDeleteProductType_NotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTypeRepo := repomock.NewMockProductTypeRepository(ctrl)
+	mockTypeRepo.EXPECT().UpdateProductType(gomock.Any(), domain.ProductType{Name: "книги", Fragile: true}).Return(nil, nil)
+	mockTypeRepo.EXPECT().DeleteProductType(gomock.Any(), "книги").Return(false, nil)
+
+	pvzService := NewPVZService(nil, nil, nil, nil, mockTypeRepo, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.UpdateProductType(context.Background(), domain.ProductType{Name: "Книги", Fragile: true})
+	assert.ErrorIs(t, err, ErrProductTypeNotFound)
+
+	err = pvzService.DeleteProductType(context.Background(), "КНИГИ")
+	assert.ErrorIs(t, err, ErrProductTypeNotFound)
+}
+
+func TestPVZService_AddProducts_DuplicateBarcodeInRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// повтор штрихкода ловится до транзакции, пробелы по краям не делают его другим
+	pvzService := NewPVZService(nil, nil, nil, nil, anyProductTypeRepo(ctrl), nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProducts(context.Background(), "pvz1", []domain.ProductItem{
+		{Type: "обувь", Barcode: "4601234567890"},
+		{Type: "одежда"},
+		{Type: "одежда"},
+		{Type: "обувь", Barcode: " 4601234567890 "},
+	})
+	assert.ErrorIs(t, err, ErrDuplicateBarcode)
+}
+
+func TestPVZService_AddProduct_DuplicateBarcodeInReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r1"}, nil)
+	mockProductRepo.EXPECT().CreateProduct(gomock.Any(),
+		domain.ProductItem{Type: "обувь", Barcode: "4601234567890", OrderID: "ORD-1"}, "r1").
+		Return(nil, repository.ErrAlreadyExists)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, mockProductRepo, anyProductTypeRepo(ctrl), nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProduct(context.Background(), "pvz1",
+		domain.ProductItem{Type: "обувь", Barcode: "4601234567890 ", OrderID: " ORD-1"})
+	assert.ErrorIs(t, err, ErrDuplicateBarcode)
+}
+
+func TestPVZService_AddProduct_InvalidIdentity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pvzService := NewPVZService(nil, nil, nil, nil, anyProductTypeRepo(ctrl), nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProduct(context.Background(), "pvz1",
+		domain.ProductItem{Type: "обувь", Barcode: strings.Repeat("1", MaxBarcodeLength+1)})
+	assert.ErrorIs(t, err, ErrInvalidBarcode)
+
+	_, err = pvzService.AddProduct(context.Background(), "pvz1",
+		domain.ProductItem{Type: "обувь", OrderID: strings.Repeat("1", MaxOrderIDLength+1)})
+	assert.ErrorIs(t, err, ErrInvalidOrderID)
+}
+
+func TestPVZService_FindProductsByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+
+	found := []domain.Product{{ID: "1", Barcode: "4601234567890", ReceptionID: "r1"}}
+	mockProductRepo.EXPECT().FindProductsByBarcode(gomock.Any(), "4601234567890", nil).Return(found, nil)
+	mockAssignRepo.EXPECT().GetAssignedPVZIDs(gomock.Any(), "7").Return([]string{"1", "3"}, nil)
+	mockProductRepo.EXPECT().FindProductsByBarcode(gomock.Any(), "4601234567890", []string{"1", "3"}).Return(found, nil)
+
+	pvzService := NewPVZService(nil, nil, nil, mockProductRepo, nil, nil, nil, mockAssignRepo, nil, nil, nil, rbac.DefaultPolicy(), slog.Default())
+
+	// модератор ищет по всем ПВЗ
+	moderator := rbac.WithActor(context.Background(), rbac.Actor{UserID: "1", Role: "moderator"})
+	products, err := pvzService.FindProductsByBarcode(moderator, " 4601234567890")
+	require.NoError(t, err)
+	assert.Equal(t, found, products)
+
+	// сотрудник - только по назначенным
+	employee := rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"})
+	products, err = pvzService.FindProductsByBarcode(employee, "4601234567890")
+	require.NoError(t, err)
+	assert.Equal(t, found, products)
+
+	_, err = pvzService.FindProductsByBarcode(context.Background(), "  ")
+	assert.ErrorIs(t, err, ErrInvalidBarcode)
+}
+
+func TestPVZService_DeleteProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	deletedAt := time.Now()
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Times(4)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r2"}, nil).Times(4)
+
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "5").Return(&domain.Product{ID: "5", ReceptionID: "r2"}, nil)
+	mockProductRepo.EXPECT().DeleteProduct(gomock.Any(), "5").
+		Return(&domain.Product{ID: "5", ReceptionID: "r2", DeletedAt: &deletedAt}, nil)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventProductDeleted, "pvz1", gomock.Any()).Return(nil)
+	mockBroker.EXPECT().Publish(gomock.Any()).Return(domain.PvzEvent{})
+
+	// товар закрытой приемки, уже удаленный и несуществующий товар удалить нельзя
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "3").Return(&domain.Product{ID: "3", ReceptionID: "r1"}, nil)
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "4").Return(&domain.Product{ID: "4", ReceptionID: "r2", DeletedAt: &deletedAt}, nil)
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "404").Return(nil, nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, mockProductRepo, nil, mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+
+	require.NoError(t, pvzService.DeleteProduct(context.Background(), "pvz1", "5"))
+
+	for _, productID := range []string{"3", "4", "404"} {
+		err := pvzService.DeleteProduct(context.Background(), "pvz1", productID)
+		assert.ErrorIs(t, err, ErrProductNotFound, productID)
+	}
+}
+
+func TestPVZService_DeleteAndRestoreProduct_InvalidID(t *testing.T) {
+	// нечисловой id отсекается до транзакции и запроса к БД
+	pvzService := NewPVZService(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, slog.Default())
+
+	for _, productID := range []string{"abc", "", "-1", "1.5"} {
+		err := pvzService.DeleteProduct(context.Background(), "pvz1", productID)
+		assert.ErrorIs(t, err, ErrProductNotFound, productID)
+
+		_, err = pvzService.RestoreProduct(context.Background(), "pvz1", productID)
+		assert.ErrorIs(t, err, ErrProductNotFound, productID)
+	}
+}
+
+func TestPVZService_RestoreProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockProductRepo := repomock.NewMockProductRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	deletedAt := time.Now()
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Times(4)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(&domain.Reception{ID: "r2"}, nil).Times(4)
+
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "5").
+		Return(&domain.Product{ID: "5", ReceptionID: "r2", DeletedAt: &deletedAt}, nil)
+	mockProductRepo.EXPECT().RestoreProduct(gomock.Any(), "5").Return(&domain.Product{ID: "5", ReceptionID: "r2"}, nil)
+	// в outbox и broker уходит одно и то же время восстановления
+	var outboxPayload eventPayload
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventProductRestored, "pvz1", gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ string, payload []byte) error {
+			return json.Unmarshal(payload, &outboxPayload)
+		},
+	)
+	mockBroker.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event domain.PvzEvent) domain.PvzEvent {
+		assert.False(t, event.OccurredAt.IsZero())
+		assert.True(t, outboxPayload.OccurredAt.Equal(event.OccurredAt))
+		return event
+	})
+
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "6").Return(&domain.Product{ID: "6", ReceptionID: "r2"}, nil)
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "3").
+		Return(&domain.Product{ID: "3", ReceptionID: "r1", DeletedAt: &deletedAt}, nil)
+	mockProductRepo.EXPECT().GetProduct(gomock.Any(), "7").
+		Return(&domain.Product{ID: "7", ReceptionID: "r2", Barcode: "1", DeletedAt: &deletedAt}, nil)
+	mockProductRepo.EXPECT().RestoreProduct(gomock.Any(), "7").Return(nil, repository.ErrAlreadyExists)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, mockProductRepo, nil, mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+
+	product, err := pvzService.RestoreProduct(context.Background(), "pvz1", "5")
+	require.NoError(t, err)
+	assert.Equal(t, "5", product.ID)
+
+	_, err = pvzService.RestoreProduct(context.Background(), "pvz1", "6")
+	assert.ErrorIs(t, err, ErrProductNotDeleted)
+
+	_, err = pvzService.RestoreProduct(context.Background(), "pvz1", "3")
+	assert.ErrorIs(t, err, ErrProductNotFound)
+
+	// штрихкод восстанавливаемого товара успели отсканировать заново
+	_, err = pvzService.RestoreProduct(context.Background(), "pvz1", "7")
+	assert.ErrorIs(t, err, ErrDuplicateBarcode)
+}
+
+func TestPVZService_ReceptionTransitions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockBroker := eventsmock.NewMockBroker(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).AnyTimes()
+
+	changedAt := time.Now()
+	mockReceptionRepo.EXPECT().AddStatusChange(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, change domain.ReceptionStatusChange) (*domain.ReceptionStatusChange, error) {
+			change.ChangedAt = changedAt
+			return &change, nil
+		},
+	).AnyTimes()
+
+	// пауза приемки в работе
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "1").
+		Return(&domain.Reception{ID: "1", PvzID: "pvz1", Status: domain.ReceptionInProgress}, nil)
+	mockReceptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), "1", domain.ReceptionInProgress, domain.ReceptionPaused).Return(true, nil)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventReceptionPaused, "pvz1", gomock.Any()).Return(nil)
+	mockBroker.EXPECT().Publish(gomock.Any()).DoAndReturn(func(event domain.PvzEvent) domain.PvzEvent {
+		assert.Equal(t, domain.EventReceptionPaused, event.Type)
+		assert.Equal(t, "1", event.ReceptionID)
+		assert.Equal(t, changedAt, event.OccurredAt)
+		return event
+	})
+
+	// закрытую приемку нельзя приостановить
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "2").
+		Return(&domain.Reception{ID: "2", PvzID: "pvz1", Status: domain.ReceptionClosed}, nil)
+
+	// закрытую приемку нельзя вернуть в работу, пока в ПВЗ есть другая открытая
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "3").
+		Return(&domain.Reception{ID: "3", PvzID: "pvz1", Status: domain.ReceptionClosed}, nil)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").
+		Return(&domain.Reception{ID: "1", PvzID: "pvz1", Status: domain.ReceptionPaused}, nil)
+
+	// отмененная приемка конечная
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "4").
+		Return(&domain.Reception{ID: "4", PvzID: "pvz2", Status: domain.ReceptionCancelled}, nil)
+
+	// статус сменили параллельно
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "5").
+		Return(&domain.Reception{ID: "5", PvzID: "pvz2", Status: domain.ReceptionPaused}, nil)
+	mockReceptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), "5", domain.ReceptionPaused, domain.ReceptionCancelled).Return(false, nil)
+
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "404").Return(nil, nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, mockOutboxRepo, anyAuditRepo(ctrl), nil, nil, mockTxManager, mockBroker, nil, slog.Default())
+	ctx := context.Background()
+
+	reception, err := pvzService.PauseReception(ctx, "1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReceptionPaused, reception.Status)
+
+	_, err = pvzService.PauseReception(ctx, "2")
+	assert.ErrorIs(t, err, ErrInvalidReceptionTransition)
+
+	_, err = pvzService.ReopenReception(ctx, "3", "")
+	assert.ErrorIs(t, err, ErrAlreadyOpen)
+
+	_, err = pvzService.ReopenReception(ctx, "4", "")
+	assert.ErrorIs(t, err, ErrInvalidReceptionTransition)
+
+	_, err = pvzService.CancelReception(ctx, "5", "брак")
+	assert.ErrorIs(t, err, ErrInvalidReceptionTransition)
+
+	_, err = pvzService.ResumeReception(ctx, "404")
+	assert.ErrorIs(t, err, ErrReceptionNotFound)
+
+	// до БД не доходят
+	_, err = pvzService.ResumeReception(ctx, "abc")
+	assert.ErrorIs(t, err, ErrReceptionNotFound)
+
+	_, err = pvzService.CancelReception(ctx, "1", strings.Repeat("x", MaxReceptionReasonLength+1))
+	assert.ErrorIs(t, err, ErrInvalidReason)
+}
+
+func TestPVZService_ReopenReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+	mockOutboxRepo := repomock.NewMockOutboxRepository(ctrl)
+	mockAuditRepo := repomock.NewMockAuditRepository(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "3").
+		Return(&domain.Reception{ID: "3", PvzID: "pvz1", Status: domain.ReceptionClosed}, nil)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+	mockReceptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), "3", domain.ReceptionClosed, domain.ReceptionInProgress).Return(true, nil)
+	mockReceptionRepo.EXPECT().AddStatusChange(gomock.Any(), domain.ReceptionStatusChange{
+		ReceptionID: "3",
+		FromStatus:  domain.ReceptionClosed,
+		ToStatus:    domain.ReceptionInProgress,
+		Action:      domain.ReceptionActionReopen,
+		Reason:      "пропущен товар",
+		UserID:      "1",
+		Role:        "moderator",
+	}).Return(&domain.ReceptionStatusChange{Action: domain.ReceptionActionReopen}, nil)
+	mockAuditRepo.EXPECT().AddEntry(gomock.Any(), domain.AuditEntry{
+		UserID:      "1",
+		Role:        "moderator",
+		Action:      domain.AuditReceptionReopened,
+		PvzID:       "pvz1",
+		ReceptionID: "3",
+	}).Return(nil)
+	mockOutboxRepo.EXPECT().AddEvent(gomock.Any(), domain.EventReceptionReopened, "pvz1", gomock.Any()).Return(nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, mockOutboxRepo, mockAuditRepo, nil, nil, mockTxManager,
+		events.NewBroker(10, 10, slog.Default()), rbac.DefaultPolicy(), slog.Default())
+
+	ctx := rbac.WithActor(context.Background(), rbac.Actor{UserID: "1", Role: "moderator"})
+	reception, err := pvzService.ReopenReception(ctx, "3", "  пропущен товар ")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ReceptionInProgress, reception.Status)
+}
+
+func TestPVZService_ReopenReception_ConcurrentlyOpened(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	})
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "3").
+		Return(&domain.Reception{ID: "3", PvzID: "pvz1", Status: domain.ReceptionClosed}, nil)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").Return(nil, nil)
+	mockReceptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), "3", domain.ReceptionClosed, domain.ReceptionInProgress).
+		Return(false, repository.ErrAlreadyExists)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, nil, nil, nil, nil, mockTxManager,
+		events.NewBroker(10, 10, slog.Default()), nil, slog.Default())
+
+	_, err := pvzService.ReopenReception(context.Background(), "3", "")
+	assert.ErrorIs(t, err, ErrAlreadyOpen)
+}
+
+func TestPVZService_AddProduct_ReceptionPaused(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Times(2)
+	mockReceptionRepo.EXPECT().FindOpen(gomock.Any(), "pvz1").
+		Return(&domain.Reception{ID: "r1", PvzID: "pvz1", Status: domain.ReceptionPaused}, nil).Times(2)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, anyProductTypeRepo(ctrl), nil, nil, nil, nil, mockTxManager, nil, nil, slog.Default())
+
+	_, err := pvzService.AddProduct(context.Background(), "pvz1", domain.ProductItem{Type: "обувь"})
+	assert.ErrorIs(t, err, ErrReceptionPaused)
+
+	err = pvzService.DeleteLastProduct(context.Background(), "pvz1")
+	assert.ErrorIs(t, err, ErrReceptionPaused)
+}
+
+func TestPVZService_GetReceptionHistory(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockReceptionRepo := repomock.NewMockReceptionRepository(ctrl)
+	mockAssignRepo := repomock.NewMockAssignmentRepository(ctrl)
+	mockTxManager := repomock.NewMockTxManager(ctrl)
+
+	mockTxManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(context.Context) error) error {
+		return fn(ctx)
+	}).Times(2)
+	mockReceptionRepo.EXPECT().GetReception(gomock.Any(), "1").
+		Return(&domain.Reception{ID: "1", PvzID: "pvz1", Status: domain.ReceptionClosed}, nil).Times(2)
+	mockAssignRepo.EXPECT().IsAssigned(gomock.Any(), "7", "pvz1").Return(true, nil)
+	mockAssignRepo.EXPECT().IsAssigned(gomock.Any(), "8", "pvz1").Return(false, nil)
+	mockReceptionRepo.EXPECT().GetStatusHistory(gomock.Any(), "1").Return([]domain.ReceptionStatusChange{
+		{ReceptionID: "1", ToStatus: domain.ReceptionInProgress, Action: domain.ReceptionActionStart},
+		{ReceptionID: "1", FromStatus: domain.ReceptionInProgress, ToStatus: domain.ReceptionClosed, Action: domain.ReceptionActionClose},
+	}, nil)
+
+	pvzService := NewPVZService(nil, mockReceptionRepo, nil, nil, nil, nil, nil, mockAssignRepo, nil, mockTxManager, nil, rbac.DefaultPolicy(), slog.Default())
+
+	history, err := pvzService.GetReceptionHistory(rbac.WithActor(context.Background(), rbac.Actor{UserID: "7", Role: "employee"}), "1")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.Equal(t, domain.ReceptionActionClose, history[1].Action)
 
+	// приемка чужого ПВЗ
+	_, err = pvzService.GetReceptionHistory(rbac.WithActor(context.Background(), rbac.Actor{UserID: "8", Role: "employee"}), "1")
+	assert.ErrorIs(t, err, ErrPVZAccessDenied)
 }
@@ -1,6 +1,10 @@
 package service
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 
 var (
@@ -15,6 +19,90 @@ var (
 	ErrInvalidCity = errors.New("invalid city")
 	ErrUserNotFound = errors.New("user not found")
 	ErrReceptionEmpty = errors.New("reception is empty")
+	ErrSequenceExpired = errors.New("sequence expired")
+	ErrInvalidBatchSize = errors.New("invalid batch size")
+	ErrInvalidPagination = errors.New("invalid pagination")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidFilter = errors.New("invalid filter")
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	ErrDummyLoginDisabled = errors.New("dummy login is disabled")
+	ErrPVZAccessDenied = errors.New("user is not assigned to pvz")
+	ErrTooManyAttempts = errors.New("too many login attempts")
+	ErrInvalidEmail = errors.New("invalid email")
+	ErrWeakPassword = errors.New("weak password")
+	ErrInvalidResetToken = errors.New("invalid reset token")
+	ErrUserDisabled = errors.New("user is disabled")
+	ErrSelfManagement = errors.New("cannot manage own account")
+	ErrInsufficientPermissions = errors.New("cannot manage user with permissions you do not hold")
+	ErrInvalidProductType = errors.New("invalid product type")
+	ErrProductTypeNotFound = errors.New("product type not found")
+	ErrInvalidBarcode = errors.New("invalid barcode")
+	ErrInvalidOrderID = errors.New("invalid order id")
+	ErrDuplicateBarcode = errors.New("barcode already scanned in reception")
+	ErrProductNotFound = errors.New("product not found")
+	ErrProductNotDeleted = errors.New("product is not deleted")
+	ErrReceptionNotFound = errors.New("reception not found")
+	ErrReceptionPaused = errors.New("reception is paused")
+	ErrInvalidReceptionTransition = errors.New("invalid reception status transition")
+	ErrInvalidReason = errors.New("invalid reason")
+)
+
+// LoginLockedError - вход временно заблокирован после серии неудачных попыток.
+// errors.Is(err, ErrTooManyAttempts) для нее истинно.
+type LoginLockedError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginLockedError) Error() string {
+	return ErrTooManyAttempts.Error()
+}
+
+func (e *LoginLockedError) Unwrap() error {
+	return ErrTooManyAttempts
+}
+
+// InvalidProductTypeError - тип товара отсутствует в справочнике или описан некорректно.
+// errors.Is(err, ErrInvalidProductType) для нее истинно.
+type InvalidProductTypeError struct {
+	Type   string
+	Reason string
+}
+
+func (e *InvalidProductTypeError) Error() string {
+	return fmt.Sprintf("%s %q: %s", ErrInvalidProductType.Error(), e.Type, e.Reason)
+}
+
+func (e *InvalidProductTypeError) Unwrap() error {
+	return ErrInvalidProductType
+}
+
+const (
+	// MaxProductsBatchSize - сколько товаров можно добавить одним запросом AddProducts.
+	MaxProductsBatchSize = 500
+
+	// MaxProductTypeLength - длина названия типа товара, как у колонок product.type и product_type.name.
+	MaxProductTypeLength = 50
+
+	// Длины необязательных штрихкода и номера заказа, как у колонок product.barcode и product.order_id.
+	MaxBarcodeLength = 64
+	MaxOrderIDLength = 64
+
+	// MaxReceptionReasonLength - длина причины отмены или возврата приемки в работу, как у колонки reception_status_history.reason.
+	MaxReceptionReasonLength = 255
+
+	// Пагинация списка ПВЗ с приемками.
+	DefaultPageLimit = 10
+	MaxPageLimit     = 30
+
+	// DefaultRefreshTTL - время жизни refresh токена, если в конфиге оно не задано.
+	DefaultRefreshTTL = 30 * 24 * time.Hour
+
+	// DefaultResetTokenTTL - время жизни токена сброса пароля, если в конфиге оно не задано.
+	DefaultResetTokenTTL = time.Hour
+
+	// Пагинация GetPVZList, там отдаются только сами ПВЗ, поэтому страницы больше.
+	DefaultPVZListLimit = 100
+	MaxPVZListLimit     = 1000
 )
 
 type Service interface {
@@ -0,0 +1,181 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSAKeyBits - RSA ключи короче не принимаются ни для подписи, ни для проверки.
+const minRSAKeyBits = 2048
+
+var (
+	ErrUnknownKeyID   = errors.New("unknown key id")
+	ErrNoSigningKey   = errors.New("no private key for active key id")
+	ErrUnsupportedKey = errors.New("unsupported key type")
+)
+
+// Key - ключ подписи с идентификатором kid. Private может отсутствовать: ключ, выведенный из ротации,
+// остается только для проверки еще не истекших токенов.
+type Key struct {
+	ID      string
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+// Algorithm определяется типом ключа: RSA - RS256, Ed25519 - EdDSA.
+func (k Key) Algorithm() string {
+	switch k.Public.(type) {
+	case *rsa.PublicKey:
+		return jwt.SigningMethodRS256.Alg()
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA.Alg()
+	default:
+		return ""
+	}
+}
+
+func (k Key) signingMethod() jwt.SigningMethod {
+	return jwt.GetSigningMethod(k.Algorithm())
+}
+
+// KeySet - набор ключей для проверки и id активного ключа, которым подписываются новые токены.
+type KeySet struct {
+	active Key
+	keys   map[string]Key
+}
+
+// NewKeySet собирает набор ключей. Если activeID пустой, активным становится ключ с приватной частью
+// и наибольшим id, поэтому при именовании ключей по дате ротация сводится к добавлению нового файла.
+func NewKeySet(activeID string, keys []Key) (*KeySet, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("key set is empty")
+	}
+
+	set := &KeySet{keys: make(map[string]Key, len(keys))}
+	ids := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if key.ID == "" {
+			return nil, errors.New("key id is empty")
+		}
+		if _, ok := set.keys[key.ID]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", key.ID)
+		}
+		set.keys[key.ID] = key
+		ids = append(ids, key.ID)
+	}
+
+	if activeID == "" {
+		sort.Strings(ids)
+		for i := len(ids) - 1; i >= 0; i-- {
+			if set.keys[ids[i]].Private != nil {
+				activeID = ids[i]
+				break
+			}
+		}
+	}
+
+	active, ok := set.keys[activeID]
+	if !ok || active.Private == nil {
+		return nil, fmt.Errorf("%w: %q", ErrNoSigningKey, activeID)
+	}
+	set.active = active
+
+	return set, nil
+}
+
+func (s *KeySet) ActiveID() string {
+	return s.active.ID
+}
+
+func (s *KeySet) lookup(kid string) (Key, bool) {
+	key, ok := s.keys[kid]
+	return key, ok
+}
+
+// ParseKeyPEM разбирает приватный (PKCS#8, PKCS#1) или публичный (PKIX, PKCS#1) ключ RSA или Ed25519.
+func ParseKeyPEM(id string, data []byte) (Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return Key{}, fmt.Errorf("key %q: no PEM block found", id)
+	}
+
+	var (
+		parsed any
+		err    error
+	)
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return Key{}, fmt.Errorf("key %q: unexpected PEM block %q", id, block.Type)
+	}
+	if err != nil {
+		return Key{}, fmt.Errorf("key %q: %w", id, err)
+	}
+
+	key := Key{ID: id}
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key.Private, key.Public = k, &k.PublicKey
+	case *rsa.PublicKey:
+		key.Public = k
+	case ed25519.PrivateKey:
+		key.Private, key.Public = k, k.Public()
+	case ed25519.PublicKey:
+		key.Public = k
+	default:
+		return Key{}, fmt.Errorf("key %q: %w %T", id, ErrUnsupportedKey, parsed)
+	}
+
+	if rsaKey, ok := key.Public.(*rsa.PublicKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+		return Key{}, fmt.Errorf("key %q: RSA key must be at least %d bits", id, minRSAKeyBits)
+	}
+
+	return key, nil
+}
+
+// LoadKeyFile читает ключ из PEM файла.
+func LoadKeyFile(id string, path string) (Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Key{}, err
+	}
+	return ParseKeyPEM(id, data)
+}
+
+// LoadKeyDir читает все *.pem файлы каталога, kid - имя файла без расширения.
+func LoadKeyDir(dir string) ([]Key, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]Key, 0, len(paths))
+	for _, path := range paths {
+		id := strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		key, err := LoadKeyFile(id, path)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,262 @@
+//go:build unit
+
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newRSAKey(t *testing.T, id string) Key {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	return Key{ID: id, Private: private, Public: &private.PublicKey}
+}
+
+func newEd25519Key(t *testing.T, id string) Key {
+	t.Helper()
+	public, private, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	return Key{ID: id, Private: private, Public: public}
+}
+
+func newKeySet(t *testing.T, activeID string, keys ...Key) *KeySet {
+	t.Helper()
+	set, err := NewKeySet(activeID, keys)
+	require.NoError(t, err)
+	return set
+}
+
+func asymmetricConfig(keys *KeySet) Config {
+	cfg := testConfig
+	cfg.Keys = keys
+	return cfg
+}
+
+func TestToken_Asymmetric_GenerateAndParse(t *testing.T) {
+	tests := []struct {
+		name string
+		key  Key
+		alg  string
+	}{
+		{name: "RS256", key: newRSAKey(t, "rsa-1"), alg: "RS256"},
+		{name: "EdDSA", key: newEd25519Key(t, "ed-1"), alg: "EdDSA"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tk := NewToken("", asymmetricConfig(newKeySet(t, "", tt.key)), slog.Default())
+
+			tokenStr, err := tk.GenerateToken("user123", "employee")
+			require.NoError(t, err)
+
+			header, _, err := jwtv5.NewParser().ParseUnverified(tokenStr, jwtv5.MapClaims{})
+			require.NoError(t, err)
+			assert.Equal(t, tt.key.ID, header.Header["kid"])
+			assert.Equal(t, tt.alg, header.Header["alg"])
+
+			claims, err := tk.Parse(tokenStr)
+			assert.NoError(t, err)
+			assert.Equal(t, "user123", claims["user_id"])
+		})
+	}
+}
+
+func TestToken_Asymmetric_Rotation(t *testing.T) {
+	oldKey := newRSAKey(t, "2025-04")
+	newKey := newEd25519Key(t, "2025-05")
+
+	tk := NewToken("", asymmetricConfig(newKeySet(t, "", oldKey)), slog.Default())
+	oldToken, err := tk.GenerateToken("user123", "employee")
+	require.NoError(t, err)
+
+	// Новый ключ становится активным, старый остается для проверки
+	require.NoError(t, tk.SetKeys(newKeySet(t, "", oldKey, newKey)))
+
+	newToken, err := tk.GenerateToken("user123", "employee")
+	require.NoError(t, err)
+	header, _, err := jwtv5.NewParser().ParseUnverified(newToken, jwtv5.MapClaims{})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-05", header.Header["kid"])
+
+	_, err = tk.Parse(oldToken)
+	assert.NoError(t, err)
+	_, err = tk.Parse(newToken)
+	assert.NoError(t, err)
+
+	// Старый ключ удален из набора - его токены больше не принимаются
+	require.NoError(t, tk.SetKeys(newKeySet(t, "", newKey)))
+	_, err = tk.Parse(oldToken)
+	assert.ErrorIs(t, err, ErrUnknownKeyID)
+}
+
+func TestToken_Asymmetric_Rejects(t *testing.T) {
+	key := newRSAKey(t, "rsa-1")
+	tk := NewToken("secret", asymmetricConfig(newKeySet(t, "", key)), slog.Default())
+
+	claims := jwtv5.MapClaims{
+		"user_id": "user123",
+		"role":    "employee",
+		"jti":     "jti-1",
+		"iss":     testConfig.Issuer,
+		"aud":     testConfig.Audience,
+		"iat":     time.Now().Unix(),
+		"exp":     time.Now().Add(time.Minute).Unix(),
+	}
+
+	t.Run("HS256 with shared secret", func(t *testing.T) {
+		tokenStr, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString([]byte("secret"))
+		require.NoError(t, err)
+
+		_, err = tk.Parse(tokenStr)
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown kid", func(t *testing.T) {
+		foreign := newRSAKey(t, "rsa-2")
+		jwtToken := jwtv5.NewWithClaims(jwtv5.SigningMethodRS256, claims)
+		jwtToken.Header["kid"] = "rsa-2"
+		tokenStr, err := jwtToken.SignedString(foreign.Private)
+		require.NoError(t, err)
+
+		_, err = tk.Parse(tokenStr)
+		assert.ErrorIs(t, err, ErrUnknownKeyID)
+	})
+
+	t.Run("foreign key with known kid", func(t *testing.T) {
+		foreign := newRSAKey(t, "rsa-1")
+		jwtToken := jwtv5.NewWithClaims(jwtv5.SigningMethodRS256, claims)
+		jwtToken.Header["kid"] = "rsa-1"
+		tokenStr, err := jwtToken.SignedString(foreign.Private)
+		require.NoError(t, err)
+
+		_, err = tk.Parse(tokenStr)
+		assert.Error(t, err)
+	})
+
+	t.Run("alg does not match key", func(t *testing.T) {
+		edKey := newEd25519Key(t, "rsa-1")
+		jwtToken := jwtv5.NewWithClaims(jwtv5.SigningMethodEdDSA, claims)
+		jwtToken.Header["kid"] = "rsa-1"
+		tokenStr, err := jwtToken.SignedString(edKey.Private)
+		require.NoError(t, err)
+
+		_, err = tk.Parse(tokenStr)
+		assert.Error(t, err)
+	})
+}
+
+func TestToken_SetKeys_RequiresAsymmetricMode(t *testing.T) {
+	tk := NewToken("secret", testConfig, slog.Default())
+	assert.Error(t, tk.SetKeys(newKeySet(t, "", newRSAKey(t, "rsa-1"))))
+	assert.Empty(t, tk.JWKS().Keys)
+}
+
+func TestToken_JWKS(t *testing.T) {
+	rsaKey := newRSAKey(t, "a-rsa")
+	edKey := newEd25519Key(t, "b-ed")
+	retired := Key{ID: "0-retired", Public: newRSAKey(t, "").Public}
+
+	tk := NewToken("", asymmetricConfig(newKeySet(t, "a-rsa", rsaKey, edKey, retired)), slog.Default())
+
+	jwks := tk.JWKS()
+	require.Len(t, jwks.Keys, 3)
+
+	assert.Equal(t, "0-retired", jwks.Keys[0].Kid)
+
+	assert.Equal(t, "a-rsa", jwks.Keys[1].Kid)
+	assert.Equal(t, "RSA", jwks.Keys[1].Kty)
+	assert.Equal(t, "RS256", jwks.Keys[1].Alg)
+	assert.Equal(t, "AQAB", jwks.Keys[1].E)
+	assert.NotEmpty(t, jwks.Keys[1].N)
+
+	assert.Equal(t, "b-ed", jwks.Keys[2].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[2].Kty)
+	assert.Equal(t, "Ed25519", jwks.Keys[2].Crv)
+	assert.Equal(t, "EdDSA", jwks.Keys[2].Alg)
+	assert.NotEmpty(t, jwks.Keys[2].X)
+}
+
+func TestNewKeySet(t *testing.T) {
+	retired := Key{ID: "2025-06", Public: newRSAKey(t, "").Public}
+	first := newRSAKey(t, "2025-04")
+	second := newEd25519Key(t, "2025-05")
+
+	set, err := NewKeySet("", []Key{first, retired, second})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-05", set.ActiveID(), "без приватной части ключ не может быть активным")
+
+	set, err = NewKeySet("2025-04", []Key{first, second})
+	require.NoError(t, err)
+	assert.Equal(t, "2025-04", set.ActiveID())
+
+	_, err = NewKeySet("2025-06", []Key{first, retired})
+	assert.ErrorIs(t, err, ErrNoSigningKey)
+
+	_, err = NewKeySet("", []Key{first, first})
+	assert.Error(t, err)
+
+	_, err = NewKeySet("", nil)
+	assert.Error(t, err)
+}
+
+func TestLoadKeyDir(t *testing.T) {
+	dir := t.TempDir()
+
+	rsaKey := newRSAKey(t, "")
+	rsaDER, err := x509.MarshalPKCS8PrivateKey(rsaKey.Private)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "2025-04.pem"), "PRIVATE KEY", rsaDER)
+
+	edKey := newEd25519Key(t, "")
+	edDER, err := x509.MarshalPKIXPublicKey(edKey.Public)
+	require.NoError(t, err)
+	writePEM(t, filepath.Join(dir, "2025-05.pem"), "PUBLIC KEY", edDER)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.txt"), []byte("ignored"), 0o600))
+
+	keys, err := LoadKeyDir(dir)
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+
+	assert.Equal(t, "2025-04", keys[0].ID)
+	assert.Equal(t, "RS256", keys[0].Algorithm())
+	assert.NotNil(t, keys[0].Private)
+
+	assert.Equal(t, "2025-05", keys[1].ID)
+	assert.Equal(t, "EdDSA", keys[1].Algorithm())
+	assert.Nil(t, keys[1].Private)
+
+	set, err := NewKeySet("", keys)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-04", set.ActiveID())
+}
+
+func TestParseKeyPEM_Rejects(t *testing.T) {
+	_, err := ParseKeyPEM("k", []byte("not a pem"))
+	assert.Error(t, err)
+
+	weak, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+	_, err = ParseKeyPEM("k", pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(weak)}))
+	assert.Error(t, err)
+}
+
+func writePEM(t *testing.T, path string, blockType string, der []byte) {
+	t.Helper()
+	data := pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
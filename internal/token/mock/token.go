@@ -10,8 +10,10 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
+	token "github.com/Ranik23/avito-tech-spring/internal/token"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -39,6 +41,21 @@ func (m *MockToken) EXPECT() *MockTokenMockRecorder {
 	return m.recorder
 }
 
+// GenerateDummyToken mocks base method.
+func (m *MockToken) GenerateDummyToken(userID, role string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateDummyToken", userID, role)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateDummyToken indicates an expected call of GenerateDummyToken.
+func (mr *MockTokenMockRecorder) GenerateDummyToken(userID, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateDummyToken", reflect.TypeOf((*MockToken)(nil).GenerateDummyToken), userID, role)
+}
+
 // GenerateToken mocks base method.
 func (m *MockToken) GenerateToken(userID, role string) (string, error) {
 	m.ctrl.T.Helper()
@@ -54,17 +71,123 @@ func (mr *MockTokenMockRecorder) GenerateToken(userID, role any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateToken", reflect.TypeOf((*MockToken)(nil).GenerateToken), userID, role)
 }
 
+// JWKS mocks base method.
+func (m *MockToken) JWKS() token.JWKS {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JWKS")
+	ret0, _ := ret[0].(token.JWKS)
+	return ret0
+}
+
+// JWKS indicates an expected call of JWKS.
+func (mr *MockTokenMockRecorder) JWKS() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JWKS", reflect.TypeOf((*MockToken)(nil).JWKS))
+}
+
 // Parse mocks base method.
-func (m *MockToken) Parse(token string) (map[string]any, error) {
+func (m *MockToken) Parse(arg0 string) (map[string]any, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Parse", token)
+	ret := m.ctrl.Call(m, "Parse", arg0)
 	ret0, _ := ret[0].(map[string]any)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Parse indicates an expected call of Parse.
-func (mr *MockTokenMockRecorder) Parse(token any) *gomock.Call {
+func (mr *MockTokenMockRecorder) Parse(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockToken)(nil).Parse), arg0)
+}
+
+// SetKeys mocks base method.
+func (m *MockToken) SetKeys(keys *token.KeySet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetKeys", keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetKeys indicates an expected call of SetKeys.
+func (mr *MockTokenMockRecorder) SetKeys(keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetKeys", reflect.TypeOf((*MockToken)(nil).SetKeys), keys)
+}
+
+// MockRevocationList is a mock of RevocationList interface.
+type MockRevocationList struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevocationListMockRecorder
+	isgomock struct{}
+}
+
+// MockRevocationListMockRecorder is the mock recorder for MockRevocationList.
+type MockRevocationListMockRecorder struct {
+	mock *MockRevocationList
+}
+
+// NewMockRevocationList creates a new mock instance.
+func NewMockRevocationList(ctrl *gomock.Controller) *MockRevocationList {
+	mock := &MockRevocationList{ctrl: ctrl}
+	mock.recorder = &MockRevocationListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevocationList) EXPECT() *MockRevocationListMockRecorder {
+	return m.recorder
+}
+
+// IsAccessTokenRevoked mocks base method.
+func (m *MockRevocationList) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccessTokenRevoked", ctx, jti)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAccessTokenRevoked indicates an expected call of IsAccessTokenRevoked.
+func (mr *MockRevocationListMockRecorder) IsAccessTokenRevoked(ctx, jti any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccessTokenRevoked", reflect.TypeOf((*MockRevocationList)(nil).IsAccessTokenRevoked), ctx, jti)
+}
+
+// MockUserStatus is a mock of UserStatus interface.
+type MockUserStatus struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserStatusMockRecorder
+	isgomock struct{}
+}
+
+// MockUserStatusMockRecorder is the mock recorder for MockUserStatus.
+type MockUserStatusMockRecorder struct {
+	mock *MockUserStatus
+}
+
+// NewMockUserStatus creates a new mock instance.
+func NewMockUserStatus(ctrl *gomock.Controller) *MockUserStatus {
+	mock := &MockUserStatus{ctrl: ctrl}
+	mock.recorder = &MockUserStatusMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserStatus) EXPECT() *MockUserStatusMockRecorder {
+	return m.recorder
+}
+
+// IsUserDisabled mocks base method.
+func (m *MockUserStatus) IsUserDisabled(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserDisabled", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserDisabled indicates an expected call of IsUserDisabled.
+func (mr *MockUserStatusMockRecorder) IsUserDisabled(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Parse", reflect.TypeOf((*MockToken)(nil).Parse), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserDisabled", reflect.TypeOf((*MockUserStatus)(nil).IsUserDisabled), ctx, userID)
 }
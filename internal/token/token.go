@@ -1,37 +1,142 @@
 package token
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"log/slog"
+	"sync/atomic"
+	"time"
+
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
+// DefaultAccessTTL используется, если в конфиге не задано время жизни access токена.
+const DefaultAccessTTL = 15 * time.Minute
+
+// DummyClaim помечает токены, выпущенные DummyLogin. В строгом режиме такие токены отклоняются.
+const DummyClaim = "dummy"
+
 type Token interface {
 	GenerateToken(userID string, role string) (string, error)
+	// GenerateDummyToken выпускает токен с claim DummyClaim, чтобы его можно было отличить от настоящего.
+	GenerateDummyToken(userID string, role string) (string, error)
 	Parse(token string) (map[string]interface{}, error)
+	// JWKS возвращает публичные ключи для проверки токенов сторонними сервисами. В режиме HS256 набор пуст.
+	JWKS() JWKS
+	// SetKeys заменяет набор ключей без перезапуска, так выполняется ротация.
+	SetKeys(keys *KeySet) error
+}
+
+// RevocationList отвечает, отозван ли access токен с данным jti. Проверяется на каждом запросе.
+type RevocationList interface {
+	IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error)
+}
+
+// UserStatus отвечает, отключена или удалена учетная запись, на которую выдан токен. Проверяется на каждом запросе,
+// поэтому отключение действует сразу, не дожидаясь истечения access токена.
+type UserStatus interface {
+	IsUserDisabled(ctx context.Context, userID string) (bool, error)
+}
+
+// Config задает claims, которые проставляются при выпуске и проверяются при разборе токена.
+// Пустые Issuer и Audience не выставляются и не проверяются.
+// Если Keys задан, токены подписываются асимметрично (RS256/EdDSA) с kid, а общий секрет не используется.
+type Config struct {
+	Issuer    string
+	Audience  string
+	AccessTTL time.Duration
+	Keys      *KeySet
 }
 
 type token struct {
 	logger *slog.Logger
 	secret []byte
+	cfg    Config
+	parser *jwt.Parser
+	keys   atomic.Pointer[KeySet]
 }
 
-func NewToken(secret string, logger *slog.Logger) Token {
-	return &token{
+func NewToken(secret string, cfg Config, logger *slog.Logger) Token {
+	if cfg.AccessTTL <= 0 {
+		cfg.AccessTTL = DefaultAccessTTL
+	}
+
+	validMethods := []string{jwt.SigningMethodHS256.Alg()}
+	if cfg.Keys != nil {
+		validMethods = []string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}
+	}
+
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(validMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+
+	t := &token{
 		secret: []byte(secret),
+		cfg:    cfg,
+		parser: jwt.NewParser(opts...),
 		logger: logger,
 	}
+	t.keys.Store(cfg.Keys)
+
+	return t
 }
 
 func (j *token) GenerateToken(userID string, role string) (string, error) {
-	j.logger.Info("Generating token", slog.String("user_id", userID), slog.String("role", role))
+	return j.generate(userID, role, false)
+}
+
+func (j *token) GenerateDummyToken(userID string, role string) (string, error) {
+	return j.generate(userID, role, true)
+}
+
+func (j *token) generate(userID string, role string, dummy bool) (string, error) {
+	j.logger.Info("Generating token", slog.String("user_id", userID), slog.String("role", role), slog.Bool("dummy", dummy))
 
+	now := time.Now()
 	claims := jwt.MapClaims{
 		"user_id": userID,
 		"role":    role,
+		"sub":     userID,
+		"jti":     uuid.NewString(),
+		"iat":     now.Unix(),
+		"nbf":     now.Unix(),
+		"exp":     now.Add(j.cfg.AccessTTL).Unix(),
+	}
+	if j.cfg.Issuer != "" {
+		claims["iss"] = j.cfg.Issuer
+	}
+	if j.cfg.Audience != "" {
+		claims["aud"] = j.cfg.Audience
+	}
+	if dummy {
+		claims[DummyClaim] = true
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString(j.secret)
+	var (
+		tokenString string
+		err         error
+	)
+	if keys := j.keys.Load(); keys != nil {
+		token := jwt.NewWithClaims(keys.active.signingMethod(), claims)
+		token.Header["kid"] = keys.active.ID
+		tokenString, err = token.SignedString(keys.active.Private)
+	} else {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+		tokenString, err = token.SignedString(j.secret)
+	}
 
 	if err != nil {
 		j.logger.Error("Error generating token", slog.String("error", err.Error()))
@@ -43,15 +148,7 @@ func (j *token) GenerateToken(userID string, role string) (string, error) {
 }
 
 func (j *token) Parse(tokenString string) (map[string]interface{}, error) {
-	j.logger.Info("Parsing token", "token", tokenString)
-
-	parsedToken, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			j.logger.Error("Invalid signature method", slog.String("method", token.Method.Alg()))
-			return nil, jwt.ErrSignatureInvalid
-		}
-		return j.secret, nil
-	})
+	parsedToken, err := j.parser.Parse(tokenString, j.keyFunc)
 
 	if err != nil {
 		j.logger.Error("Error parsing token", "error", err)
@@ -59,10 +156,101 @@ func (j *token) Parse(tokenString string) (map[string]interface{}, error) {
 	}
 
 	if claims, ok := parsedToken.Claims.(jwt.MapClaims); ok && parsedToken.Valid {
-		j.logger.Info("Token parsed successfully", slog.String("user_id", claims["user_id"].(string)))
+		userID, _ := claims["user_id"].(string)
+		j.logger.Info("Token parsed successfully", slog.String("user_id", userID))
 		return claims, nil
 	}
 
 	j.logger.Error("Invalid token signature")
 	return nil, jwt.ErrSignatureInvalid
 }
+
+// keyFunc выбирает ключ проверки по kid из заголовка. Алгоритм токена должен совпадать с алгоритмом ключа,
+// иначе токен с подменой alg мог бы пройти проверку чужим ключом.
+func (j *token) keyFunc(token *jwt.Token) (interface{}, error) {
+	keys := j.keys.Load()
+	if keys == nil {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			j.logger.Error("Invalid signature method", slog.String("method", token.Method.Alg()))
+			return nil, jwt.ErrSignatureInvalid
+		}
+		return j.secret, nil
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, ok := keys.lookup(kid)
+	if !ok {
+		j.logger.Error("Unknown key id", slog.String("kid", kid))
+		return nil, ErrUnknownKeyID
+	}
+	if token.Method.Alg() != key.Algorithm() {
+		j.logger.Error("Signature method does not match key",
+			slog.String("method", token.Method.Alg()), slog.String("kid", kid))
+		return nil, jwt.ErrSignatureInvalid
+	}
+
+	return key.Public, nil
+}
+
+func (j *token) JWKS() JWKS {
+	keys := j.keys.Load()
+	if keys == nil {
+		return JWKS{Keys: []JWK{}}
+	}
+	return keys.jwks()
+}
+
+func (j *token) SetKeys(keys *KeySet) error {
+	if keys == nil || j.keys.Load() == nil {
+		return errors.New("key rotation requires asymmetric signing")
+	}
+
+	j.keys.Store(keys)
+	j.logger.Info("Signing keys updated", slog.String("active_kid", keys.ActiveID()))
+	return nil
+}
+
+// TokenID возвращает jti из claims, разобранных Parse.
+func TokenID(claims map[string]interface{}) (string, bool) {
+	jti, ok := claims["jti"].(string)
+	return jti, ok && jti != ""
+}
+
+// IsDummy сообщает, выпущен ли токен через DummyLogin.
+func IsDummy(claims map[string]interface{}) bool {
+	dummy, _ := claims[DummyClaim].(bool)
+	return dummy
+}
+
+// ExpiresAt возвращает exp из claims, разобранных Parse. Числа в MapClaims приходят как float64.
+func ExpiresAt(claims map[string]interface{}) (time.Time, bool) {
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(exp), 0), true
+}
+
+// NewRefreshToken генерирует непрозрачный refresh токен. На сервере хранится только HashRefreshToken от него.
+func NewRefreshToken() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func HashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
+}
+
+// NewResetToken генерирует одноразовый токен сброса пароля. Формат тот же, что у refresh токена,
+// на сервере тоже хранится только хеш.
+func NewResetToken() (string, error) {
+	return NewRefreshToken()
+}
+
+func HashResetToken(resetToken string) string {
+	return HashRefreshToken(resetToken)
+}
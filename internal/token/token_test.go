@@ -8,12 +8,19 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 )
 
+var testConfig = Config{
+	Issuer:    "avito-tech-spring",
+	Audience:  "pvz-api",
+	AccessTTL: time.Minute,
+}
+
 func TestToken_GenerateAndParse_Success(t *testing.T) {
 	secret := "mysecret"
-	tk := NewToken(secret, slog.Default())
+	tk := NewToken(secret, testConfig, slog.Default())
 
 	userID := "user123"
 	role := "Client"
@@ -27,14 +34,110 @@ func TestToken_GenerateAndParse_Success(t *testing.T) {
 
 	assert.Equal(t, userID, claims["user_id"])
 	assert.Equal(t, role, claims["role"])
+	assert.Equal(t, "avito-tech-spring", claims["iss"])
+
+	jti, ok := TokenID(claims)
+	assert.True(t, ok)
+	assert.NotEmpty(t, jti)
+
+	expiresAt, ok := ExpiresAt(claims)
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), expiresAt, 2*time.Second)
+}
+
+func TestToken_GenerateDummyToken(t *testing.T) {
+	tk := NewToken("mysecret", testConfig, slog.Default())
+
+	dummyToken, err := tk.GenerateDummyToken("dummyRandomID", "employee")
+	assert.NoError(t, err)
+	realToken, err := tk.GenerateToken("user123", "employee")
+	assert.NoError(t, err)
+
+	dummyClaims, err := tk.Parse(dummyToken)
+	assert.NoError(t, err)
+	realClaims, err := tk.Parse(realToken)
+	assert.NoError(t, err)
+
+	assert.True(t, IsDummy(dummyClaims))
+	assert.False(t, IsDummy(realClaims))
+}
+
+func TestToken_GenerateToken_UniqueJTI(t *testing.T) {
+	tk := NewToken("mysecret", testConfig, slog.Default())
+
+	first, err := tk.GenerateToken("user123", "employee")
+	assert.NoError(t, err)
+	second, err := tk.GenerateToken("user123", "employee")
+	assert.NoError(t, err)
+
+	firstClaims, err := tk.Parse(first)
+	assert.NoError(t, err)
+	secondClaims, err := tk.Parse(second)
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, firstClaims["jti"], secondClaims["jti"])
+}
+
+func TestToken_Parse_RejectsClaims(t *testing.T) {
+	secret := "mysecret"
+	tk := NewToken(secret, testConfig, slog.Default())
+
+	valid := func() jwtv5.MapClaims {
+		return jwtv5.MapClaims{
+			"user_id": "user123",
+			"role":    "employee",
+			"jti":     "jti-1",
+			"iss":     testConfig.Issuer,
+			"aud":     testConfig.Audience,
+			"iat":     time.Now().Unix(),
+			"exp":     time.Now().Add(time.Minute).Unix(),
+		}
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(jwtv5.MapClaims)
+	}{
+		{name: "expired", mutate: func(c jwtv5.MapClaims) { c["exp"] = time.Now().Add(-time.Minute).Unix() }},
+		{name: "no exp", mutate: func(c jwtv5.MapClaims) { delete(c, "exp") }},
+		{name: "wrong issuer", mutate: func(c jwtv5.MapClaims) { c["iss"] = "someone-else" }},
+		{name: "wrong audience", mutate: func(c jwtv5.MapClaims) { c["aud"] = "other-api" }},
+		{name: "issued in future", mutate: func(c jwtv5.MapClaims) { c["iat"] = time.Now().Add(time.Hour).Unix() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims := valid()
+			tt.mutate(claims)
+
+			tokenStr, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString([]byte(secret))
+			assert.NoError(t, err)
+
+			parsed, err := tk.Parse(tokenStr)
+			assert.Error(t, err)
+			assert.Nil(t, parsed)
+		})
+	}
+}
+
+func TestToken_RefreshToken(t *testing.T) {
+	first, err := NewRefreshToken()
+	assert.NoError(t, err)
+	second, err := NewRefreshToken()
+	assert.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+	assert.Len(t, HashRefreshToken(first), 64)
+	assert.Equal(t, HashRefreshToken(first), HashRefreshToken(first))
+	assert.NotEqual(t, HashRefreshToken(first), HashRefreshToken(second))
 }
 
 func TestToken_Parse_InvalidSignature(t *testing.T) {
 	secret := "correct-secret"
 	wrongSecret := "wrong-secret"
-	tk := NewToken(secret, slog.Default())
+	tk := NewToken(secret, testConfig, slog.Default())
 
-	foreign := NewToken(wrongSecret, slog.Default())
+	foreign := NewToken(wrongSecret, testConfig, slog.Default())
 	tokenStr, err := foreign.GenerateToken("user123", "Client")
 	assert.NoError(t, err)
 
@@ -55,7 +158,7 @@ func TestToken_Parse_InvalidMethod(t *testing.T) {
 	tokenStr, err := jwtToken.SignedString(jwt.UnsafeAllowNoneSignatureType)
 	assert.NoError(t, err)
 
-	tk := NewToken(secret, slog.Default())
+	tk := NewToken(secret, testConfig, slog.Default())
 	parsedClaims, err := tk.Parse(tokenStr)
 	assert.Error(t, err)
 	assert.Nil(t, parsedClaims)
@@ -63,7 +166,7 @@ func TestToken_Parse_InvalidMethod(t *testing.T) {
 
 func TestToken_Parse_MalformedToken(t *testing.T) {
 	secret := "mysecret"
-	tk := NewToken(secret, slog.Default())
+	tk := NewToken(secret, testConfig, slog.Default())
 
 	malformed := "this.is.not.a.jwt"
 
@@ -73,7 +176,7 @@ func TestToken_Parse_MalformedToken(t *testing.T) {
 }
 
 func TestToken_Parse_EmptyToken(t *testing.T) {
-	tk := NewToken("secret", slog.Default())
+	tk := NewToken("secret", testConfig, slog.Default())
 
 	claims, err := tk.Parse("")
 	assert.Error(t, err)
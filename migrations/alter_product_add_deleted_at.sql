@@ -0,0 +1,13 @@
+-- alter_product_add_deleted_at.sql
+--liquibase formatted sql
+
+
+--changeset anton:alter-product-add-deleted-at
+ALTER TABLE product
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+DROP INDEX product_barcode_reception_uidx;
+
+CREATE UNIQUE INDEX product_barcode_reception_uidx ON product (barcode, reception_id) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX product_reception_active_idx ON product (reception_id, id) WHERE deleted_at IS NULL;
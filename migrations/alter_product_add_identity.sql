@@ -0,0 +1,10 @@
+-- alter_product_add_identity.sql
+--liquibase formatted sql
+
+
+--changeset anton:alter-product-add-identity
+ALTER TABLE product
+    ADD COLUMN barcode VARCHAR(64),
+    ADD COLUMN order_id VARCHAR(64);
+
+CREATE UNIQUE INDEX product_barcode_reception_uidx ON product (barcode, reception_id) WHERE barcode IS NOT NULL;
@@ -0,0 +1,8 @@
+-- alter_users_add_status.sql
+--liquibase formatted sql
+
+
+--changeset anton:alter-users-add-status
+ALTER TABLE users
+    ADD COLUMN disabled BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
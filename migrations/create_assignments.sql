@@ -0,0 +1,13 @@
+-- assignments.sql
+--liquibase formatted sql
+
+
+--changeset anton:create-assignments
+CREATE TABLE pvz_assignment (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    pvz_id INTEGER NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, pvz_id)
+);
+
+CREATE INDEX pvz_assignment_pvz_idx ON pvz_assignment (pvz_id);
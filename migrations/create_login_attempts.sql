@@ -0,0 +1,13 @@
+-- login_attempts.sql
+--liquibase formatted sql
+
+
+--changeset anton:create-login-attempts
+CREATE TABLE login_attempt (
+    key VARCHAR(320) PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ
+);
+
+CREATE INDEX login_attempt_last_failure_idx ON login_attempt (last_failure_at);
@@ -0,0 +1,18 @@
+-- outbox.sql
+--liquibase formatted sql
+
+
+--changeset anton:create-outbox
+CREATE TABLE outbox (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    event_type VARCHAR(50) NOT NULL,
+    pvz_id INTEGER NOT NULL,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_error TEXT
+);
+
+CREATE INDEX outbox_pending_idx ON outbox (id) WHERE processed_at IS NULL;
@@ -0,0 +1,15 @@
+-- password_resets.sql
+--liquibase formatted sql
+
+
+--changeset anton:create-password-resets
+CREATE TABLE password_reset_token (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX password_reset_token_user_idx ON password_reset_token (user_id) WHERE used_at IS NULL;
@@ -0,0 +1,17 @@
+-- product_types.sql
+--liquibase formatted sql
+
+
+--changeset anton:create-product-types
+CREATE TABLE product_type (
+    name VARCHAR(50) PRIMARY KEY,
+    fragile BOOLEAN NOT NULL DEFAULT FALSE,
+    max_weight_grams INTEGER NOT NULL DEFAULT 0 CHECK (max_weight_grams >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO product_type (name, fragile) VALUES
+    ('электроника', TRUE),
+    ('одежда', FALSE),
+    ('обувь', FALSE);
@@ -0,0 +1,26 @@
+-- create_reception_open_unique_index.sql
+--liquibase formatted sql
+
+
+--changeset anton:close-duplicate-open-receptions
+-- до уникального индекса в ПВЗ могло оказаться несколько незавершенных приемок: оставляем самую новую,
+-- остальные закрываем и записываем переход в историю
+WITH ranked AS (
+    SELECT id, status,
+           ROW_NUMBER() OVER (PARTITION BY pvz_id ORDER BY date_time DESC, id DESC) AS rn
+    FROM reception
+    WHERE status IN ('in_progress', 'paused')
+),
+closed AS (
+    UPDATE reception r
+    SET status = 'closed'
+    FROM ranked
+    WHERE r.id = ranked.id AND ranked.rn > 1
+    RETURNING r.id, ranked.status AS from_status
+)
+INSERT INTO reception_status_history (reception_id, from_status, to_status, action, reason)
+SELECT id, from_status, 'closed', 'close', 'duplicate open reception closed by migration'
+FROM closed;
+
+--changeset anton:create-reception-open-unique-index runInTransaction:false
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS reception_pvz_open_uidx ON reception (pvz_id) WHERE status IN ('in_progress', 'paused');
@@ -0,0 +1,25 @@
+-- reception_status_history.sql
+--liquibase formatted sql
+
+
+--changeset anton:create-reception-status-history
+ALTER TABLE reception DROP CONSTRAINT reception_status_check;
+
+UPDATE reception SET status = 'in_progress' WHERE status = 'open';
+
+ALTER TABLE reception
+    ADD CONSTRAINT reception_status_check CHECK (status IN ('in_progress', 'paused', 'closed', 'cancelled'));
+
+CREATE TABLE reception_status_history (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    reception_id INTEGER NOT NULL REFERENCES reception(id) ON DELETE CASCADE,
+    from_status VARCHAR(20),
+    to_status VARCHAR(20) NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    reason VARCHAR(255),
+    user_id VARCHAR(64),
+    role VARCHAR(50),
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX reception_status_history_reception_idx ON reception_status_history (reception_id, id);
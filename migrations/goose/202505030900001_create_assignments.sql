@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pvz_assignment (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    pvz_id INTEGER NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, pvz_id)
+);
+
+CREATE INDEX pvz_assignment_pvz_idx ON pvz_assignment (pvz_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pvz_assignment;
+-- +goose StatementEnd
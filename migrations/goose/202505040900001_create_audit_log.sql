@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_log (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id VARCHAR(64),
+    role VARCHAR(50),
+    dummy BOOLEAN NOT NULL DEFAULT FALSE,
+    action VARCHAR(50) NOT NULL,
+    pvz_id INTEGER NOT NULL,
+    reception_id INTEGER,
+    product_id INTEGER,
+    target_user_id INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX audit_log_user_idx ON audit_log (user_id, id);
+CREATE INDEX audit_log_pvz_idx ON audit_log (pvz_id, id);
+CREATE INDEX audit_log_created_at_idx ON audit_log (created_at);
+
+-- журнал только на добавление: UPDATE и DELETE запрещены на уровне БД
+CREATE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_append_only
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+-- +goose StatementEnd
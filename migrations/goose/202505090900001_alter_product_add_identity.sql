@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE product
+    ADD COLUMN barcode VARCHAR(64),
+    ADD COLUMN order_id VARCHAR(64);
+
+CREATE UNIQUE INDEX product_barcode_reception_uidx ON product (barcode, reception_id) WHERE barcode IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS product_barcode_reception_uidx;
+
+ALTER TABLE product
+    DROP COLUMN IF EXISTS order_id,
+    DROP COLUMN IF EXISTS barcode;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE product
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+DROP INDEX product_barcode_reception_uidx;
+
+CREATE UNIQUE INDEX product_barcode_reception_uidx ON product (barcode, reception_id) WHERE barcode IS NOT NULL AND deleted_at IS NULL;
+
+CREATE INDEX product_reception_active_idx ON product (reception_id, id) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM product WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS product_reception_active_idx;
+
+DROP INDEX IF EXISTS product_barcode_reception_uidx;
+
+CREATE UNIQUE INDEX product_barcode_reception_uidx ON product (barcode, reception_id) WHERE barcode IS NOT NULL;
+
+ALTER TABLE product
+    DROP COLUMN IF EXISTS deleted_at;
+-- +goose StatementEnd
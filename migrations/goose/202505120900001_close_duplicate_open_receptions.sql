@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- до уникального индекса в ПВЗ могло оказаться несколько незавершенных приемок: оставляем самую новую,
+-- остальные закрываем и записываем переход в историю
+WITH ranked AS (
+    SELECT id, status,
+           ROW_NUMBER() OVER (PARTITION BY pvz_id ORDER BY date_time DESC, id DESC) AS rn
+    FROM reception
+    WHERE status IN ('in_progress', 'paused')
+),
+closed AS (
+    UPDATE reception r
+    SET status = 'closed'
+    FROM ranked
+    WHERE r.id = ranked.id AND ranked.rn > 1
+    RETURNING r.id, ranked.status AS from_status
+)
+INSERT INTO reception_status_history (reception_id, from_status, to_status, action, reason)
+SELECT id, from_status, 'closed', 'close', 'duplicate open reception closed by migration'
+FROM closed;
+-- +goose StatementEnd
+
+-- +goose Down
+-- закрытые приемки не возвращаем: какая из них была бы открыта, уже не определить
@@ -0,0 +1,6 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+CREATE UNIQUE INDEX CONCURRENTLY IF NOT EXISTS reception_pvz_open_uidx ON reception (pvz_id) WHERE status IN ('in_progress', 'paused');
+
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS reception_pvz_open_uidx;
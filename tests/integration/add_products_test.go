@@ -0,0 +1,66 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+)
+
+func (s *TestSuite) TestAddProductsBatch() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	reception, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	items := []domain.ProductItem{
+		{Type: "обувь", Barcode: "4601234567890", OrderID: "ORD-1"},
+		{Type: "одежда"},
+		{Type: "электроника", Barcode: "4601234567891"},
+	}
+
+	products, err := s.service.AddProducts(ctx, pvz.ID, items)
+	s.Require().NoError(err)
+	s.Require().Len(products, len(items))
+
+	for i, product := range products {
+		s.Require().Equal(items[i].Type, product.Type)
+		s.Require().Equal(items[i].Barcode, product.Barcode)
+		s.Require().Equal(items[i].OrderID, product.OrderID)
+		s.Require().Equal(reception.ID, product.ReceptionID)
+	}
+
+	stored, err := s.productRepo.GetProducts(ctx, reception.ID)
+	s.Require().NoError(err)
+	s.Require().Len(stored, len(items))
+}
+
+func (s *TestSuite) TestAddProductsBatchAllOrNothing() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	// Типа последнего товара нет в справочнике, вся пачка должна быть отклонена.
+	_, err = s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{{Type: "обувь"}, {Type: "одежда"}, {Type: strings.Repeat("x", 51)}})
+	s.Require().ErrorIs(err, service.ErrInvalidProductType)
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	var count int
+	err = db.QueryRow(`SELECT COUNT(*) FROM product`).Scan(&count)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+}
@@ -0,0 +1,66 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+)
+
+func (s *TestSuite) TestEmployeeScopedToAssignedPVZ() {
+	ctx := context.Background()
+
+	assigned, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+	other, err := s.service.CreatePVZ(ctx, "Kazan")
+	s.Require().NoError(err)
+
+	userID, err := s.service.Register(ctx, "employee@mail.ru", "Password1", "employee")
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.service.AssignEmployee(ctx, assigned.ID, userID))
+	// повторное назначение не ошибка
+	s.Require().NoError(s.service.AssignEmployee(ctx, assigned.ID, userID))
+
+	employeeCtx := rbac.WithActor(ctx, rbac.Actor{UserID: userID, Role: "employee"})
+
+	_, err = s.service.StartReception(employeeCtx, assigned.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(employeeCtx, other.ID)
+	s.Require().ErrorIs(err, service.ErrPVZAccessDenied)
+
+	info, err := s.service.GetPVZSInfo(employeeCtx, domain.PvzInfoQuery{Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(1, info.Total)
+	s.Require().Len(info.Items, 1)
+	s.Require().Equal(assigned.ID, info.Items[0].Pvz.ID)
+
+	// модератор с pvz:any видит все ПВЗ
+	moderatorCtx := rbac.WithActor(ctx, rbac.Actor{UserID: "0", Role: "moderator"})
+	info, err = s.service.GetPVZSInfo(moderatorCtx, domain.PvzInfoQuery{Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(2, info.Total)
+
+	s.Require().NoError(s.service.UnassignEmployee(ctx, assigned.ID, userID))
+	s.Require().ErrorIs(s.service.UnassignEmployee(ctx, assigned.ID, userID), service.ErrNotFound)
+
+	_, err = s.service.CloseReception(employeeCtx, assigned.ID)
+	s.Require().ErrorIs(err, service.ErrPVZAccessDenied)
+}
+
+func (s *TestSuite) TestAssignEmployeeUnknownUser() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	err = s.service.AssignEmployee(ctx, pvz.ID, "100500")
+	s.Require().ErrorIs(err, service.ErrUserNotFound)
+
+	err = s.service.AssignEmployee(ctx, "100500", "1")
+	s.Require().ErrorIs(err, service.ErrNoPVZFound)
+}
@@ -0,0 +1,79 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+)
+
+func (s *TestSuite) TestAuditTrail() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	employeeCtx := rbac.WithActor(ctx, rbac.Actor{UserID: "dummyRandomID", Role: "employee", Dummy: true})
+
+	reception, err := s.service.StartReception(employeeCtx, pvz.ID)
+	s.Require().NoError(err)
+
+	product, err := s.service.AddProduct(employeeCtx, pvz.ID, domain.ProductItem{Type: "обувь"})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.service.DeleteLastProduct(employeeCtx, pvz.ID))
+
+	_, err = s.service.CloseReception(employeeCtx, pvz.ID)
+	s.Require().NoError(err)
+
+	page, err := s.service.GetAuditLog(ctx, domain.AuditQuery{UserID: "dummyRandomID", PvzIDs: []string{pvz.ID}, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 4)
+	s.Require().Empty(page.NextCursor)
+
+	// записи идут от новых к старым
+	s.Require().Equal(domain.AuditReceptionClosed, page.Items[0].Action)
+	s.Require().Equal(domain.AuditProductDeleted, page.Items[1].Action)
+	s.Require().Equal(product.ID, page.Items[1].ProductID)
+	s.Require().Equal(domain.AuditProductAdded, page.Items[2].Action)
+	s.Require().Equal(domain.AuditReceptionStarted, page.Items[3].Action)
+	s.Require().Equal(reception.ID, page.Items[3].ReceptionID)
+	s.Require().Equal("employee", page.Items[3].Role)
+	s.Require().True(page.Items[3].Dummy)
+
+	// создание ПВЗ без пользователя в контексте тоже попадает в журнал
+	page, err = s.service.GetAuditLog(ctx, domain.AuditQuery{PvzIDs: []string{pvz.ID}, Limit: 1})
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Require().NotEmpty(page.NextCursor)
+
+	var total int
+	for cursor := ""; ; {
+		page, err = s.service.GetAuditLog(ctx, domain.AuditQuery{PvzIDs: []string{pvz.ID}, Limit: 2, Cursor: cursor})
+		s.Require().NoError(err)
+		total += len(page.Items)
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	s.Require().Equal(5, total)
+}
+
+func (s *TestSuite) TestAuditLogIsAppendOnly() {
+	_, err := s.service.CreatePVZ(context.Background(), "Moscow")
+	s.Require().NoError(err)
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	_, err = db.Exec(`UPDATE audit_log SET action = 'forged'`)
+	s.Require().Error(err)
+
+	_, err = db.Exec(`DELETE FROM audit_log`)
+	s.Require().Error(err)
+}
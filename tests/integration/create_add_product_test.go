@@ -19,7 +19,7 @@ func (s *TestSuite) TestAddProduct() {
 	s.Require().NoError(err)
 	
 
-	_, err = s.service.AddProduct(context.Background(), pvz.ID, "box")
+	_, err = s.service.AddProduct(context.Background(), pvz.ID, domain.ProductItem{Type: "обувь"})
 	s.Require().NoError(err)
 
 
@@ -38,7 +38,7 @@ func (s *TestSuite) TestAddProduct() {
 
 
 	s.Require().Equal(reception.ID, product.ReceptionID)
-	s.Require().Equal("box", product.Type)
+	s.Require().Equal("обувь", product.Type)
 }
 
 
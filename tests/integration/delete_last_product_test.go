@@ -5,6 +5,8 @@ package integration
 import (
 	"context"
 	"database/sql"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
 )
 
 
@@ -19,7 +21,7 @@ func (s *TestSuite) TestDeleteLastProduct() {
 	_, err = s.service.StartReception(ctx, pvz.ID)
 	s.Require().NoError(err)
 
-	_, err = s.service.AddProduct(ctx, pvz.ID, "box")
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь"})
 	s.Require().NoError(err)
 
 	err = s.service.DeleteLastProduct(ctx, pvz.ID)
@@ -29,8 +31,10 @@ func (s *TestSuite) TestDeleteLastProduct() {
 	s.Require().NoError(err)
 	defer db.Close()
 
-	var count int
-	err = db.QueryRow(`SELECT COUNT(*) FROM product`).Scan(&count)
+	// удаление мягкое: строка остается с отметкой deleted_at
+	var count, deleted int
+	err = db.QueryRow(`SELECT COUNT(*), COUNT(deleted_at) FROM product`).Scan(&count, &deleted)
 	s.Require().NoError(err)
-	s.Require().Equal(0, count)
+	s.Require().Equal(1, count)
+	s.Require().Equal(1, deleted)
 }
\ No newline at end of file
@@ -0,0 +1,123 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+)
+
+
+func (s *TestSuite) TestDeleteLastProductIsLIFOByID() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	reception, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	// у товаров одной пачки одинаковое время приема, порядок задает id
+	products, err := s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{{Type: "обувь"}, {Type: "одежда"}, {Type: "электроника"}})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.service.DeleteLastProduct(ctx, pvz.ID))
+	s.Require().NoError(s.service.DeleteLastProduct(ctx, pvz.ID))
+
+	stored, err := s.productRepo.GetProducts(ctx, reception.ID)
+	s.Require().NoError(err)
+	s.Require().Len(stored, 1)
+	s.Require().Equal(products[0].ID, stored[0].ID)
+}
+
+
+func (s *TestSuite) TestDeleteAndRestoreProduct() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	reception, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	products, err := s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{
+		{Type: "обувь", Barcode: "4601234567890"},
+		{Type: "одежда"},
+	})
+	s.Require().NoError(err)
+
+	// удаляем не последний, а первый товар
+	s.Require().NoError(s.service.DeleteProduct(ctx, pvz.ID, products[0].ID))
+	s.Require().ErrorIs(s.service.DeleteProduct(ctx, pvz.ID, products[0].ID), service.ErrProductNotFound)
+
+	stored, err := s.productRepo.GetProducts(ctx, reception.ID)
+	s.Require().NoError(err)
+	s.Require().Len(stored, 1)
+	s.Require().Equal(products[1].ID, stored[0].ID)
+
+	found, err := s.service.FindProductsByBarcode(ctx, "4601234567890")
+	s.Require().NoError(err)
+	s.Require().Empty(found)
+
+	restored, err := s.service.RestoreProduct(ctx, pvz.ID, products[0].ID)
+	s.Require().NoError(err)
+	s.Require().Equal(products[0].ID, restored.ID)
+	s.Require().Nil(restored.DeletedAt)
+
+	_, err = s.service.RestoreProduct(ctx, pvz.ID, products[0].ID)
+	s.Require().ErrorIs(err, service.ErrProductNotDeleted)
+
+	// пока товар удален, его штрихкод можно отсканировать заново, и тогда восстановить старый нельзя
+	s.Require().NoError(s.service.DeleteProduct(ctx, pvz.ID, products[0].ID))
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь", Barcode: "4601234567890"})
+	s.Require().NoError(err)
+
+	_, err = s.service.RestoreProduct(ctx, pvz.ID, products[0].ID)
+	s.Require().ErrorIs(err, service.ErrDuplicateBarcode)
+
+	// после закрытия приемки товары не удаляются и не восстанавливаются
+	_, err = s.service.CloseReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	s.Require().ErrorIs(s.service.DeleteProduct(ctx, pvz.ID, products[1].ID), service.ErrProductNotFound)
+	_, err = s.service.RestoreProduct(ctx, pvz.ID, products[0].ID)
+	s.Require().ErrorIs(err, service.ErrProductNotFound)
+}
+
+func (s *TestSuite) TestProductTypeFilterSkipsDeletedProducts() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	products, err := s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{{Type: "обувь"}, {Type: "одежда"}})
+	s.Require().NoError(err)
+
+	query := domain.PvzInfoQuery{
+		PvzIDs:       []string{pvz.ID},
+		ProductTypes: []string{"одежда"},
+		Page:         1,
+		Limit:        10,
+	}
+
+	result, err := s.service.GetPVZSInfo(ctx, query)
+	s.Require().NoError(err)
+	s.Require().Len(result.Items, 1)
+	s.Require().Len(result.Items[0].Receptions, 1)
+
+	// единственный товар нужного типа удален, приемка под фильтр больше не подходит
+	s.Require().NoError(s.service.DeleteProduct(ctx, pvz.ID, products[1].ID))
+
+	result, err = s.service.GetPVZSInfo(ctx, query)
+	s.Require().NoError(err)
+	s.Require().Len(result.Items, 1)
+	s.Require().Empty(result.Items[0].Receptions)
+}
@@ -0,0 +1,148 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/Ranik23/avito-tech-spring/internal/repository/postgresql"
+	"github.com/Ranik23/avito-tech-spring/tests/integration/util"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	benchPvzCount             = 30
+	benchReceptionsPerPvz     = 5
+	benchProductsPerReception = 20
+)
+
+// BenchmarkGetPVZSInfo сравнивает старую схему N+1 (запрос приемок на каждый ПВЗ
+// и товаров на каждую приемку) с агрегированным PvzRepository.GetPVZSInfo на странице из 30 ПВЗ.
+//
+//	go test -tags=integration -run ^$ -bench BenchmarkGetPVZSInfo ./tests/integration/
+func BenchmarkGetPVZSInfo(b *testing.B) {
+	ctx := context.Background()
+
+	psqlContainer, err := util.NewPostgreSQLContainer(ctx)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer psqlContainer.Terminate(ctx)
+
+	if err := util.RunMigrations(psqlContainer.GetDSN(), "../../migrations/goose"); err != nil {
+		b.Fatal(err)
+	}
+
+	pool, err := pgxpool.New(ctx, psqlContainer.GetDSN())
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer pool.Close()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	ctxManager := postgresql.NewCtxManager(pool)
+	txManager := postgresql.NewTxManager(pool, logger, ctxManager)
+	pvzRepo := postgresql.NewPostgresPvzRepository(ctxManager, logger)
+	receptionRepo := postgresql.NewPostgresReceptionRepository(ctxManager, logger)
+	productRepo := postgresql.NewPostgresProductRepository(ctxManager, logger)
+
+	seedPvzInfo(b, ctx, txManager, pvzRepo, receptionRepo, productRepo)
+
+	b.Run("n+1", func(b *testing.B) {
+		for b.Loop() {
+			err := txManager.Do(ctx, func(txCtx context.Context) error {
+				_, err := getPVZSInfoNPlusOne(txCtx, pvzRepo, receptionRepo, productRepo)
+				return err
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("aggregated", func(b *testing.B) {
+		for b.Loop() {
+			err := txManager.Do(ctx, func(txCtx context.Context) error {
+				_, err := pvzRepo.GetPVZSInfo(txCtx, domain.PvzFilter{Limit: benchPvzCount})
+				return err
+			})
+			if err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func seedPvzInfo(b *testing.B, ctx context.Context, txManager repository.TxManager, pvzRepo repository.PvzRepository,
+	receptionRepo repository.ReceptionRepository, productRepo repository.ProductRepository) {
+	b.Helper()
+
+	items := make([]domain.ProductItem, benchProductsPerReception)
+	for i := range items {
+		items[i] = domain.ProductItem{Type: "обувь"}
+	}
+
+	err := txManager.Do(ctx, func(txCtx context.Context) error {
+		for range benchPvzCount {
+			pvz, err := pvzRepo.CreatePVZ(txCtx, "Moscow")
+			if err != nil {
+				return err
+			}
+			for range benchReceptionsPerPvz {
+				reception, err := receptionRepo.CreateReception(txCtx, pvz.ID)
+				if err != nil {
+					return err
+				}
+				if _, err := productRepo.CreateProducts(txCtx, items, reception.ID); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		b.Fatal(err)
+	}
+}
+
+// getPVZSInfoNPlusOne - прежняя реализация сборки страницы, оставлена как база для сравнения.
+func getPVZSInfoNPlusOne(ctx context.Context, pvzRepo repository.PvzRepository,
+	receptionRepo repository.ReceptionRepository, productRepo repository.ProductRepository) ([]domain.PvzInfo, error) {
+	pvzs, err := pvzRepo.GetPVZS(ctx, domain.PvzFilter{Limit: benchPvzCount})
+	if err != nil {
+		return nil, err
+	}
+
+	var result []domain.PvzInfo
+	for _, pvz := range pvzs {
+		receptions, err := receptionRepo.GetReceptionsFiltered(ctx, pvz.ID, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var receptionsInfo []domain.ReceptionInfo
+		for _, reception := range receptions {
+			products, err := productRepo.GetProducts(ctx, reception.ID)
+			if err != nil {
+				return nil, err
+			}
+			receptionsInfo = append(receptionsInfo, domain.ReceptionInfo{
+				Reception: *reception,
+				Products:  products,
+			})
+		}
+
+		result = append(result, domain.PvzInfo{
+			Pvz:        pvz,
+			Receptions: receptionsInfo,
+		})
+	}
+
+	return result, nil
+}
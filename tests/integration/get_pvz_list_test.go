@@ -5,6 +5,9 @@ package integration
 import (
 	"context"
 	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
 )
 
 
@@ -17,13 +20,249 @@ func (s *TestSuite) TestGetPVZSInfo() {
 	_, err = s.service.StartReception(ctx, pvz.ID)
 	s.Require().NoError(err)
 
-	_, err = s.service.AddProduct(ctx, pvz.ID, "box")
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь"})
 	s.Require().NoError(err)
 
 	start := time.Now().Add(-1 * time.Hour)
 	end := time.Now().Add(1 * time.Hour)
 
-	pvzInfo, err := s.service.GetPVZSInfo(ctx, start, end, 0, 10)
+	pvzInfo, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{StartDate: &start, EndDate: &end, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().GreaterOrEqual(len(pvzInfo.Items), 1)
+}
+
+func (s *TestSuite) TestGetPVZSInfoPagination() {
+	ctx := context.Background()
+
+	for range 5 {
+		_, err := s.service.CreatePVZ(ctx, "Moscow")
+		s.Require().NoError(err)
+	}
+
+	first, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{Page: 1, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(first.Items, 2)
+	s.Require().Equal(5, first.Total)
+	s.Require().Equal(2, first.NextPage)
+
+	second, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{Page: 2, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(second.Items, 2)
+	s.Require().NotEqual(first.Items[0].Pvz.ID, second.Items[0].Pvz.ID)
+
+	last, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{Page: 3, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Len(last.Items, 1)
+	s.Require().Zero(last.NextPage)
+}
+
+func (s *TestSuite) TestGetPVZSInfoCursorWalk() {
+	ctx := context.Background()
+
+	created := make([]string, 0, 5)
+	for range 5 {
+		pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+		s.Require().NoError(err)
+		created = append(created, pvz.ID)
+	}
+
+	first, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{Page: 1, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(first.NextCursor)
+
+	seen := []string{}
+	for _, info := range first.Items {
+		seen = append(seen, info.Pvz.ID)
+	}
+
+	// ПВЗ, созданный между запросами, не сдвигает курсорную выдачу
+	extra, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	cursor := first.NextCursor
+	for cursor != "" {
+		page, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{Limit: 2, Cursor: cursor})
+		s.Require().NoError(err)
+		for _, info := range page.Items {
+			seen = append(seen, info.Pvz.ID)
+		}
+		cursor = page.NextCursor
+	}
+
+	s.Require().Equal(append(created, extra.ID), seen)
+}
+
+func (s *TestSuite) TestGetPVZListCursorWalk() {
+	ctx := context.Background()
+
+	created := make([]string, 0, 5)
+	for range 5 {
+		pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+		s.Require().NoError(err)
+		created = append(created, pvz.ID)
+	}
+
+	seen := []string{}
+	cursor := ""
+	for {
+		page, err := s.service.GetPVZList(ctx, cursor, 2)
+		s.Require().NoError(err)
+		for _, pvz := range page.Items {
+			seen = append(seen, pvz.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	s.Require().Equal(created, seen)
+
+	_, err := s.service.GetPVZList(ctx, "not-a-cursor", 2)
+	s.Require().ErrorIs(err, service.ErrInvalidCursor)
+}
+
+func (s *TestSuite) TestGetPVZSInfoDateFilter() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	future := time.Now().Add(time.Hour)
+
+	result, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{StartDate: &future, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(result.Items, 1)
+	s.Require().Empty(result.Items[0].Receptions)
+
+	result, err = s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{EndDate: &future, Page: 1, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(result.Items[0].Receptions, 1)
+}
+
+func (s *TestSuite) TestGetPVZSInfoAggregatedMatchesPerRowQueries() {
+	ctx := context.Background()
+
+	for range 3 {
+		pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+		s.Require().NoError(err)
+
+		for range 2 {
+			_, err = s.service.StartReception(ctx, pvz.ID)
+			s.Require().NoError(err)
+
+			_, err = s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{{Type: "обувь"}, {Type: "одежда"}})
+			s.Require().NoError(err)
+
+			_, err = s.service.CloseReception(ctx, pvz.ID)
+			s.Require().NoError(err)
+		}
+	}
+
+	// ПВЗ без приемок тоже должен попасть в выдачу
+	_, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	var aggregated, perRow []domain.PvzInfo
+	err = s.txManager.Do(ctx, func(txCtx context.Context) error {
+		var err error
+		aggregated, err = s.pvzRepo.GetPVZSInfo(txCtx, domain.PvzFilter{Limit: 10})
+		if err != nil {
+			return err
+		}
+		perRow, err = getPVZSInfoNPlusOne(txCtx, s.pvzRepo, s.receptionRepo, s.productRepo)
+		return err
+	})
+	s.Require().NoError(err)
+
+	s.Require().Len(aggregated, 4)
+	s.Require().Len(perRow, 4)
+	for i := range perRow {
+		s.Require().Equal(perRow[i].Pvz.ID, aggregated[i].Pvz.ID)
+		s.Require().Len(aggregated[i].Receptions, len(perRow[i].Receptions))
+		for j := range perRow[i].Receptions {
+			s.Require().Equal(perRow[i].Receptions[j].Reception.ID, aggregated[i].Receptions[j].Reception.ID)
+			s.Require().ElementsMatch(perRow[i].Receptions[j].Products, aggregated[i].Receptions[j].Products)
+		}
+	}
+	s.Require().Empty(aggregated[3].Receptions)
+}
+
+func (s *TestSuite) TestGetPVZSInfoFilters() {
+	ctx := context.Background()
+
+	moscow, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	kazan, err := s.service.CreatePVZ(ctx, "Kazan")
+	s.Require().NoError(err)
+
+	// закрытая приемка с электроникой и открытая с одеждой
+	_, err = s.service.StartReception(ctx, kazan.ID)
+	s.Require().NoError(err)
+	_, err = s.service.AddProducts(ctx, kazan.ID, []domain.ProductItem{{Type: "электроника"}, {Type: "обувь"}})
+	s.Require().NoError(err)
+	closed, err := s.service.CloseReception(ctx, kazan.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, kazan.ID)
+	s.Require().NoError(err)
+	_, err = s.service.AddProduct(ctx, kazan.ID, domain.ProductItem{Type: "одежда"})
+	s.Require().NoError(err)
+
+	result, err := s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{
+		Cities:            []string{"Kazan"},
+		ReceptionStatuses: []string{"closed"},
+		ProductTypes:      []string{"электроника"},
+		Page:              1,
+		Limit:             10,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, result.Total)
+	s.Require().Len(result.Items, 1)
+	s.Require().Equal(kazan.ID, result.Items[0].Pvz.ID)
+	s.Require().Len(result.Items[0].Receptions, 1)
+	s.Require().Equal(closed.ID, result.Items[0].Receptions[0].Reception.ID)
+	// у подходящей приемки отдаются все ее товары, а не только электроника
+	s.Require().Len(result.Items[0].Receptions[0].Products, 2)
+
+	result, err = s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{
+		ProductTypes: []string{"одежда"},
+		Page:         1,
+		Limit:        10,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, result.Total)
+	s.Require().Len(result.Items, 2)
+	s.Require().Empty(result.Items[0].Receptions)
+	s.Require().Len(result.Items[1].Receptions, 1)
+	s.Require().Equal(domain.ReceptionInProgress, result.Items[1].Receptions[0].Reception.Status)
+
+	// тип в фильтре нормализуется так же, как при добавлении товара
+	result, err = s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{
+		ProductTypes: []string{" Одежда"},
+		Page:         1,
+		Limit:        10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(result.Items[1].Receptions, 1)
+
+	_, err = s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{
+		ProductTypes: []string{"мебель"},
+		Page:         1,
+		Limit:        10,
+	})
+	s.Require().ErrorIs(err, service.ErrInvalidProductType)
+
+	result, err = s.service.GetPVZSInfo(ctx, domain.PvzInfoQuery{
+		PvzIDs: []string{moscow.ID},
+		Page:   1,
+		Limit:  10,
+	})
 	s.Require().NoError(err)
-	s.Require().GreaterOrEqual(len(pvzInfo), 1)
-}
\ No newline at end of file
+	s.Require().Equal(1, result.Total)
+	s.Require().Equal(moscow.ID, result.Items[0].Pvz.ID)
+}
@@ -4,7 +4,10 @@ package integration
 
 import (
 	"context"
+	"time"
 
+	"github.com/Ranik23/avito-tech-spring/internal/hasher"
+	"github.com/Ranik23/avito-tech-spring/internal/limiter"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
 )
 
@@ -12,33 +15,155 @@ import (
 
 func (s *TestSuite) TestLoginSuccess() {
 	exampleEmail := "test@example.com"
-	exampleRole := "Client"
-	examplePassword := "strongpassword"
+	exampleRole := "employee"
+	examplePassword := "StrongPassword1"
 
 	_, err := s.service.Register(context.Background(), exampleEmail, examplePassword, exampleRole)
 	s.Require().NoError(err)
 
-	token, err := s.service.Login(context.Background(), exampleEmail, examplePassword)
+	pair, err := s.service.Login(context.Background(), exampleEmail, examplePassword, "10.0.0.1")
 	s.Require().NoError(err)
-	s.Require().NotEmpty(token)
+	s.Require().NotEmpty(pair.AccessToken)
+	s.Require().NotEmpty(pair.RefreshToken)
 }
 
 
 func (s *TestSuite) TestLoginInvalidPassword() {
 	exampleEmail := "wrongpass@example.com"
-	exampleRole := "Client"
-	correctPassword := "correct"
+	exampleRole := "employee"
+	correctPassword := "Correct123"
 	wrongPassword := "wrong"
 
 	_, err := s.service.Register(context.Background(), exampleEmail, correctPassword, exampleRole)
 	s.Require().NoError(err)
 
-	_, err = s.service.Login(context.Background(), exampleEmail, wrongPassword)
+	_, err = s.service.Login(context.Background(), exampleEmail, wrongPassword, "10.0.0.1")
 	s.Require().ErrorIs(err, service.ErrInvalidCredentials)
 }
 
 
 func (s *TestSuite) TestLoginUserNotFound() {
-	_, err := s.service.Login(context.Background(), "ghost@example.com", "whatever")
-	s.Require().ErrorIs(err, service.ErrUserNotFound)
+	_, err := s.service.Login(context.Background(), "ghost@example.com", "whatever", "10.0.0.1")
+	s.Require().ErrorIs(err, service.ErrInvalidCredentials)
+}
+
+
+func (s *TestSuite) TestLoginLockout() {
+	exampleEmail := "bruteforce@example.com"
+	examplePassword := "Correct123"
+
+	_, err := s.service.Register(context.Background(), exampleEmail, examplePassword, "employee")
+	s.Require().NoError(err)
+
+	for i := 0; i < limiter.DefaultMaxEmailAttempts; i++ {
+		_, err = s.service.Login(context.Background(), exampleEmail, "wrong", "10.0.0.2")
+		s.Require().ErrorIs(err, service.ErrInvalidCredentials)
+	}
+
+	// даже верный пароль не принимается, пока email заблокирован
+	_, err = s.service.Login(context.Background(), exampleEmail, examplePassword, "10.0.0.3")
+	s.Require().ErrorIs(err, service.ErrTooManyAttempts)
+
+	var locked *service.LoginLockedError
+	s.Require().ErrorAs(err, &locked)
+	s.Require().Greater(locked.RetryAfter, time.Duration(0))
+
+	lockedUntil, err := s.loginAttemptRepo.LockedUntil(context.Background(), "email:"+exampleEmail)
+	s.Require().NoError(err)
+	s.Require().True(lockedUntil.After(time.Now()))
+}
+
+
+func (s *TestSuite) TestLoginSuccessResetsEmailAttempts() {
+	exampleEmail := "reset@example.com"
+	examplePassword := "Correct123"
+
+	_, err := s.service.Register(context.Background(), exampleEmail, examplePassword, "employee")
+	s.Require().NoError(err)
+
+	for i := 0; i < limiter.DefaultMaxEmailAttempts-1; i++ {
+		_, err = s.service.Login(context.Background(), exampleEmail, "wrong", "10.0.0.4")
+		s.Require().ErrorIs(err, service.ErrInvalidCredentials)
+	}
+
+	_, err = s.service.Login(context.Background(), exampleEmail, examplePassword, "10.0.0.4")
+	s.Require().NoError(err)
+
+	// после успешного входа счет неудач начинается заново
+	_, err = s.service.Login(context.Background(), exampleEmail, "wrong", "10.0.0.4")
+	s.Require().ErrorIs(err, service.ErrInvalidCredentials)
+
+	lockedUntil, err := s.loginAttemptRepo.LockedUntil(context.Background(), "email:"+exampleEmail)
+	s.Require().NoError(err)
+	s.Require().True(lockedUntil.IsZero())
+}
+
+
+func (s *TestSuite) TestLoginAttemptsDeleteStale() {
+	ctx := context.Background()
+	now := time.Now()
+
+	failures, err := s.loginAttemptRepo.AddFailure(ctx, "ip:10.0.0.5", now.Add(-time.Hour), time.Minute)
+	s.Require().NoError(err)
+	s.Require().Equal(1, failures)
+
+	// после окна без неудач счетчик начинается заново
+	failures, err = s.loginAttemptRepo.AddFailure(ctx, "ip:10.0.0.5", now, time.Minute)
+	s.Require().NoError(err)
+	s.Require().Equal(1, failures)
+
+	_, err = s.loginAttemptRepo.AddFailure(ctx, "ip:10.0.0.6", now.Add(-time.Hour), time.Minute)
+	s.Require().NoError(err)
+
+	deleted, err := s.loginAttemptRepo.DeleteStale(ctx, now.Add(-time.Minute))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), deleted)
+}
+
+
+func (s *TestSuite) TestLoginRehashesOutdatedHash() {
+	ctx := context.Background()
+	exampleEmail := "legacy@example.com"
+	examplePassword := "Legacy123"
+
+	legacyHasher, err := hasher.NewHasher(hasher.Config{Algorithm: hasher.AlgorithmBcrypt, BcryptCost: 4})
+	s.Require().NoError(err)
+	legacyHash, err := legacyHasher.Hash(examplePassword)
+	s.Require().NoError(err)
+	s.Require().True(s.hasher.NeedsRehash(legacyHash))
+
+	userID, err := s.userRepo.CreateUser(ctx, exampleEmail, legacyHash, "employee")
+	s.Require().NoError(err)
+
+	_, err = s.service.Login(ctx, exampleEmail, examplePassword, "10.0.0.1")
+	s.Require().NoError(err)
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().NotEqual(legacyHash, user.PasswordHash)
+	s.Require().False(s.hasher.NeedsRehash(user.PasswordHash))
+	s.Require().True(s.hasher.Equal(user.PasswordHash, examplePassword))
+
+	_, err = s.service.Login(ctx, exampleEmail, examplePassword, "10.0.0.1")
+	s.Require().NoError(err)
+}
+
+
+func (s *TestSuite) TestReplacePasswordHashSkipsChangedHash() {
+	ctx := context.Background()
+
+	userID, err := s.userRepo.CreateUser(ctx, "replace@example.com", "currentHash", "employee")
+	s.Require().NoError(err)
+
+	replaced, err := s.userRepo.ReplacePasswordHash(ctx, userID, "staleHash", "newHash")
+	s.Require().NoError(err)
+	s.Require().False(replaced)
+
+	replaced, err = s.userRepo.ReplacePasswordHash(ctx, userID, "currentHash", "newHash")
+	s.Require().NoError(err)
+	s.Require().True(replaced)
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().Equal("newHash", user.PasswordHash)
 }
@@ -20,7 +20,7 @@ func (s *TestSuite) TestMain() {
 	s.Require().NoError(err)
 
 	for i := 0; i < 50; i++ {
-		_, err := s.service.AddProduct(context.Background(), examplePvz.ID, "box")
+		_, err := s.service.AddProduct(context.Background(), examplePvz.ID, domain.ProductItem{Type: "обувь"})
 		s.Require().NoError(err)
 	}
 
@@ -0,0 +1,146 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/outbox"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	events []domain.OutboxEvent
+	err    error
+}
+
+func (r *recordingSink) Send(ctx context.Context, event domain.OutboxEvent) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (s *TestSuite) newRelay(sink outbox.Sink) *outbox.Relay {
+	return outbox.NewRelay(s.outboxRepo, s.txManager, sink, outbox.Config{
+		PollInterval: 100 * time.Millisecond,
+		BatchSize:    10,
+		MaxAttempts:  3,
+		RetryBackoff: time.Hour,
+		SendTimeout:  time.Second,
+	}, s.logger)
+}
+
+func (s *TestSuite) TestOutboxEventsWrittenWithMutation() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь"})
+	s.Require().NoError(err)
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	rows, err := db.Query(`SELECT event_type FROM outbox WHERE pvz_id = $1 ORDER BY id`, pvz.ID)
+	s.Require().NoError(err)
+	defer rows.Close()
+
+	var types []string
+	for rows.Next() {
+		var eventType string
+		s.Require().NoError(rows.Scan(&eventType))
+		types = append(types, eventType)
+	}
+	s.Require().NoError(rows.Err())
+
+	s.Require().Equal([]string{domain.EventPvzCreated, domain.EventReceptionStarted, domain.EventProductAdded}, types)
+}
+
+func (s *TestSuite) TestOutboxNoEventOnRollback() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь"})
+	s.Require().Error(err)
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	var count int
+	err = db.QueryRow(`SELECT COUNT(*) FROM outbox WHERE event_type = $1`, domain.EventProductAdded).Scan(&count)
+	s.Require().NoError(err)
+	s.Require().Zero(count)
+}
+
+func (s *TestSuite) TestOutboxRelayDelivers() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	sink := &recordingSink{}
+	relay := s.newRelay(sink)
+
+	sent, err := relay.ProcessBatch(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(1, sent)
+	s.Require().Len(sink.events, 1)
+	s.Require().Equal(pvz.ID, sink.events[0].PvzID)
+	s.Require().Equal(domain.EventPvzCreated, sink.events[0].EventType)
+
+	sent, err = relay.ProcessBatch(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(sent)
+}
+
+func (s *TestSuite) TestOutboxRelayRetriesLater() {
+	ctx := context.Background()
+
+	_, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	relay := s.newRelay(&recordingSink{err: errors.New("sink is down")})
+
+	sent, err := relay.ProcessBatch(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(sent)
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	var (
+		attempts  int
+		lastError string
+		processed sql.NullTime
+	)
+	err = db.QueryRow(`SELECT attempts, last_error, processed_at FROM outbox`).Scan(&attempts, &lastError, &processed)
+	s.Require().NoError(err)
+	s.Require().Equal(1, attempts)
+	s.Require().Equal("sink is down", lastError)
+	s.Require().False(processed.Valid)
+
+	// Следующая попытка отложена, поэтому исправный sink пока ничего не получит.
+	sink := &recordingSink{}
+	sent, err = s.newRelay(sink).ProcessBatch(ctx)
+	s.Require().NoError(err)
+	s.Require().Zero(sent)
+	s.Require().Empty(sink.events)
+}
@@ -0,0 +1,123 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+	"github.com/Ranik23/avito-tech-spring/internal/token"
+)
+
+// recordingNotifier запоминает последний токен сброса для каждого email вместо отправки письма.
+type recordingNotifier struct {
+	mu     sync.Mutex
+	tokens map[string]string
+}
+
+func newRecordingNotifier() *recordingNotifier {
+	return &recordingNotifier{
+		tokens: make(map[string]string),
+	}
+}
+
+func (r *recordingNotifier) SendPasswordReset(ctx context.Context, email string, resetToken string, expiresAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.tokens[email] = resetToken
+	return nil
+}
+
+func (r *recordingNotifier) lastToken(email string) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	resetToken, ok := r.tokens[email]
+	return resetToken, ok
+}
+
+func (s *TestSuite) TestChangePassword() {
+	ctx := context.Background()
+
+	userID, err := s.service.Register(ctx, "change@example.com", "OldPassword1", "employee")
+	s.Require().NoError(err)
+
+	pair, err := s.service.Login(ctx, "change@example.com", "OldPassword1", "")
+	s.Require().NoError(err)
+
+	err = s.service.ChangePassword(ctx, userID, "WrongPassword1", "NewPassword1")
+	s.Require().ErrorIs(err, service.ErrInvalidCredentials)
+
+	err = s.service.ChangePassword(ctx, userID, "OldPassword1", "weak")
+	s.Require().ErrorIs(err, service.ErrWeakPassword)
+
+	err = s.service.ChangePassword(ctx, userID, "OldPassword1", "NewPassword1")
+	s.Require().NoError(err)
+
+	_, err = s.service.Login(ctx, "change@example.com", "OldPassword1", "")
+	s.Require().ErrorIs(err, service.ErrInvalidCredentials)
+
+	_, err = s.service.Login(ctx, "change@example.com", "NewPassword1", "")
+	s.Require().NoError(err)
+
+	// старые сессии после смены пароля не продлеваются
+	_, err = s.service.Refresh(ctx, pair.RefreshToken)
+	s.Require().ErrorIs(err, service.ErrInvalidRefreshToken)
+}
+
+func (s *TestSuite) TestPasswordResetFlow() {
+	ctx := context.Background()
+
+	_, err := s.service.Register(ctx, "reset-flow@example.com", "OldPassword1", "employee")
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.service.RequestPasswordReset(ctx, "reset-flow@example.com"))
+	firstToken, ok := s.notifier.lastToken("reset-flow@example.com")
+	s.Require().True(ok)
+
+	s.Require().NoError(s.service.RequestPasswordReset(ctx, "reset-flow@example.com"))
+	resetToken, _ := s.notifier.lastToken("reset-flow@example.com")
+	s.Require().NotEqual(firstToken, resetToken)
+
+	// новый запрос гасит предыдущий токен
+	err = s.service.ResetPassword(ctx, firstToken, "NewPassword1")
+	s.Require().ErrorIs(err, service.ErrInvalidResetToken)
+
+	// слабый пароль не сжигает токен
+	err = s.service.ResetPassword(ctx, resetToken, "weak")
+	s.Require().ErrorIs(err, service.ErrWeakPassword)
+
+	s.Require().NoError(s.service.ResetPassword(ctx, resetToken, "NewPassword1"))
+
+	err = s.service.ResetPassword(ctx, resetToken, "OtherPassword1")
+	s.Require().ErrorIs(err, service.ErrInvalidResetToken)
+
+	_, err = s.service.Login(ctx, "reset-flow@example.com", "NewPassword1", "")
+	s.Require().NoError(err)
+}
+
+func (s *TestSuite) TestPasswordResetUnknownEmail() {
+	err := s.service.RequestPasswordReset(context.Background(), "nobody@example.com")
+	s.Require().NoError(err)
+
+	_, ok := s.notifier.lastToken("nobody@example.com")
+	s.Require().False(ok)
+}
+
+func (s *TestSuite) TestPasswordResetExpiredToken() {
+	ctx := context.Background()
+
+	userID, err := s.service.Register(ctx, "expired@example.com", "OldPassword1", "employee")
+	s.Require().NoError(err)
+
+	resetToken, err := token.NewResetToken()
+	s.Require().NoError(err)
+	err = s.passwordResetRepo.CreateResetToken(ctx, userID, token.HashResetToken(resetToken), time.Now().Add(-time.Minute))
+	s.Require().NoError(err)
+
+	err = s.service.ResetPassword(ctx, resetToken, "NewPassword1")
+	s.Require().ErrorIs(err, service.ErrInvalidResetToken)
+}
@@ -0,0 +1,61 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+)
+
+
+func (s *TestSuite) TestDuplicateBarcodeScan() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	first, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	product, err := s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь", Barcode: "4601234567890", OrderID: "ORD-1"})
+	s.Require().NoError(err)
+	s.Require().Equal("4601234567890", product.Barcode)
+	s.Require().Equal("ORD-1", product.OrderID)
+
+	// повторный скан в ту же приемку - конфликт, товары без штрихкода не ограничены
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "одежда", Barcode: "4601234567890"})
+	s.Require().ErrorIs(err, service.ErrDuplicateBarcode)
+
+	_, err = s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{{Type: "одежда"}, {Type: "одежда"}})
+	s.Require().NoError(err)
+
+	_, err = s.service.AddProducts(ctx, pvz.ID, []domain.ProductItem{{Type: "одежда", Barcode: "1"}, {Type: "обувь", Barcode: "4601234567890"}})
+	s.Require().ErrorIs(err, service.ErrDuplicateBarcode)
+
+	stored, err := s.productRepo.GetProducts(ctx, first.ID)
+	s.Require().NoError(err)
+	s.Require().Len(stored, 3)
+
+	// в другой приемке тот же штрихкод разрешен
+	_, err = s.service.CloseReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	second, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь", Barcode: "4601234567890"})
+	s.Require().NoError(err)
+
+	found, err := s.service.FindProductsByBarcode(ctx, "4601234567890")
+	s.Require().NoError(err)
+	s.Require().Len(found, 2)
+	s.Require().Equal(second.ID, found[0].ReceptionID)
+	s.Require().Equal(first.ID, found[1].ReceptionID)
+	s.Require().Equal("ORD-1", found[1].OrderID)
+
+	found, err = s.service.FindProductsByBarcode(ctx, "404")
+	s.Require().NoError(err)
+	s.Require().Empty(found)
+}
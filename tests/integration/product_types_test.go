@@ -0,0 +1,71 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+)
+
+
+func (s *TestSuite) TestAddProductValidatesType() {
+	ctx := context.Background()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "electronic"})
+	s.Require().ErrorIs(err, service.ErrInvalidProductType)
+
+	// тип приводится к виду справочника
+	product, err := s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: " Обувь "})
+	s.Require().NoError(err)
+	s.Require().Equal("обувь", product.Type)
+}
+
+
+func (s *TestSuite) TestProductTypeCatalog() {
+	ctx := context.Background()
+
+	productTypes, err := s.service.ListProductTypes(ctx)
+	s.Require().NoError(err)
+	s.Require().Len(productTypes, 3)
+
+	created, err := s.service.CreateProductType(ctx, domain.ProductType{Name: "Посуда", Fragile: true, MaxWeightGrams: 5000})
+	s.Require().NoError(err)
+	s.Require().Equal("посуда", created.Name)
+	s.Require().True(created.Fragile)
+
+	_, err = s.service.CreateProductType(ctx, domain.ProductType{Name: "посуда"})
+	s.Require().ErrorIs(err, service.ErrAlreadyExists)
+
+	updated, err := s.service.UpdateProductType(ctx, domain.ProductType{Name: "посуда", MaxWeightGrams: 3000})
+	s.Require().NoError(err)
+	s.Require().False(updated.Fragile)
+	s.Require().Equal(3000, updated.MaxWeightGrams)
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	product, err := s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "посуда"})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.service.DeleteProductType(ctx, "посуда"))
+	s.Require().ErrorIs(s.service.DeleteProductType(ctx, "посуда"), service.ErrProductTypeNotFound)
+
+	// принятый товар остается, новый с удаленным типом добавить нельзя
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "посуда"})
+	s.Require().ErrorIs(err, service.ErrInvalidProductType)
+
+	stored, err := s.productRepo.GetProducts(ctx, product.ReceptionID)
+	s.Require().NoError(err)
+	s.Require().Len(stored, 1)
+}
@@ -5,6 +5,11 @@ package integration
 import (
 	"context"
 	"database/sql"
+	"sync"
+
+	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/repository"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
 )
 
 func (s *TestSuite) TestStartCloseReception() {
@@ -29,4 +34,135 @@ func (s *TestSuite) TestStartCloseReception() {
 	err = db.QueryRow(`SELECT status FROM reception WHERE id = $1`, reception.ID).Scan(&status)
 	s.Require().NoError(err)
 	s.Require().Equal("closed", status)
-}
\ No newline at end of file
+}
+func (s *TestSuite) TestReceptionLifecycle() {
+	ctx := context.TODO()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	reception, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReceptionInProgress, reception.Status)
+
+	paused, err := s.service.PauseReception(ctx, reception.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReceptionPaused, paused.Status)
+
+	// на паузе товары не принимаются, а новую приемку открыть нельзя
+	_, err = s.service.AddProduct(ctx, pvz.ID, domain.ProductItem{Type: "обувь"})
+	s.Require().ErrorIs(err, service.ErrReceptionPaused)
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().ErrorIs(err, service.ErrAlreadyOpen)
+
+	_, err = s.service.ResumeReception(ctx, reception.ID)
+	s.Require().NoError(err)
+
+	closed, err := s.service.CloseReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReceptionClosed, closed.Status)
+
+	_, err = s.service.CancelReception(ctx, reception.ID, "")
+	s.Require().ErrorIs(err, service.ErrInvalidReceptionTransition)
+
+	reopened, err := s.service.ReopenReception(ctx, reception.ID, "пересчет")
+	s.Require().NoError(err)
+	s.Require().Equal(domain.ReceptionInProgress, reopened.Status)
+
+	history, err := s.service.GetReceptionHistory(ctx, reception.ID)
+	s.Require().NoError(err)
+	s.Require().Len(history, 5)
+
+	actions := make([]string, 0, len(history))
+	for _, change := range history {
+		actions = append(actions, change.Action)
+	}
+	s.Require().Equal([]string{
+		domain.ReceptionActionStart,
+		domain.ReceptionActionPause,
+		domain.ReceptionActionResume,
+		domain.ReceptionActionClose,
+		domain.ReceptionActionReopen,
+	}, actions)
+	s.Require().Empty(history[0].FromStatus)
+	s.Require().Equal(domain.ReceptionClosed, history[4].FromStatus)
+	s.Require().Equal("пересчет", history[4].Reason)
+}
+
+func (s *TestSuite) TestReopenReceptionWhenAnotherIsActive() {
+	ctx := context.TODO()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	first, err := s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+	_, err = s.service.CloseReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.StartReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	_, err = s.service.ReopenReception(ctx, first.ID, "")
+	s.Require().ErrorIs(err, service.ErrAlreadyOpen)
+}
+
+func (s *TestSuite) TestStartReceptionConcurrently() {
+	ctx := context.TODO()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	const workers = 10
+
+	var (
+		wg    sync.WaitGroup
+		start = make(chan struct{})
+		errs  = make(chan error, workers)
+	)
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			_, err := s.service.StartReception(ctx, pvz.ID)
+			errs <- err
+		}()
+	}
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	var opened int
+	for err := range errs {
+		if err == nil {
+			opened++
+			continue
+		}
+		s.Require().ErrorIs(err, service.ErrAlreadyOpen)
+	}
+	s.Require().Equal(1, opened)
+
+	db, err := sql.Open("postgres", s.psqlContainer.GetDSN())
+	s.Require().NoError(err)
+	defer db.Close()
+
+	var active int
+	err = db.QueryRow(`SELECT COUNT(*) FROM reception WHERE pvz_id = $1 AND status IN ('in_progress', 'paused')`, pvz.ID).Scan(&active)
+	s.Require().NoError(err)
+	s.Require().Equal(1, active)
+}
+
+func (s *TestSuite) TestCreateReceptionUniqueOpenIndex() {
+	ctx := context.TODO()
+
+	pvz, err := s.service.CreatePVZ(ctx, "Moscow")
+	s.Require().NoError(err)
+
+	_, err = s.receptionRepo.CreateReception(ctx, pvz.ID)
+	s.Require().NoError(err)
+
+	// в обход проверки FindOpen в сервисе вторую открытую приемку не пускает индекс
+	_, err = s.receptionRepo.CreateReception(ctx, pvz.ID)
+	s.Require().ErrorIs(err, repository.ErrAlreadyExists)
+}
@@ -0,0 +1,59 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+	"github.com/Ranik23/avito-tech-spring/internal/token"
+)
+
+func (s *TestSuite) TestRefreshRotation() {
+	ctx := context.Background()
+
+	_, err := s.service.Register(ctx, "refresh@example.com", "Password1", "employee")
+	s.Require().NoError(err)
+
+	pair, err := s.service.Login(ctx, "refresh@example.com", "Password1", "")
+	s.Require().NoError(err)
+
+	rotated, err := s.service.Refresh(ctx, pair.RefreshToken)
+	s.Require().NoError(err)
+	s.Require().NotEqual(pair.RefreshToken, rotated.RefreshToken)
+
+	// Повторное предъявление старого токена считается кражей: отзываются все токены пользователя
+	_, err = s.service.Refresh(ctx, pair.RefreshToken)
+	s.Require().ErrorIs(err, service.ErrInvalidRefreshToken)
+
+	_, err = s.service.Refresh(ctx, rotated.RefreshToken)
+	s.Require().ErrorIs(err, service.ErrInvalidRefreshToken)
+}
+
+func (s *TestSuite) TestLogoutRevokesTokens() {
+	ctx := context.Background()
+
+	userID, err := s.service.Register(ctx, "logout@example.com", "Password1", "employee")
+	s.Require().NoError(err)
+
+	pair, err := s.service.Login(ctx, "logout@example.com", "Password1", "")
+	s.Require().NoError(err)
+
+	claims, err := s.token.Parse(pair.AccessToken)
+	s.Require().NoError(err)
+
+	jti, ok := token.TokenID(claims)
+	s.Require().True(ok)
+	expiresAt, ok := token.ExpiresAt(claims)
+	s.Require().True(ok)
+
+	err = s.service.Logout(ctx, userID, jti, expiresAt, pair.RefreshToken)
+	s.Require().NoError(err)
+
+	revoked, err := s.tokenRepo.IsAccessTokenRevoked(ctx, jti)
+	s.Require().NoError(err)
+	s.Require().True(revoked)
+
+	_, err = s.service.Refresh(ctx, pair.RefreshToken)
+	s.Require().ErrorIs(err, service.ErrInvalidRefreshToken)
+}
@@ -7,13 +7,14 @@ import (
 	"database/sql"
 
 	"github.com/Ranik23/avito-tech-spring/internal/models/domain"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
 )
 
 
 func (s *TestSuite) TestRegisterSuccess() {
-	exampleEmail := "lol"
-	exampleRole := "lol"
-	examplePassword := "employee"
+	exampleEmail := "lol@example.com"
+	exampleRole := "employee"
+	examplePassword := "Lollipop1"
 
 	userID, err := s.service.Register(context.Background(), exampleEmail, examplePassword, exampleRole)
 	s.Require().NoError(err)
@@ -34,4 +35,23 @@ func (s *TestSuite) TestRegisterSuccess() {
 	s.Require().Equal(userID, user.ID)
 	s.Require().Equal(exampleEmail, user.Email)
 	s.Require().Equal(exampleRole, user.Role)
-}
\ No newline at end of file
+}
+
+func (s *TestSuite) TestRegisterRoleNotAllowed() {
+	_, err := s.service.Register(context.Background(), "admin@example.com", "password", "admin")
+	s.Require().ErrorIs(err, service.ErrInvalidRole)
+}
+
+func (s *TestSuite) TestRegisterInvalidEmail() {
+	_, err := s.service.Register(context.Background(), "not-an-email", "Password1", "employee")
+	s.Require().ErrorIs(err, service.ErrInvalidEmail)
+}
+
+func (s *TestSuite) TestRegisterWeakPassword() {
+	_, err := s.service.Register(context.Background(), "weak@example.com", "password", "employee")
+	s.Require().ErrorIs(err, service.ErrWeakPassword)
+
+	user, err := s.userRepo.GetUser(context.Background(), "weak@example.com")
+	s.Require().NoError(err)
+	s.Require().Nil(user)
+}
@@ -20,8 +20,8 @@ func (s *TestSuite) TestRollBackCreatePVZ() {
 
 	txManager := mock.NewMockTxManager(ctrl)
 
-	authService := service.NewAuthService(s.userRepo, txManager, s.token, s.hasher, s.logger)
-	pvzService := service.NewPVZService(s.pvzRepo, s.receptionRepo, s.cities, s.productRepo, txManager, s.logger)
+	authService := service.NewAuthService(s.userRepo, s.tokenRepo, s.passwordResetRepo, txManager, s.token, s.hasher, nil, s.notifier, s.rbac, s.passwordPolicy, service.DefaultRefreshTTL, service.DefaultResetTokenTTL, true, s.logger)
+	pvzService := service.NewPVZService(s.pvzRepo, s.receptionRepo, s.cities, s.productRepo, s.productTypeRepo, s.outboxRepo, s.auditRepo, s.assignmentRepo, s.userRepo, txManager, s.broker, s.rbac, s.logger)
 	service := service.NewService(authService, pvzService)
 
 	txManager.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
@@ -11,7 +11,11 @@ import (
 	"time"
 
 	"github.com/Ranik23/avito-tech-spring/internal/config"
+	"github.com/Ranik23/avito-tech-spring/internal/credentials"
+	"github.com/Ranik23/avito-tech-spring/internal/events"
 	"github.com/Ranik23/avito-tech-spring/internal/hasher"
+	"github.com/Ranik23/avito-tech-spring/internal/limiter"
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
 	"github.com/Ranik23/avito-tech-spring/internal/repository"
 	"github.com/Ranik23/avito-tech-spring/internal/repository/postgresql"
 	"github.com/Ranik23/avito-tech-spring/internal/service"
@@ -35,9 +39,22 @@ type TestSuite struct {
 	pvzRepo repository.PvzRepository
 	userRepo repository.UserRepository
 	productRepo repository.ProductRepository
+	productTypeRepo repository.ProductTypeRepository
+	outboxRepo repository.OutboxRepository
+	tokenRepo repository.TokenRepository
+	assignmentRepo repository.AssignmentRepository
+	auditRepo repository.AuditRepository
+	loginAttemptRepo repository.LoginAttemptRepository
+	passwordResetRepo repository.PasswordResetRepository
+
+	txManager repository.TxManager
 
 	token token.Token
 	hasher hasher.Hasher
+	notifier *recordingNotifier
+	passwordPolicy credentials.PasswordPolicy
+	rbac *rbac.Policy
+	broker events.Broker
 
 	cities []string
 
@@ -81,27 +98,75 @@ func (s *TestSuite) SetupSuite() {
 
 	userRepo := postgresql.NewPostgresUserRepository(ctxManager, logger)
 	productRepo := postgresql.NewPostgresProductRepository(ctxManager, logger)
+	productTypeRepo := postgresql.NewPostgresProductTypeRepository(ctxManager, logger)
 	receptionRepo := postgresql.NewPostgresReceptionRepository(ctxManager, logger)
 	pvzRepo := postgresql.NewPostgresPvzRepository(ctxManager, logger)
+	outboxRepo := postgresql.NewPostgresOutboxRepository(ctxManager, logger)
+	tokenRepo := postgresql.NewPostgresTokenRepository(ctxManager, logger)
+	assignmentRepo := postgresql.NewPostgresAssignmentRepository(ctxManager, logger)
+	auditRepo := postgresql.NewPostgresAuditRepository(ctxManager, logger)
+	loginAttemptRepo := postgresql.NewPostgresLoginAttemptRepository(ctxManager, logger)
+	passwordResetRepo := postgresql.NewPostgresPasswordResetRepository(ctxManager, logger)
 
 
 	s.pvzRepo = pvzRepo
 	s.userRepo = userRepo
 	s.productRepo = productRepo
+	s.productTypeRepo = productTypeRepo
 	s.receptionRepo = receptionRepo
-
-	token := token.NewToken("lol", logger)
-	hasher := hasher.NewHasher()
+	s.outboxRepo = outboxRepo
+	s.tokenRepo = tokenRepo
+	s.assignmentRepo = assignmentRepo
+	s.auditRepo = auditRepo
+	s.loginAttemptRepo = loginAttemptRepo
+	s.passwordResetRepo = passwordResetRepo
+	s.txManager = txManager
+
+	token := token.NewToken("lol", token.Config{
+		Issuer:    cfg.Token.Issuer,
+		Audience:  cfg.Token.Audience,
+		AccessTTL: time.Duration(cfg.Token.AccessTTL) * time.Second,
+	}, logger)
+	hasher, err := hasher.NewHasher(hasher.Config{
+		Algorithm:  cfg.Hasher.Algorithm,
+		BcryptCost: cfg.Hasher.BcryptCost,
+		Argon2: hasher.Argon2Params{
+			Time:       cfg.Hasher.Argon2Time,
+			Memory:     cfg.Hasher.Argon2Memory,
+			Threads:    cfg.Hasher.Argon2Threads,
+			KeyLength:  cfg.Hasher.Argon2KeyLength,
+			SaltLength: cfg.Hasher.Argon2SaltLength,
+		},
+	})
+	s.Require().NoError(err)
 
 
 	s.token = token
 	s.hasher = hasher
+	s.notifier = newRecordingNotifier()
+	s.passwordPolicy = cfg.Password.Policy()
 
-	cities := []string{"Moscow"}
+	rbacPolicy, err := cfg.RBAC.Policy()
+	s.Require().NoError(err)
+	s.rbac = rbacPolicy
+
+	cities := []string{"Moscow", "Kazan"}
 	s.cities = cities
 
-	authService := service.NewAuthService(userRepo, txManager, token, hasher, logger)
-	pvzService := service.NewPVZService(pvzRepo, receptionRepo, cities, productRepo, txManager, logger)
+	broker := events.NewBroker(100, 100, logger)
+	s.broker = broker
+
+	loginLimiter := limiter.NewLoginLimiter(loginAttemptRepo, limiter.Config{
+		MaxEmailAttempts: cfg.LoginLimiter.MaxEmailAttempts,
+		MaxIPAttempts:    cfg.LoginLimiter.MaxIPAttempts,
+		Window:           time.Duration(cfg.LoginLimiter.Window) * time.Second,
+		BaseLockout:      time.Duration(cfg.LoginLimiter.BaseLockout) * time.Second,
+		MaxLockout:       time.Duration(cfg.LoginLimiter.MaxLockout) * time.Second,
+	}, logger)
+
+	authService := service.NewAuthService(userRepo, tokenRepo, passwordResetRepo, txManager, token, hasher, loginLimiter, s.notifier, rbacPolicy,
+		s.passwordPolicy, time.Duration(cfg.Token.RefreshTTL)*time.Second, service.DefaultResetTokenTTL, true, logger)
+	pvzService := service.NewPVZService(pvzRepo, receptionRepo, cities, productRepo, productTypeRepo, outboxRepo, auditRepo, assignmentRepo, userRepo, txManager, broker, rbacPolicy, logger)
 
 	service := service.NewService(authService, pvzService)
 
@@ -118,7 +183,14 @@ func (s *TestSuite) SetupTest() {
 	defer db.Close()
 
 	_, err = db.Exec(`
-        TRUNCATE TABLE users, reception, product, pvz RESTART IDENTITY CASCADE;
+        TRUNCATE TABLE users, refresh_token, revoked_token, reception, product, pvz, pvz_assignment, audit_log, login_attempt, password_reset_token, outbox RESTART IDENTITY CASCADE;
+    `)
+	s.Require().NoError(err)
+
+	// справочник типов товаров возвращается к состоянию после миграции
+	_, err = db.Exec(`
+        TRUNCATE TABLE product_type;
+        INSERT INTO product_type (name, fragile) VALUES ('электроника', TRUE), ('одежда', FALSE), ('обувь', FALSE);
     `)
 	s.Require().NoError(err)
 }
@@ -0,0 +1,115 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+
+	"github.com/Ranik23/avito-tech-spring/internal/rbac"
+	"github.com/Ranik23/avito-tech-spring/internal/service"
+)
+
+
+func (s *TestSuite) TestListUsersPagination() {
+	ctx := context.Background()
+
+	for _, email := range []string{"first@example.com", "second@example.com", "third@example.com"} {
+		_, err := s.service.Register(ctx, email, "Password1", "employee")
+		s.Require().NoError(err)
+	}
+
+	page, err := s.service.ListUsers(ctx, "", 2)
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 2)
+	s.Require().NotEmpty(page.NextCursor)
+	s.Require().Equal("first@example.com", page.Items[0].Email)
+
+	page, err = s.service.ListUsers(ctx, page.NextCursor, 2)
+	s.Require().NoError(err)
+	s.Require().Len(page.Items, 1)
+	s.Require().Empty(page.NextCursor)
+	s.Require().Equal("third@example.com", page.Items[0].Email)
+}
+
+
+func (s *TestSuite) TestUpdateUserRole() {
+	ctx := context.Background()
+
+	moderatorID, err := s.service.Register(ctx, "moderator@example.com", "Password1", "moderator")
+	s.Require().NoError(err)
+	userID, err := s.service.Register(ctx, "promoted@example.com", "Password1", "employee")
+	s.Require().NoError(err)
+
+	before, err := s.service.GetUser(ctx, userID)
+	s.Require().NoError(err)
+
+	moderatorCtx := rbac.WithActor(ctx, rbac.Actor{UserID: moderatorID, Role: "moderator"})
+
+	user, err := s.service.UpdateUserRole(moderatorCtx, userID, "moderator")
+	s.Require().NoError(err)
+	s.Require().Equal("moderator", user.Role)
+	s.Require().True(user.UpdatedAt.After(before.UpdatedAt))
+
+	_, err = s.service.UpdateUserRole(moderatorCtx, moderatorID, "employee")
+	s.Require().ErrorIs(err, service.ErrSelfManagement)
+
+	_, err = s.service.UpdateUserRole(moderatorCtx, userID, "root")
+	s.Require().ErrorIs(err, service.ErrInvalidRole)
+}
+
+
+func (s *TestSuite) TestDeactivateUser() {
+	ctx := context.Background()
+	email := "deactivated@example.com"
+	password := "Password1"
+
+	userID, err := s.service.Register(ctx, email, password, "employee")
+	s.Require().NoError(err)
+
+	pair, err := s.service.Login(ctx, email, password, "10.0.0.1")
+	s.Require().NoError(err)
+
+	user, err := s.service.DeactivateUser(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().True(user.Disabled)
+
+	disabled, err := s.userRepo.IsUserDisabled(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().True(disabled)
+
+	_, err = s.service.Login(ctx, email, password, "10.0.0.1")
+	s.Require().ErrorIs(err, service.ErrUserDisabled)
+
+	_, err = s.service.Refresh(ctx, pair.RefreshToken)
+	s.Require().ErrorIs(err, service.ErrInvalidRefreshToken)
+
+	_, err = s.service.ActivateUser(ctx, userID)
+	s.Require().NoError(err)
+
+	_, err = s.service.Login(ctx, email, password, "10.0.0.1")
+	s.Require().NoError(err)
+}
+
+
+func (s *TestSuite) TestDeleteUser() {
+	ctx := context.Background()
+	email := "deleted@example.com"
+
+	userID, err := s.service.Register(ctx, email, "Password1", "employee")
+	s.Require().NoError(err)
+
+	_, err = s.service.Login(ctx, email, "Password1", "10.0.0.1")
+	s.Require().NoError(err)
+
+	// refresh токены удаляются каскадно вместе с пользователем
+	s.Require().NoError(s.service.DeleteUser(ctx, userID))
+
+	_, err = s.service.GetUser(ctx, userID)
+	s.Require().ErrorIs(err, service.ErrUserNotFound)
+
+	disabled, err := s.userRepo.IsUserDisabled(ctx, userID)
+	s.Require().NoError(err)
+	s.Require().True(disabled, "tokens of a deleted user must be rejected")
+
+	s.Require().ErrorIs(s.service.DeleteUser(ctx, userID), service.ErrUserNotFound)
+}